@@ -0,0 +1,71 @@
+/*
+ * foundationdbcluster_conversion.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var _ conversion.Convertible = &FoundationDBCluster{}
+
+// ConvertTo converts this FoundationDBCluster to the hub version (v1beta2). The deprecated v1beta1 version was always
+// served without a conversion by the API server, so only the fields with the same JSON representation are converted.
+func (cluster *FoundationDBCluster) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*fdbv1beta2.FoundationDBCluster)
+	if !ok {
+		return fmt.Errorf("expected a v1beta2 FoundationDBCluster but got %T", dstRaw)
+	}
+
+	typeMeta := dst.TypeMeta
+	err := convertByJSON(cluster, dst)
+	dst.TypeMeta = typeMeta
+
+	return err
+}
+
+// ConvertFrom converts from the hub version (v1beta2) to this version. The deprecated v1beta1 version was always
+// served without a conversion by the API server, so only the fields with the same JSON representation are converted.
+func (cluster *FoundationDBCluster) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*fdbv1beta2.FoundationDBCluster)
+	if !ok {
+		return fmt.Errorf("expected a v1beta2 FoundationDBCluster but got %T", srcRaw)
+	}
+
+	typeMeta := cluster.TypeMeta
+	err := convertByJSON(src, cluster)
+	cluster.TypeMeta = typeMeta
+
+	return err
+}
+
+// convertByJSON converts the source object into the destination object by using the JSON representation of the source.
+func convertByJSON(src interface{}, dst interface{}) error {
+	rawData, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(rawData, dst)
+}
@@ -0,0 +1,83 @@
+/*
+ * foundationdbcluster_conversion_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[api] FoundationDBCluster conversion", func() {
+	When("converting a v1beta1 cluster to v1beta2 and back", func() {
+		var spoke *FoundationDBCluster
+		var hub *fdbv1beta2.FoundationDBCluster
+
+		BeforeEach(func() {
+			spoke = &FoundationDBCluster{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "FoundationDBCluster",
+					APIVersion: GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: FoundationDBClusterSpec{
+					Version: Versions.Default.String(),
+					DatabaseConfiguration: DatabaseConfiguration{
+						RedundancyMode: "double",
+						StorageEngine:  "ssd",
+						UsableRegions:  1,
+					},
+				},
+			}
+
+			hub = &fdbv1beta2.FoundationDBCluster{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "FoundationDBCluster",
+					APIVersion: fdbv1beta2.GroupVersion.String(),
+				},
+			}
+			Expect(spoke.ConvertTo(hub)).NotTo(HaveOccurred())
+		})
+
+		It("should convert the fields with the same representation", func() {
+			Expect(hub.APIVersion).To(Equal(fdbv1beta2.GroupVersion.String()))
+			Expect(hub.Name).To(Equal(spoke.Name))
+			Expect(hub.Spec.Version).To(Equal(spoke.Spec.Version))
+			Expect(hub.Spec.DatabaseConfiguration.RedundancyMode).To(Equal(fdbv1beta2.RedundancyModeDouble))
+			Expect(hub.Spec.DatabaseConfiguration.UsableRegions).To(Equal(1))
+		})
+
+		It("should not change the cluster", func() {
+			converted := &FoundationDBCluster{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "FoundationDBCluster",
+					APIVersion: GroupVersion.String(),
+				},
+			}
+			Expect(converted.ConvertFrom(hub)).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(spoke))
+		})
+	})
+})
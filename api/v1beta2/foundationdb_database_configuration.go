@@ -54,6 +54,39 @@ type DatabaseConfiguration struct {
 	// +kubebuilder:validation:MaxItems=1024
 	ExcludedServers []ExcludedServers `json:"excluded_servers,omitempty"`
 
+	// PerpetualStorageWiggle defines if the perpetual storage wiggle should be enabled. A value of 1 enables the
+	// perpetual storage wiggle and a value of 0 disables it. If unset the operator will not change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	PerpetualStorageWiggle *int `json:"perpetual_storage_wiggle,omitempty"`
+
+	// PerpetualStorageWiggleLocality restricts the perpetual storage wiggle to the storage servers matching the
+	// locality in the format <key>:<value>. A value of "0" removes the restriction. If unset the operator will not
+	// change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=200
+	PerpetualStorageWiggleLocality *string `json:"perpetual_storage_wiggle_locality,omitempty"`
+
+	// StorageMigrationType defines how storage servers with a different storage engine than the configured storage
+	// engine will be migrated. If unset the operator will not change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=disabled;aggressive;gradual
+	StorageMigrationType *StorageMigrationType `json:"storage_migration_type,omitempty"`
+
+	// PerpetualStorageWiggleEngine defines the storage engine that storage servers will use after they have been
+	// wiggled. A value of none will use the configured storage engine. If unset the operator will not change the
+	// current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=none;ssd;ssd-1;ssd-2;memory;memory-1;memory-2;ssd-redwood-1-experimental;ssd-redwood-1;ssd-rocksdb-experimental;ssd-rocksdb-v1;ssd-sharded-rocksdb;memory-radixtree-beta;custom
+	PerpetualStorageWiggleEngine *StorageEngine `json:"perpetual_storage_wiggle_engine,omitempty"`
+
+	// TenantMode defines if tenants are disabled, optional or required for all transactions. If unset the operator
+	// will not change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=disabled;optional_experimental;required_experimental
+	TenantMode *TenantMode `json:"tenant_mode,omitempty"`
+
 	// RoleCounts defines how many processes the database should recruit for
 	// each role.
 	RoleCounts `json:""`
@@ -160,6 +193,84 @@ func (configuration *DatabaseConfiguration) FailOver() DatabaseConfiguration {
 	return *newConfiguration
 }
 
+// GetPrimaryDataCenter returns the ID of the main data center with the highest priority. If no regions are defined an
+// empty string will be returned.
+func (configuration DatabaseConfiguration) GetPrimaryDataCenter() string {
+	var primary string
+	primaryPriority := 0
+
+	for _, region := range configuration.Regions {
+		id, priority := getMainDataCenter(region)
+		if id == "" {
+			continue
+		}
+
+		if primary == "" || priority > primaryPriority {
+			primary = id
+			primaryPriority = priority
+		}
+	}
+
+	return primary
+}
+
+// GetFailoverDataCenter returns the ID of the main data center with the highest priority besides the primary data
+// center. Data centers with a negative priority will never be used as primary, so they will not be returned. If no such
+// data center exists an empty string will be returned.
+func (configuration DatabaseConfiguration) GetFailoverDataCenter() string {
+	primary := configuration.GetPrimaryDataCenter()
+
+	var failover string
+	failoverPriority := 0
+
+	for _, region := range configuration.Regions {
+		id, priority := getMainDataCenter(region)
+		if id == "" || id == primary || priority < 0 {
+			continue
+		}
+
+		if failover == "" || priority > failoverPriority {
+			failover = id
+			failoverPriority = priority
+		}
+	}
+
+	return failover
+}
+
+// FailOverTo returns a new DatabaseConfiguration where the provided main data center is the primary data center. The
+// priorities of the current primary data center and the provided data center will be switched. In contrast to FailOver
+// calling this method multiple times will return the same configuration.
+func (configuration DatabaseConfiguration) FailOverTo(dataCenterID string) DatabaseConfiguration {
+	newConfiguration := configuration.DeepCopy()
+	primary := configuration.GetPrimaryDataCenter()
+	if primary == "" || primary == dataCenterID {
+		return *newConfiguration
+	}
+
+	priorities := configuration.getRegionPriorities()
+	newPriority, ok := priorities[dataCenterID]
+	if !ok {
+		return *newConfiguration
+	}
+
+	for regionIndex, region := range newConfiguration.Regions {
+		for dataCenterIndex, dataCenter := range region.DataCenters {
+			if dataCenter.Satellite != 0 {
+				continue
+			}
+
+			if dataCenter.ID == primary {
+				newConfiguration.Regions[regionIndex].DataCenters[dataCenterIndex].Priority = newPriority
+			} else if dataCenter.ID == dataCenterID {
+				newConfiguration.Regions[regionIndex].DataCenters[dataCenterIndex].Priority = priorities[primary]
+			}
+		}
+	}
+
+	return *newConfiguration
+}
+
 // NormalizeConfiguration ensures a standardized format and defaults when
 // comparing database configuration in the cluster spec with database
 // configuration in the cluster status.
@@ -659,6 +770,31 @@ func (configuration DatabaseConfiguration) GetConfigurationString(version string
 		}
 	}
 
+	if configuration.PerpetualStorageWiggle != nil {
+		configurationString.WriteString(" perpetual_storage_wiggle=")
+		configurationString.WriteString(strconv.Itoa(*configuration.PerpetualStorageWiggle))
+	}
+
+	if configuration.PerpetualStorageWiggleLocality != nil {
+		configurationString.WriteString(" perpetual_storage_wiggle_locality=")
+		configurationString.WriteString(*configuration.PerpetualStorageWiggleLocality)
+	}
+
+	if configuration.StorageMigrationType != nil {
+		configurationString.WriteString(" storage_migration_type=")
+		configurationString.WriteString(string(*configuration.StorageMigrationType))
+	}
+
+	if configuration.PerpetualStorageWiggleEngine != nil {
+		configurationString.WriteString(" perpetual_storage_wiggle_engine=")
+		configurationString.WriteString(string(*configuration.PerpetualStorageWiggleEngine))
+	}
+
+	if configuration.TenantMode != nil {
+		configurationString.WriteString(" tenant_mode=")
+		configurationString.WriteString(string(*configuration.TenantMode))
+	}
+
 	var regionString string
 	if configuration.Regions == nil {
 		regionString = "[]"
@@ -778,6 +914,37 @@ const (
 	StorageEngineRedwood1Experimental StorageEngine = "ssd-redwood-1-experimental"
 	// StorageEngineRedwood1 defines the storage engine ssd-redwood-1.
 	StorageEngineRedwood1 StorageEngine = "ssd-redwood-1"
+	// StorageEngineNone defines that no storage engine is set, this is only valid for the perpetual storage wiggle
+	// engine.
+	StorageEngineNone StorageEngine = "none"
+)
+
+// StorageMigrationType defines how storage servers with a different storage engine will be migrated.
+// +kubebuilder:validation:MaxLength=100
+type StorageMigrationType string
+
+const (
+	// StorageMigrationTypeDisabled defines that storage servers will not be migrated.
+	StorageMigrationTypeDisabled StorageMigrationType = "disabled"
+	// StorageMigrationTypeAggressive defines that all storage servers with a different storage engine will be migrated
+	// at once.
+	StorageMigrationTypeAggressive StorageMigrationType = "aggressive"
+	// StorageMigrationTypeGradual defines that storage servers with a different storage engine will be migrated by the
+	// perpetual storage wiggle.
+	StorageMigrationTypeGradual StorageMigrationType = "gradual"
+)
+
+// TenantMode defines if tenants are disabled, optional or required for all transactions.
+// +kubebuilder:validation:MaxLength=100
+type TenantMode string
+
+const (
+	// TenantModeDisabled defines that tenants can't be used.
+	TenantModeDisabled TenantMode = "disabled"
+	// TenantModeOptionalExperimental defines that transactions can use tenants but are not required to use them.
+	TenantModeOptionalExperimental TenantMode = "optional_experimental"
+	// TenantModeRequiredExperimental defines that all normal transactions must use a tenant.
+	TenantModeRequiredExperimental TenantMode = "required_experimental"
 )
 
 // RoleCounts represents the roles whose counts can be customized.
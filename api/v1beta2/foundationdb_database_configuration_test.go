@@ -156,6 +156,45 @@ var _ = Describe("DatabaseConfiguration", func() {
 				Expect(newConfig.GetConfigurationString(Versions.Default.String())).To(Equal("triple ssd usable_regions=1 logs=3 resolvers=1 log_routers=0 remote_logs=0 proxies=3 regions=[{\\\"datacenters\\\":[{\\\"id\\\":\\\"primary\\\"},{\\\"id\\\":\\\"primary-sat\\\",\\\"priority\\\":1,\\\"satellite\\\":1}],\\\"satellite_logs\\\":3,\\\"satellite_redundancy_mode\\\":\\\"one_satellite_single\\\"},{\\\"datacenters\\\":[{\\\"id\\\":\\\"remote\\\",\\\"priority\\\":1},{\\\"id\\\":\\\"remote-sat\\\",\\\"priority\\\":1,\\\"satellite\\\":1}],\\\"satellite_logs\\\":3,\\\"satellite_redundancy_mode\\\":\\\"one_satellite_double\\\"}]"))
 			})
 		})
+		When("getting the primary and the failover data center", func() {
+			It("should return the main data centers", func() {
+				Expect(config.GetPrimaryDataCenter()).To(Equal("primary"))
+				Expect(config.GetFailoverDataCenter()).To(Equal("remote"))
+			})
+
+			When("the remote data center has a negative priority", func() {
+				BeforeEach(func() {
+					config.Regions[1].DataCenters[0].Priority = -1
+				})
+
+				It("should not return a failover data center", func() {
+					Expect(config.GetPrimaryDataCenter()).To(Equal("primary"))
+					Expect(config.GetFailoverDataCenter()).To(BeEmpty())
+				})
+			})
+		})
+
+		When("failing over to the remote data center", func() {
+			It("should switch the priorities of the main data centers", func() {
+				newConfig := config.FailOverTo("remote")
+				Expect(newConfig.GetPrimaryDataCenter()).To(Equal("remote"))
+				Expect(newConfig.Regions[0].DataCenters[0].Priority).To(Equal(0))
+				Expect(newConfig.Regions[0].DataCenters[1].Priority).To(Equal(1))
+				Expect(newConfig.Regions[1].DataCenters[0].Priority).To(Equal(1))
+				Expect(newConfig.Regions[1].DataCenters[1].Priority).To(Equal(1))
+			})
+
+			It("should not change the configuration if the data center is already the primary", func() {
+				newConfig := config.FailOverTo("remote")
+				Expect(newConfig.FailOverTo("remote")).To(Equal(newConfig))
+				Expect(config.FailOverTo("primary")).To(Equal(*config))
+			})
+
+			It("should not change the configuration for an unknown data center", func() {
+				Expect(config.FailOverTo("remote-sat")).To(Equal(*config))
+				Expect(config.FailOverTo("unknown")).To(Equal(*config))
+			})
+		})
 	})
 
 	When("a three_data_hall cluster with the default values is provided", func() {
@@ -0,0 +1,254 @@
+/*
+ * foundationdb_knob.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FoundationDBKnob defines a knob that should be set for the fdbserver processes.
+type FoundationDBKnob struct {
+	// Name defines the name of the knob without the knob_ prefix, e.g. max_trace_lines.
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:Pattern=^[a-z0-9_]+$
+	Name string `json:"name"`
+
+	// Value defines the value of the knob. The value must match the type of the knob in the knob catalog.
+	// +kubebuilder:validation:MaxLength=1000
+	Value string `json:"value"`
+}
+
+// KnobType defines the type of the value of a knob.
+type KnobType string
+
+const (
+	// KnobTypeInt64 defines a knob with an integer value.
+	KnobTypeInt64 KnobType = "int64"
+	// KnobTypeDouble defines a knob with a floating point value.
+	KnobTypeDouble KnobType = "double"
+	// KnobTypeBool defines a knob with a boolean value.
+	KnobTypeBool KnobType = "bool"
+	// KnobTypeString defines a knob with a string value.
+	KnobTypeString KnobType = "string"
+)
+
+// KnobDefinition describes a knob in the knob catalog.
+type KnobDefinition struct {
+	// Name defines the name of the knob without the knob_ prefix.
+	Name string
+
+	// Type defines the type of the value of the knob.
+	Type KnobType
+
+	// LiveUpdatable defines if the knob can be changed on running processes through the configuration database. All
+	// other knobs require a restart of the processes.
+	LiveUpdatable bool
+
+	// MinimumVersion defines the first version that supports the knob.
+	MinimumVersion Version
+
+	// RemovedVersion defines the first version that doesn't support the knob anymore. If nil the knob is supported
+	// by all versions since the minimum version.
+	RemovedVersion *Version
+}
+
+// configurationDatabaseEnabled defines if the operator starts the fdbserver processes with the configuration database
+// enabled. Knobs that are set through the configuration database have no effect on processes that don't use it, so as
+// long as the configuration database is not enabled all knobs are passed as command line arguments.
+const configurationDatabaseEnabled = false
+
+// knobCatalog contains the knobs that can be managed in the knobs section of the process settings. Knobs that are not
+// part of the catalog can still be set with the custom parameters.
+var knobCatalog = []KnobDefinition{
+	{Name: "dd_shard_size_granularity", Type: KnobTypeInt64, MinimumVersion: Versions.MinimumVersion},
+	{Name: "disable_posix_kernel_aio", Type: KnobTypeInt64, MinimumVersion: Versions.MinimumVersion},
+	{Name: "fetch_keys_parallelism_bytes", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "max_storage_commit_time", Type: KnobTypeDouble, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "max_trace_lines", Type: KnobTypeInt64, MinimumVersion: Versions.MinimumVersion},
+	{Name: "max_transactions_per_byte", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "min_available_space_ratio", Type: KnobTypeDouble, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "min_trace_severity", Type: KnobTypeInt64, MinimumVersion: Versions.MinimumVersion},
+	{Name: "redwood_default_page_size", Type: KnobTypeInt64, MinimumVersion: Version{Major: 7, Minor: 0, Patch: 0}},
+	{Name: "rocksdb_block_cache_size", Type: KnobTypeInt64, MinimumVersion: Version{Major: 7, Minor: 1, Patch: 0}},
+	{Name: "spring_bytes_storage_server", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "spring_bytes_tlog", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "storage_hard_limit_bytes", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "target_bytes_per_storage_server", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+	{Name: "target_bytes_per_tlog", Type: KnobTypeInt64, LiveUpdatable: true, MinimumVersion: Versions.MinimumVersion},
+}
+
+// GetKnobDefinition returns the definition of the knob with the provided name for the provided version. If the knob is
+// not part of the knob catalog or not supported by the provided version, nil will be returned.
+func GetKnobDefinition(name string, version Version) *KnobDefinition {
+	for _, definition := range knobCatalog {
+		if definition.Name != name {
+			continue
+		}
+
+		if !version.IsAtLeast(definition.MinimumVersion) {
+			return nil
+		}
+
+		if definition.RemovedVersion != nil && version.IsAtLeast(*definition.RemovedVersion) {
+			return nil
+		}
+
+		return &definition
+	}
+
+	return nil
+}
+
+// IsLiveUpdatable returns true if the knob can be changed without a restart of the processes for the provided version.
+// This requires that the knob is marked as live-updatable in the knob catalog, that the version supports the
+// configuration database and that the processes are started with the configuration database enabled.
+func (knob FoundationDBKnob) IsLiveUpdatable(version Version) bool {
+	if !configurationDatabaseEnabled || !version.SupportsDynamicKnobs() {
+		return false
+	}
+
+	definition := GetKnobDefinition(knob.Name, version)
+	if definition == nil {
+		return false
+	}
+
+	return definition.LiveUpdatable
+}
+
+// validateValue checks if the provided value matches the type of the knob.
+func (definition KnobDefinition) validateValue(value string) error {
+	var err error
+	switch definition.Type {
+	case KnobTypeInt64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case KnobTypeDouble:
+		_, err = strconv.ParseFloat(value, 64)
+	case KnobTypeBool:
+		_, err = strconv.ParseBool(value)
+	case KnobTypeString:
+		if strings.ContainsAny(value, " \t\n") {
+			err = fmt.Errorf("value must not contain whitespaces")
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("knob %s has an invalid %s value %q", definition.Name, definition.Type, value)
+	}
+
+	return nil
+}
+
+// ValidateKnobs checks that all knobs are part of the knob catalog for the provided version, that the values match the
+// type of the knobs, that no knob is defined twice and that no knob is also set in the provided custom parameters.
+func ValidateKnobs(knobs []FoundationDBKnob, customParameters FoundationDBCustomParameters, version Version) error {
+	customKnobs := make(map[string]None, len(customParameters))
+	for _, parameter := range customParameters {
+		parameterName := strings.TrimSpace(strings.Split(string(parameter), "=")[0])
+		customKnobs[strings.TrimPrefix(parameterName, "knob_")] = None{}
+	}
+
+	knobNames := make(map[string]None, len(knobs))
+	violations := make([]string, 0)
+	for _, knob := range knobs {
+		if _, ok := knobNames[knob.Name]; ok {
+			violations = append(violations, fmt.Sprintf("found duplicated knob: %s", knob.Name))
+		}
+		knobNames[knob.Name] = None{}
+
+		if _, ok := customKnobs[knob.Name]; ok {
+			violations = append(violations, fmt.Sprintf("knob %s is also defined in the customParameters", knob.Name))
+		}
+
+		definition := GetKnobDefinition(knob.Name, version)
+		if definition == nil {
+			violations = append(violations, fmt.Sprintf("knob %s is not supported on version %s", knob.Name, version))
+			continue
+		}
+
+		err := definition.validateValue(knob.Value)
+		if err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("found the following knob violations:\n%s", strings.Join(violations, "\n"))
+	}
+
+	return nil
+}
+
+// SplitKnobsByRestart splits the knobs into the knobs that can be updated without a restart and the knobs that
+// require a restart of the processes for the provided version. Both slices are sorted by the name of the knobs.
+func SplitKnobsByRestart(knobs []FoundationDBKnob, version Version) ([]FoundationDBKnob, []FoundationDBKnob) {
+	var live, restart []FoundationDBKnob
+	for _, knob := range knobs {
+		if knob.IsLiveUpdatable(version) {
+			live = append(live, knob)
+			continue
+		}
+
+		restart = append(restart, knob)
+	}
+
+	sortKnobs(live)
+	sortKnobs(restart)
+
+	return live, restart
+}
+
+// sortKnobs sorts the knobs by their name.
+func sortKnobs(knobs []FoundationDBKnob) {
+	sort.Slice(knobs, func(i, j int) bool {
+		return knobs[i].Name < knobs[j].Name
+	})
+}
+
+// ProcessClassKnobStatus describes the effective knobs of the processes of a process class.
+type ProcessClassKnobStatus struct {
+	// ProcessClass defines the process class of the processes.
+	ProcessClass ProcessClass `json:"processClass"`
+
+	// Knobs contains the knobs that are in effect for all processes of the process class.
+	// +kubebuilder:validation:MaxItems=200
+	Knobs []FoundationDBKnobStatus `json:"knobs,omitempty"`
+
+	// PendingRestart contains the names of the knobs that require a restart of at least one process of the process
+	// class before they are in effect.
+	// +kubebuilder:validation:MaxItems=200
+	PendingRestart []string `json:"pendingRestart,omitempty"`
+}
+
+// FoundationDBKnobStatus describes a knob that is in effect.
+type FoundationDBKnobStatus struct {
+	// Name defines the name of the knob without the knob_ prefix.
+	Name string `json:"name"`
+
+	// Value defines the value of the knob.
+	Value string `json:"value"`
+
+	// LiveUpdated is true if the knob was set through the configuration database instead of the command line of the
+	// processes.
+	LiveUpdated bool `json:"liveUpdated,omitempty"`
+}
@@ -0,0 +1,136 @@
+/*
+ * foundationdb_knob_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FoundationDBKnob", func() {
+	When("Validating the knobs", func() {
+		DescribeTable("should return the expected violations",
+			func(knobs []FoundationDBKnob, customParameters FoundationDBCustomParameters, version Version, expected error) {
+				err := ValidateKnobs(knobs, customParameters, version)
+
+				if expected == nil {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(Equal(expected))
+				}
+			},
+			Entry("no knobs",
+				nil,
+				nil,
+				Versions.Default,
+				nil),
+			Entry("valid knobs",
+				[]FoundationDBKnob{
+					{Name: "max_trace_lines", Value: "100000"},
+					{Name: "min_available_space_ratio", Value: "0.05"},
+				},
+				FoundationDBCustomParameters{
+					"knob_disable_posix_kernel_aio=1",
+				},
+				Versions.Default,
+				nil),
+			Entry("unknown knob",
+				[]FoundationDBKnob{
+					{Name: "unknown_knob", Value: "1"},
+				},
+				nil,
+				Versions.Default,
+				errors.New("found the following knob violations:\nknob unknown_knob is not supported on version 6.2.21")),
+			Entry("knob that is not supported by the version",
+				[]FoundationDBKnob{
+					{Name: "rocksdb_block_cache_size", Value: "1000"},
+				},
+				nil,
+				Versions.Default,
+				errors.New("found the following knob violations:\nknob rocksdb_block_cache_size is not supported on version 6.2.21")),
+			Entry("command line option that is not a knob",
+				[]FoundationDBKnob{
+					{Name: "trace_format", Value: "json"},
+				},
+				nil,
+				Versions.Default,
+				errors.New("found the following knob violations:\nknob trace_format is not supported on version 6.2.21")),
+			Entry("knob with an invalid value",
+				[]FoundationDBKnob{
+					{Name: "max_trace_lines", Value: "many"},
+				},
+				nil,
+				Versions.Default,
+				errors.New("found the following knob violations:\nknob max_trace_lines has an invalid int64 value \"many\"")),
+			Entry("duplicate knobs",
+				[]FoundationDBKnob{
+					{Name: "max_trace_lines", Value: "1"},
+					{Name: "max_trace_lines", Value: "2"},
+				},
+				nil,
+				Versions.Default,
+				errors.New("found the following knob violations:\nfound duplicated knob: max_trace_lines")),
+			Entry("knob that is also defined in the custom parameters",
+				[]FoundationDBKnob{
+					{Name: "max_trace_lines", Value: "1"},
+				},
+				FoundationDBCustomParameters{
+					"knob_max_trace_lines = 2",
+				},
+				Versions.Default,
+				errors.New("found the following knob violations:\nknob max_trace_lines is also defined in the customParameters")),
+		)
+	})
+
+	When("splitting the knobs by restart", func() {
+		var knobs []FoundationDBKnob
+
+		BeforeEach(func() {
+			knobs = []FoundationDBKnob{
+				{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+				{Name: "max_trace_lines", Value: "100000"},
+				{Name: "max_storage_commit_time", Value: "120.0"},
+			}
+		})
+
+		It("should require a restart for all knobs if dynamic knobs are not supported", func() {
+			live, restart := SplitKnobsByRestart(knobs, Versions.Default)
+			Expect(live).To(BeEmpty())
+			Expect(restart).To(Equal([]FoundationDBKnob{
+				{Name: "max_storage_commit_time", Value: "120.0"},
+				{Name: "max_trace_lines", Value: "100000"},
+				{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+			}))
+		})
+
+		It("should require a restart for all knobs as long as the configuration database is not enabled", func() {
+			live, restart := SplitKnobsByRestart(knobs, Versions.SupportsDynamicKnobs)
+			Expect(live).To(BeEmpty())
+			Expect(restart).To(Equal([]FoundationDBKnob{
+				{Name: "max_storage_commit_time", Value: "120.0"},
+				{Name: "max_trace_lines", Value: "100000"},
+				{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+			}))
+		})
+	})
+})
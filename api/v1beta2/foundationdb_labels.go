@@ -40,6 +40,14 @@ const (
 	// BackupDeploymentPodLabel provides the label to select Pods for a specific Backup deployment.
 	BackupDeploymentPodLabel = "foundationdb.org/deployment-name"
 
+	// DisasterRecoveryDeploymentLabel provides the label we use to connect DR
+	// agent deployments to a disaster recovery.
+	DisasterRecoveryDeploymentLabel = "foundationdb.org/disaster-recovery-for"
+
+	// MultiRegionClusterLabel provides the label we use to connect the
+	// clusters of the data centers to a multi-region cluster.
+	MultiRegionClusterLabel = "foundationdb.org/multi-region-cluster"
+
 	// PublicIPSourceAnnotation is an annotation key that specifies where a pod
 	// gets its public IP from.
 	PublicIPSourceAnnotation = "foundationdb.org/public-ip-source"
@@ -52,6 +60,18 @@ const (
 	// The information is fetched from Pod.Spec.NodeName of the Pod resource.
 	NodeAnnotation = "foundationdb.org/current-node"
 
+	// DryRunAnnotation is an annotation key that enables the dry-run mode for a cluster when set to "true". In
+	// dry-run mode the operator only records the actions it would perform in the plan ConfigMap.
+	DryRunAnnotation = "foundationdb.org/dry-run"
+
+	// CanaryPromotionAnnotation is an annotation key that promotes a halted canary rollout when set to the generation
+	// of the canary rollout.
+	CanaryPromotionAnnotation = "foundationdb.org/promote-canary"
+
+	// AcknowledgeUpgradeRollbackAnnotation is an annotation key that acknowledges an upgrade rollback when set to the
+	// failed version. Until then the failed version is locked out.
+	AcknowledgeUpgradeRollbackAnnotation = "foundationdb.org/acknowledge-upgrade-rollback"
+
 	// FDBProcessGroupIDLabel represents the label that is used to represent a instance ID
 	FDBProcessGroupIDLabel = "foundationdb.org/fdb-process-group-id"
 
@@ -0,0 +1,110 @@
+/*
+ * foundationdb_plan.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PlanConfigMapKey defines the key in the plan ConfigMap that contains the serialized ReconciliationPlan.
+	PlanConfigMapKey = "plan.json"
+)
+
+// ReconciliationPlan describes the actions the operator would perform for a cluster, when the cluster is in
+// dry-run mode.
+type ReconciliationPlan struct {
+	// Generation of the cluster spec that this plan was created for.
+	Generation int64 `json:"generation"`
+
+	// Timestamp defines when this plan was created.
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// Actions contains the actions that the operator would perform in the order of execution.
+	Actions []PlannedAction `json:"actions,omitempty"`
+}
+
+// PlannedAction describes a single action that the operator would perform.
+type PlannedAction struct {
+	// SubReconciler is the name of the sub-reconciler that would perform this action.
+	SubReconciler string `json:"subReconciler"`
+
+	// Type defines the kind of action.
+	Type PlannedActionType `json:"type"`
+
+	// Target defines the resource, process group or the processes the action would be performed on.
+	Target string `json:"target,omitempty"`
+
+	// Message provides additional details about the action.
+	Message string `json:"message,omitempty"`
+}
+
+// PlannedActionType represents the type of action that the operator would perform.
+type PlannedActionType string
+
+const (
+	// PlannedActionCreate represents the creation of a Kubernetes resource.
+	PlannedActionCreate PlannedActionType = "Create"
+	// PlannedActionUpdate represents an update of a Kubernetes resource.
+	PlannedActionUpdate PlannedActionType = "Update"
+	// PlannedActionPatch represents a patch of a Kubernetes resource.
+	PlannedActionPatch PlannedActionType = "Patch"
+	// PlannedActionDelete represents the deletion of a Kubernetes resource.
+	PlannedActionDelete PlannedActionType = "Delete"
+	// PlannedActionRemoveProcessGroup represents a process group that would be marked for removal.
+	PlannedActionRemoveProcessGroup PlannedActionType = "RemoveProcessGroup"
+	// PlannedActionConfigureDatabase represents a change of the database configuration.
+	PlannedActionConfigureDatabase PlannedActionType = "ConfigureDatabase"
+	// PlannedActionExclude represents the exclusion of processes.
+	PlannedActionExclude PlannedActionType = "Exclude"
+	// PlannedActionInclude represents the inclusion of processes.
+	PlannedActionInclude PlannedActionType = "Include"
+	// PlannedActionKill represents the restart of processes.
+	PlannedActionKill PlannedActionType = "Kill"
+	// PlannedActionChangeCoordinators represents a change of the coordinators.
+	PlannedActionChangeCoordinators PlannedActionType = "ChangeCoordinators"
+	// PlannedActionMaintenanceMode represents a change of the maintenance mode.
+	PlannedActionMaintenanceMode PlannedActionType = "MaintenanceMode"
+	// PlannedActionUpdateDenyList represents an update of the lock deny list.
+	PlannedActionUpdateDenyList PlannedActionType = "UpdateDenyList"
+	// PlannedActionUpdateKnobs represents a change of the knobs in the configuration database.
+	PlannedActionUpdateKnobs PlannedActionType = "UpdateKnobs"
+	// PlannedActionCreateTenant represents the creation of a tenant.
+	PlannedActionCreateTenant PlannedActionType = "CreateTenant"
+	// PlannedActionDeleteTenant represents the deletion of a tenant.
+	PlannedActionDeleteTenant PlannedActionType = "DeleteTenant"
+	// PlannedActionRequeue represents a sub-reconciler that would requeue the reconciliation. Actions that are
+	// planned after a requeue might only be performed in a later reconciliation.
+	PlannedActionRequeue PlannedActionType = "Requeue"
+)
+
+// IsDryRun returns true if the cluster has the dry-run annotation set to true. In dry-run mode the operator will
+// not perform any changes and only records the actions it would perform.
+func (cluster *FoundationDBCluster) IsDryRun() bool {
+	return cluster.GetAnnotations()[DryRunAnnotation] == "true"
+}
+
+// GetPlanConfigMapName returns the name of the ConfigMap that contains the plan of the cluster in dry-run mode.
+func (cluster *FoundationDBCluster) GetPlanConfigMapName() string {
+	return fmt.Sprintf("%s-plan", cluster.Name)
+}
@@ -121,6 +121,9 @@ type FoundationDBStatusClusterInfo struct {
 	// Qos provides information about various qos metrics of the cluster.
 	Qos FoundationDBStatusQosInfo `json:"qos,omitempty"`
 
+	// DataCenterLag provides information about how far the remote data center is behind the primary data center.
+	DataCenterLag FoundationDBStatusLagInfo `json:"datacenter_lag,omitempty"`
+
 	// FaultTolerance provides information about the fault tolerance status
 	// of the cluster.
 	FaultTolerance FaultTolerance `json:"fault_tolerance,omitempty"`
@@ -140,6 +143,75 @@ type FoundationDBStatusClusterInfo struct {
 
 	// BounceImpact represents the bounce_impact part of the machine-readable status.
 	BounceImpact FoundationDBBounceImpact `json:"bounce_impact,omitempty"`
+
+	// StorageWiggler provides information about the progress of the perpetual storage wiggle.
+	StorageWiggler FoundationDBStatusStorageWiggler `json:"storage_wiggler,omitempty"`
+
+	// Workload provides information about the workload of the database.
+	Workload FoundationDBStatusWorkload `json:"workload,omitempty"`
+}
+
+// FoundationDBStatusWorkload provides information about the workload of the database.
+type FoundationDBStatusWorkload struct {
+	// Transactions provides the transaction rates of the database.
+	Transactions FoundationDBStatusTransactions `json:"transactions,omitempty"`
+}
+
+// FoundationDBStatusTransactions provides the transaction rates of the database.
+type FoundationDBStatusTransactions struct {
+	// Started provides the rate of started transactions.
+	Started FoundationDBStatusRate `json:"started,omitempty"`
+
+	// Committed provides the rate of committed transactions.
+	Committed FoundationDBStatusRate `json:"committed,omitempty"`
+
+	// Conflicted provides the rate of transactions that were rejected because of a conflict.
+	Conflicted FoundationDBStatusRate `json:"conflicted,omitempty"`
+}
+
+// FoundationDBStatusRate provides a counter and the rate of its changes.
+type FoundationDBStatusRate struct {
+	// Counter defines the total number of events.
+	Counter int `json:"counter,omitempty"`
+
+	// Hz defines the number of events per second.
+	Hz float64 `json:"hz,omitempty"`
+}
+
+// FoundationDBStatusStorageWiggler represents the storage_wiggler part of the machine-readable status.
+type FoundationDBStatusStorageWiggler struct {
+	// Primary provides the wiggle statistics of the primary region.
+	Primary *FoundationDBStatusStorageWigglerStats `json:"primary,omitempty"`
+
+	// Remote provides the wiggle statistics of the remote region.
+	Remote *FoundationDBStatusStorageWigglerStats `json:"remote,omitempty"`
+
+	// WiggleServerIDs contains the IDs of the storage servers that are currently wiggled.
+	WiggleServerIDs []string `json:"wiggle_server_ids,omitempty"`
+
+	// WiggleServerAddresses contains the addresses of the storage servers that are currently wiggled.
+	WiggleServerAddresses []string `json:"wiggle_server_addresses,omitempty"`
+}
+
+// FoundationDBStatusStorageWigglerStats provides the wiggle statistics of a single region.
+type FoundationDBStatusStorageWigglerStats struct {
+	// FinishedRound is the number of finished wiggle rounds.
+	FinishedRound int `json:"finished_round,omitempty"`
+
+	// FinishedWiggle is the number of wiggled storage servers.
+	FinishedWiggle int `json:"finished_wiggle,omitempty"`
+
+	// LastRoundStartTimestamp is the unix timestamp when the last round was started.
+	LastRoundStartTimestamp float64 `json:"last_round_start_timestamp,omitempty"`
+
+	// LastRoundFinishTimestamp is the unix timestamp when the last round was finished.
+	LastRoundFinishTimestamp float64 `json:"last_round_finish_timestamp,omitempty"`
+
+	// SmoothedRoundSeconds is the smoothed duration of a wiggle round.
+	SmoothedRoundSeconds float64 `json:"smoothed_round_seconds,omitempty"`
+
+	// SmoothedWiggleSeconds is the smoothed duration of wiggling a single storage server.
+	SmoothedWiggleSeconds float64 `json:"smoothed_wiggle_seconds,omitempty"`
 }
 
 // FoundationDBBounceImpact represents the bounce_impact part of the machine-readable status.
@@ -183,6 +255,9 @@ type FoundationDBStatusProcessInfo struct {
 	// The time that the process has been up for.
 	UptimeSeconds float64 `json:"uptime_seconds,omitempty"`
 
+	// CPU provides the CPU usage of the process.
+	CPU FoundationDBStatusCPUStatistics `json:"cpu,omitempty"`
+
 	// Roles contains a slice of all roles of the process
 	Roles []FoundationDBStatusProcessRoleInfo `json:"roles,omitempty"`
 
@@ -190,6 +265,12 @@ type FoundationDBStatusProcessInfo struct {
 	Messages []FoundationDBStatusProcessMessage `json:"messages,omitempty"`
 }
 
+// FoundationDBStatusCPUStatistics provides the CPU usage of a process.
+type FoundationDBStatusCPUStatistics struct {
+	// UsageCores defines the number of cores that are used by the process.
+	UsageCores float64 `json:"usage_cores,omitempty"`
+}
+
 // FoundationDBStatusProcessMessage represents an error message in the status json
 type FoundationDBStatusProcessMessage struct {
 	// Time when the error was observed
@@ -207,8 +288,22 @@ type FoundationDBStatusProcessRoleInfo struct {
 	Role string `json:"role,omitempty"`
 	// StoredBytes defines the number of bytes that are currently stored for this process.
 	StoredBytes int `json:"stored_bytes,omitempty"`
+	// KVStoreUsedBytes defines the number of bytes that are used on the disk of the key-value store of this role.
+	KVStoreUsedBytes int `json:"kvstore_used_bytes,omitempty"`
+	// KVStoreTotalBytes defines the total number of bytes of the disk of the key-value store of this role.
+	KVStoreTotalBytes int `json:"kvstore_total_bytes,omitempty"`
 	// ID represent the role ID.
 	ID string `json:"id,omitempty"`
+	// StorageMetadata provides additional information about a storage server.
+	StorageMetadata *FoundationDBStatusStorageMetadata `json:"storage_metadata,omitempty"`
+}
+
+// FoundationDBStatusStorageMetadata provides additional information about a storage server.
+type FoundationDBStatusStorageMetadata struct {
+	// CreatedTimeTimestamp is the unix timestamp when the storage server was created.
+	CreatedTimeTimestamp float64 `json:"created_time_timestamp,omitempty"`
+	// StorageEngine defines the storage engine the storage server is using.
+	StorageEngine StorageEngine `json:"storage_engine,omitempty"`
 }
 
 // FoundationDBStatusDataStatistics provides information about the data in
@@ -106,10 +106,13 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 2955.58,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0370445},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
-									Role: string(ProcessRoleLog),
-									ID:   "c686af4e20478a38",
+									Role:              string(ProcessRoleLog),
+									ID:                "c686af4e20478a38",
+									KVStoreUsedBytes:  104861752,
+									KVStoreTotalBytes: 8396963840,
 								},
 							},
 							Messages: []FoundationDBStatusProcessMessage{},
@@ -130,14 +133,17 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 2475.33,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0494183},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
 									Role: string(ProcessRoleProxy),
 									ID:   "1e20b57ea43f9aa9",
 								},
 								{
-									Role: string(ProcessRoleStorage),
-									ID:   "6b11d7bb5c720b38",
+									Role:              string(ProcessRoleStorage),
+									ID:                "6b11d7bb5c720b38",
+									KVStoreUsedBytes:  104865792,
+									KVStoreTotalBytes: 8396963840,
 								},
 							},
 							Messages: []FoundationDBStatusProcessMessage{},
@@ -158,14 +164,17 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 2951.17,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0496311},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
 									Role: string(ProcessRoleProxy),
 									ID:   "780a7ea7433362a3",
 								},
 								{
-									Role: string(ProcessRoleStorage),
-									ID:   "c8e7fa2179a80035",
+									Role:              string(ProcessRoleStorage),
+									ID:                "c8e7fa2179a80035",
+									KVStoreUsedBytes:  104886472,
+									KVStoreTotalBytes: 8396963840,
 								},
 							},
 							Messages: []FoundationDBStatusProcessMessage{},
@@ -186,14 +195,17 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 710.119,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0553955},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
 									Role: string(ProcessRoleClusterController),
 									ID:   "6feba05132f0bdf7",
 								},
 								{
-									Role: string(ProcessRoleLog),
-									ID:   "863f6c6abfd9f1be",
+									Role:              string(ProcessRoleLog),
+									ID:                "863f6c6abfd9f1be",
+									KVStoreUsedBytes:  104861752,
+									KVStoreTotalBytes: 8396963840,
 								},
 							},
 							Messages: []FoundationDBStatusProcessMessage{},
@@ -214,6 +226,7 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 1095.18,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0185648},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
 									Role: string(ProcessRoleCoordinator),
@@ -241,6 +254,7 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 880.18,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0932934},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
 									Role: string(ProcessRoleMaster),
@@ -258,8 +272,10 @@ var _ = Describe("FoundationDBStatus", func() {
 									Role: string(ProcessRoleCoordinator),
 								},
 								{
-									Role: string(ProcessRoleLog),
-									ID:   "ec250c522d647c95",
+									Role:              string(ProcessRoleLog),
+									ID:                "ec250c522d647c95",
+									KVStoreUsedBytes:  104861752,
+									KVStoreTotalBytes: 8396963840,
 								},
 							},
 							Messages: []FoundationDBStatusProcessMessage{},
@@ -280,6 +296,7 @@ var _ = Describe("FoundationDBStatus", func() {
 							},
 							Version:       "6.2.15",
 							UptimeSeconds: 2650.5,
+							CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.057441799999999994},
 							Roles: []FoundationDBStatusProcessRoleInfo{
 								{
 									Role: string(ProcessRoleCoordinator),
@@ -289,8 +306,10 @@ var _ = Describe("FoundationDBStatus", func() {
 									ID:   "768542f56d94c64f",
 								},
 								{
-									Role: string(ProcessRoleStorage),
-									ID:   "06a581cc09ed3fb9",
+									Role:              string(ProcessRoleStorage),
+									ID:                "06a581cc09ed3fb9",
+									KVStoreUsedBytes:  104886472,
+									KVStoreTotalBytes: 8396963840,
 								},
 							},
 							Messages: []FoundationDBStatusProcessMessage{},
@@ -308,6 +327,13 @@ var _ = Describe("FoundationDBStatus", func() {
 						},
 					},
 					FullReplication: true,
+					Workload: FoundationDBStatusWorkload{
+						Transactions: FoundationDBStatusTransactions{
+							Started:    FoundationDBStatusRate{Counter: 2723, Hz: 3.39987},
+							Committed:  FoundationDBStatusRate{Counter: 104, Hz: 0.19999499999999998},
+							Conflicted: FoundationDBStatusRate{},
+						},
+					},
 					Clients: FoundationDBStatusClusterClientInfo{
 						Count: 8,
 						SupportedVersions: []FoundationDBStatusSupportedVersion{
@@ -508,18 +534,22 @@ var _ = Describe("FoundationDBStatus", func() {
 	})
 
 	When("parsing the status json with a 7.1.0-rc1 cluster", func() {
+		storageMigrationType := StorageMigrationTypeDisabled
 		status := FoundationDBStatusClusterInfo{
 			Messages:                []FoundationDBStatusMessage{},
 			IncompatibleConnections: []string{},
 			ConnectionString:        "test_cluster:aHeD9ocNXOUxi0dyzU3k7Bhg53SpyrBV@10.1.18.253:4501,10.1.18.254:4501,10.1.19.0:4501",
 			DatabaseConfiguration: DatabaseConfiguration{
-				RedundancyMode:  "double",
-				StorageEngine:   StorageEngineSSD2,
-				UsableRegions:   1,
-				Regions:         nil,
-				ExcludedServers: make([]ExcludedServers, 0),
-				RoleCounts:      RoleCounts{Storage: 0, Logs: 3, Proxies: 3, CommitProxies: 2, GrvProxies: 1, Resolvers: 1, LogRouters: -1, RemoteLogs: -1},
-				VersionFlags:    VersionFlags{LogSpill: 2, LogVersion: 0},
+				RedundancyMode:                 "double",
+				StorageEngine:                  StorageEngineSSD2,
+				UsableRegions:                  1,
+				Regions:                        nil,
+				ExcludedServers:                make([]ExcludedServers, 0),
+				PerpetualStorageWiggle:         pointer.Int(0),
+				PerpetualStorageWiggleLocality: pointer.String("0"),
+				StorageMigrationType:           &storageMigrationType,
+				RoleCounts:                     RoleCounts{Storage: 0, Logs: 3, Proxies: 3, CommitProxies: 2, GrvProxies: 1, Resolvers: 1, LogRouters: -1, RemoteLogs: -1},
+				VersionFlags:                   VersionFlags{LogSpill: 2, LogVersion: 0},
 			},
 			Processes: map[ProcessGroupID]FoundationDBStatusProcessInfo{
 				"eb48ada3a682e86363f06aa89e1041fa": {
@@ -538,6 +568,7 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0026,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.036252700000000006},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{Role: string(ProcessRoleCoordinator)},
 						{
@@ -545,8 +576,13 @@ var _ = Describe("FoundationDBStatus", func() {
 							ID:   "0de7f5c5e549cad1",
 						},
 						{
-							Role: string(ProcessRoleStorage),
-							ID:   "9941616400759d37",
+							Role:              string(ProcessRoleStorage),
+							ID:                "9941616400759d37",
+							KVStoreUsedBytes:  104878232,
+							KVStoreTotalBytes: 135012552704,
+							StorageMetadata: &FoundationDBStatusStorageMetadata{
+								CreatedTimeTimestamp: 1646933167898430464,
+							},
 						},
 					},
 					Messages: []FoundationDBStatusProcessMessage{},
@@ -567,11 +603,17 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0031,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0126458},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{Role: string(ProcessRoleCoordinator)},
 						{
-							Role: string(ProcessClassStorage),
-							ID:   "389c23d59a646e52",
+							Role:              string(ProcessClassStorage),
+							ID:                "389c23d59a646e52",
+							KVStoreUsedBytes:  104878232,
+							KVStoreTotalBytes: 135012552704,
+							StorageMetadata: &FoundationDBStatusStorageMetadata{
+								CreatedTimeTimestamp: 1646933167898430464,
+							},
 						},
 						{
 							Role: string(ProcessRoleResolver),
@@ -596,6 +638,7 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0029,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.016351300000000003},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{Role: string(ProcessRoleCoordinator)},
 						{
@@ -603,8 +646,13 @@ var _ = Describe("FoundationDBStatus", func() {
 							ID:   "0eb90e4a0ece85b3",
 						},
 						{
-							Role: string(ProcessRoleStorage),
-							ID:   "b5e42e100018bf11",
+							Role:              string(ProcessRoleStorage),
+							ID:                "b5e42e100018bf11",
+							KVStoreUsedBytes:  104861752,
+							KVStoreTotalBytes: 135012552704,
+							StorageMetadata: &FoundationDBStatusStorageMetadata{
+								CreatedTimeTimestamp: 1646933168295447808,
+							},
 						},
 					},
 					Messages: []FoundationDBStatusProcessMessage{},
@@ -625,6 +673,7 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0027,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0418108},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{
 							Role: string(ProcessRoleMaster),
@@ -657,6 +706,7 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0029,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.011798900000000001},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{
 							Role: string(ProcessClassClusterController),
@@ -681,10 +731,13 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0029,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.012726600000000001},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{
-							Role: string(ProcessRoleLog),
-							ID:   "2c66a861b33b2697",
+							Role:              string(ProcessRoleLog),
+							ID:                "2c66a861b33b2697",
+							KVStoreUsedBytes:  104861752,
+							KVStoreTotalBytes: 135012552704,
 						},
 					},
 					Messages: []FoundationDBStatusProcessMessage{},
@@ -705,10 +758,13 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.003,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0137228},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{
-							Role: string(ProcessRoleLog),
-							ID:   "56cf105980ec2b07",
+							Role:              string(ProcessRoleLog),
+							ID:                "56cf105980ec2b07",
+							KVStoreUsedBytes:  104861752,
+							KVStoreTotalBytes: 135012552704,
 						},
 					},
 					Messages: []FoundationDBStatusProcessMessage{},
@@ -729,10 +785,13 @@ var _ = Describe("FoundationDBStatus", func() {
 					},
 					Version:       "7.1.0-rc1",
 					UptimeSeconds: 85.0027,
+					CPU:           FoundationDBStatusCPUStatistics{UsageCores: 0.0140474},
 					Roles: []FoundationDBStatusProcessRoleInfo{
 						{
-							Role: string(ProcessRoleLog),
-							ID:   "31754d1d7d8d6f05",
+							Role:              string(ProcessRoleLog),
+							ID:                "31754d1d7d8d6f05",
+							KVStoreUsedBytes:  104861752,
+							KVStoreTotalBytes: 135012552704,
 						},
 					},
 					Messages: []FoundationDBStatusProcessMessage{},
@@ -750,6 +809,13 @@ var _ = Describe("FoundationDBStatus", func() {
 				},
 			},
 			FullReplication: true,
+			Workload: FoundationDBStatusWorkload{
+				Transactions: FoundationDBStatusTransactions{
+					Started:    FoundationDBStatusRate{Counter: 429, Hz: 5.99993},
+					Committed:  FoundationDBStatusRate{Counter: 32, Hz: 0.39998900000000004},
+					Conflicted: FoundationDBStatusRate{Counter: 9},
+				},
+			},
 			Clients: FoundationDBStatusClusterClientInfo{
 				Count: 8,
 				SupportedVersions: []FoundationDBStatusSupportedVersion{
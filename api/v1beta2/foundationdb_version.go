@@ -232,6 +232,35 @@ func (version Version) SupportsLocalityBasedExclusions() bool {
 	return version.IsAtLeast(Versions.SupportsLocalityBasedExclusions)
 }
 
+// SupportsPerpetualStorageWiggle returns true if the current version supports the configuration of the perpetual
+// storage wiggle, the perpetual storage wiggle locality and the storage migration type.
+func (version Version) SupportsPerpetualStorageWiggle() bool {
+	return version.IsAtLeast(Versions.SupportsPerpetualStorageWiggle)
+}
+
+// SupportsPerpetualStorageWiggleEngine returns true if the current version supports the configuration of the perpetual
+// storage wiggle engine.
+func (version Version) SupportsPerpetualStorageWiggleEngine() bool {
+	return version.IsAtLeast(Versions.SupportsPerpetualStorageWiggleEngine)
+}
+
+// SupportsTenants returns true if the current version supports tenants.
+func (version Version) SupportsTenants() bool {
+	return version.IsAtLeast(Versions.SupportsTenants)
+}
+
+// HasTenantCommand returns true if the current version of fdbcli manages tenants with the tenant command instead of
+// the createtenant, gettenant, listtenants and deletetenant commands.
+func (version Version) HasTenantCommand() bool {
+	return version.IsAtLeast(Versions.HasTenantCommand)
+}
+
+// SupportsDynamicKnobs returns true if the current version supports changing knobs through the configuration database
+// without restarting the processes.
+func (version Version) SupportsDynamicKnobs() bool {
+	return version.IsAtLeast(Versions.SupportsDynamicKnobs)
+}
+
 // Versions provides a shorthand for known versions.
 // This is only to be used in testing.
 var Versions = struct {
@@ -249,21 +278,31 @@ var Versions = struct {
 	SupportsDNSInClusterFile,
 	SupportsLocalityBasedExclusions71,
 	SupportsLocalityBasedExclusions,
+	SupportsPerpetualStorageWiggle,
+	SupportsPerpetualStorageWiggleEngine,
+	SupportsTenants,
+	HasTenantCommand,
+	SupportsDynamicKnobs,
 	Default Version
 }{
-	Default:                           Version{Major: 6, Minor: 2, Patch: 21},
-	IncompatibleVersion:               Version{Major: 6, Minor: 1, Patch: 0},
-	PreviousPatchVersion:              Version{Major: 6, Minor: 2, Patch: 20},
-	NextPatchVersion:                  Version{Major: 6, Minor: 2, Patch: 22},
-	NextMajorVersion:                  Version{Major: 7, Minor: 0, Patch: 0},
-	MinimumVersion:                    Version{Major: 6, Minor: 2, Patch: 20},
-	SupportsRocksDBV1:                 Version{Major: 7, Minor: 1, Patch: 0, ReleaseCandidate: 4},
-	SupportsIsPresent:                 Version{Major: 7, Minor: 1, Patch: 4},
-	SupportsShardedRocksDB:            Version{Major: 7, Minor: 2, Patch: 0},
-	SupportsRedwood1Experimental:      Version{Major: 7, Minor: 0, Patch: 0},
-	SupportsRedwood1:                  Version{Major: 7, Minor: 3, Patch: 0},
-	SupportsRecoveryState:             Version{Major: 7, Minor: 1, Patch: 22},
-	SupportsDNSInClusterFile:          Version{Major: 7, Minor: 0, Patch: 0},
-	SupportsLocalityBasedExclusions71: Version{Major: 7, Minor: 1, Patch: 42},
-	SupportsLocalityBasedExclusions:   Version{Major: 7, Minor: 3, Patch: 26},
+	Default:                              Version{Major: 6, Minor: 2, Patch: 21},
+	IncompatibleVersion:                  Version{Major: 6, Minor: 1, Patch: 0},
+	PreviousPatchVersion:                 Version{Major: 6, Minor: 2, Patch: 20},
+	NextPatchVersion:                     Version{Major: 6, Minor: 2, Patch: 22},
+	NextMajorVersion:                     Version{Major: 7, Minor: 0, Patch: 0},
+	MinimumVersion:                       Version{Major: 6, Minor: 2, Patch: 20},
+	SupportsRocksDBV1:                    Version{Major: 7, Minor: 1, Patch: 0, ReleaseCandidate: 4},
+	SupportsIsPresent:                    Version{Major: 7, Minor: 1, Patch: 4},
+	SupportsShardedRocksDB:               Version{Major: 7, Minor: 2, Patch: 0},
+	SupportsRedwood1Experimental:         Version{Major: 7, Minor: 0, Patch: 0},
+	SupportsRedwood1:                     Version{Major: 7, Minor: 3, Patch: 0},
+	SupportsRecoveryState:                Version{Major: 7, Minor: 1, Patch: 22},
+	SupportsDNSInClusterFile:             Version{Major: 7, Minor: 0, Patch: 0},
+	SupportsLocalityBasedExclusions71:    Version{Major: 7, Minor: 1, Patch: 42},
+	SupportsLocalityBasedExclusions:      Version{Major: 7, Minor: 3, Patch: 26},
+	SupportsPerpetualStorageWiggle:       Version{Major: 7, Minor: 1, Patch: 0},
+	SupportsPerpetualStorageWiggleEngine: Version{Major: 7, Minor: 3, Patch: 0},
+	SupportsTenants:                      Version{Major: 7, Minor: 1, Patch: 0},
+	HasTenantCommand:                     Version{Major: 7, Minor: 2, Patch: 0},
+	SupportsDynamicKnobs:                 Version{Major: 7, Minor: 2, Patch: 0},
 }
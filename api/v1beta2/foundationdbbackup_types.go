@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -101,6 +102,88 @@ type FoundationDBBackupSpec struct {
 	// SidecarContainer defines customization for the
 	// foundationdb-kubernetes-sidecar container.
 	SidecarContainer ContainerOverrides `json:"sidecarContainer,omitempty"`
+
+	// Retention defines how long the backup data will be kept in the blob
+	// store. If no retention is defined, the backup data will never be
+	// expired.
+	Retention *BackupRetention `json:"retention,omitempty"`
+
+	// AgentAutoscaling defines if and how the operator scales the number of
+	// backup agents based on the lag and the throughput of the backup.
+	AgentAutoscaling BackupAgentAutoscalingOptions `json:"agentAutoscaling,omitempty"`
+}
+
+// BackupRetention defines the retention of the backup data in the blob store.
+type BackupRetention struct {
+	// MinRestorableDays defines for how many days the backup must remain
+	// restorable. All data that is not required to restore to a point in time
+	// within this window will be expired.
+	// +kubebuilder:validation:Minimum=1
+	MinRestorableDays *int `json:"minRestorableDays,omitempty"`
+
+	// DeleteBeforeDays defines that all backup data that is older than this
+	// number of days will be expired. The expiry will fail if the backup would
+	// not be restorable afterwards.
+	// +kubebuilder:validation:Minimum=1
+	DeleteBeforeDays *int `json:"deleteBeforeDays,omitempty"`
+
+	// ExpiryIntervalSeconds defines the time between two expiries of the
+	// backup data.
+	// The default is 86400, or 1 day.
+	// +kubebuilder:validation:Minimum=60
+	ExpiryIntervalSeconds *int `json:"expiryIntervalSeconds,omitempty"`
+}
+
+// BackupAgentAutoscalingOptions defines how the operator scales the number of
+// backup agents based on the lag and the throughput of the backup.
+type BackupAgentAutoscalingOptions struct {
+	// Enabled defines if the operator should scale the number of backup
+	// agents based on the lag and the throughput of the backup.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinimumAgentCount defines the lower bound for the number of backup
+	// agents.
+	// Default is the agentCount of the backup.
+	// +kubebuilder:validation:Minimum=1
+	MinimumAgentCount *int `json:"minimumAgentCount,omitempty"`
+
+	// MaximumAgentCount defines the upper bound for the number of backup
+	// agents.
+	// Default is the minimumAgentCount.
+	// +kubebuilder:validation:Minimum=1
+	MaximumAgentCount *int `json:"maximumAgentCount,omitempty"`
+
+	// ScaleUpLagSeconds defines the lag of the latest restorable point in
+	// seconds above which the operator adds backup agents.
+	// Default is 60.
+	// +kubebuilder:validation:Minimum=1
+	ScaleUpLagSeconds *int `json:"scaleUpLagSeconds,omitempty"`
+
+	// ScaleDownLagSeconds defines the lag of the latest restorable point in
+	// seconds below which the operator removes backup agents that are not
+	// required for the current throughput.
+	// Default is 10.
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownLagSeconds *int `json:"scaleDownLagSeconds,omitempty"`
+
+	// BytesPerSecondPerAgent defines how many bytes per second a single backup
+	// agent should write to the blob store.
+	// Default is 10485760, or 10 MiB.
+	// +kubebuilder:validation:Minimum=1
+	BytesPerSecondPerAgent *int `json:"bytesPerSecondPerAgent,omitempty"`
+
+	// ScaleUpCooldownSeconds defines the minimum time after a change of the
+	// agent count before the operator adds backup agents.
+	// Default is 300.
+	// +kubebuilder:validation:Minimum=0
+	ScaleUpCooldownSeconds *int `json:"scaleUpCooldownSeconds,omitempty"`
+
+	// ScaleDownCooldownSeconds defines the minimum time after a change of the
+	// agent count before the operator removes backup agents.
+	// Default is 900.
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownCooldownSeconds *int `json:"scaleDownCooldownSeconds,omitempty"`
 }
 
 // FoundationDBBackupStatus describes the current status of the backup for a cluster.
@@ -120,8 +203,47 @@ type FoundationDBBackupStatus struct {
 	// Generations provides information about the latest generation to be
 	// reconciled, or to reach other stages in reconciliation.
 	Generations BackupGenerationStatus `json:"generations,omitempty"`
+
+	// Expiry provides information about the last expiry of the backup data.
+	Expiry *BackupExpiryStatus `json:"expiry,omitempty"`
+
+	// AgentAutoscaling provides information about the scaling of the backup
+	// agents. This will only be set if the agent autoscaling is enabled.
+	AgentAutoscaling *BackupAgentAutoscalingStatus `json:"agentAutoscaling,omitempty"`
+
+	// Conditions represents the latest available observations of the backup's
+	// state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+const (
+	// BackupConditionReconciled indicates that the operator has reconciled the
+	// latest generation of the backup.
+	BackupConditionReconciled = "Reconciled"
+	// BackupConditionRunning indicates that the backup is running.
+	BackupConditionRunning = "Running"
+	// BackupConditionPaused indicates that the backup agents are paused.
+	BackupConditionPaused = "Paused"
+)
+
+const (
+	// ConditionReasonBackupRunning is the reason for a Running condition if
+	// the backup is running.
+	ConditionReasonBackupRunning = "BackupRunning"
+	// ConditionReasonBackupNotRunning is the reason for a Running condition if
+	// the backup is not running.
+	ConditionReasonBackupNotRunning = "BackupNotRunning"
+	// ConditionReasonBackupPaused is the reason for a Paused condition if the
+	// backup agents are paused.
+	ConditionReasonBackupPaused = "BackupPaused"
+	// ConditionReasonBackupNotPaused is the reason for a Paused condition if
+	// the backup agents are not paused.
+	ConditionReasonBackupNotPaused = "BackupNotPaused"
+)
+
 // FoundationDBBackupStatusBackupDetails provides information about the state
 // of the backup in the cluster.
 type FoundationDBBackupStatusBackupDetails struct {
@@ -131,6 +253,49 @@ type FoundationDBBackupStatusBackupDetails struct {
 	SnapshotPeriodSeconds int    `json:"snapshotTime,omitempty"`
 }
 
+// BackupExpiryStatus provides information about the last expiry of the
+// backup data.
+type BackupExpiryStatus struct {
+	// LastExpiryTime provides the timestamp of the last successful expiry.
+	LastExpiryTime *metav1.Time `json:"lastExpiryTime,omitempty"`
+
+	// MinRestorableTime provides the earliest point in time the backup can be
+	// restored to after the last expiry.
+	MinRestorableTime *metav1.Time `json:"minRestorableTime,omitempty"`
+
+	// MaxRestorableTime provides the latest point in time the backup could be
+	// restored to at the last expiry.
+	MaxRestorableTime *metav1.Time `json:"maxRestorableTime,omitempty"`
+}
+
+// BackupAgentAutoscalingStatus provides information about the scaling of the
+// backup agents.
+type BackupAgentAutoscalingStatus struct {
+	// DesiredAgentCount defines the number of backup agents chosen by the
+	// autoscaler.
+	DesiredAgentCount int `json:"desiredAgentCount,omitempty"`
+
+	// LagSeconds provides the lag of the latest restorable point in seconds
+	// of the last check.
+	LagSeconds int `json:"lagSeconds,omitempty"`
+
+	// BytesWritten provides the total number of log and range bytes written
+	// by the backup at the last sample.
+	BytesWritten int64 `json:"bytesWritten,omitempty"`
+
+	// BytesWrittenPerSecond provides the rate of bytes written by the backup
+	// between the last two samples.
+	BytesWrittenPerSecond int64 `json:"bytesWrittenPerSecond,omitempty"`
+
+	// LastSampleTime provides the timestamp of the last sample of the bytes
+	// written.
+	LastSampleTime *metav1.Time `json:"lastSampleTime,omitempty"`
+
+	// LastScaleTime provides the timestamp of the last change of the desired
+	// agent count.
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
 // BackupGenerationStatus stores information on which generations have reached
 // different stages in reconciliation for the backup.
 type BackupGenerationStatus struct {
@@ -233,6 +398,18 @@ func (backup *FoundationDBBackup) BackupURL() string {
 	return backup.Spec.BlobStoreConfiguration.getURL(backup.BackupName(), backup.Bucket())
 }
 
+// KeyRanges gets the key ranges that are included in the backup. The
+// operator starts backups without key ranges, so the backup contains the
+// whole normal keyspace.
+func (backup *FoundationDBBackup) KeyRanges() []FoundationDBKeyRange {
+	return []FoundationDBKeyRange{
+		{
+			Start: "",
+			End:   `\xff`,
+		},
+	}
+}
+
 // SnapshotPeriodSeconds gets the period between snapshots for a backup.
 func (backup *FoundationDBBackup) SnapshotPeriodSeconds() int {
 	return pointer.IntDeref(backup.Spec.SnapshotPeriodSeconds, 864000)
@@ -252,6 +429,42 @@ type FoundationDBLiveBackupStatus struct {
 
 	// BackupAgentsPaused describes whether the backup agents are paused.
 	BackupAgentsPaused bool `json:"BackupAgentsPaused,omitempty"`
+
+	// LatestRestorablePoint provides the latest point the backup can be
+	// restored to.
+	LatestRestorablePoint *FoundationDBLiveBackupRestorablePoint `json:"LatestRestorablePoint,omitempty"`
+
+	// LogBytes provides information about the mutation log data written by
+	// the backup.
+	LogBytes FoundationDBLiveBackupStatusBytes `json:"LogBytes,omitempty"`
+
+	// RangeBytes provides information about the snapshot data written by
+	// the backup.
+	RangeBytes FoundationDBLiveBackupStatusBytes `json:"RangeBytes,omitempty"`
+}
+
+// FoundationDBLiveBackupRestorablePoint describes the latest point a running
+// backup can be restored to.
+type FoundationDBLiveBackupRestorablePoint struct {
+	// Version provides the database version of the restorable point.
+	Version int64 `json:"Version,omitempty"`
+
+	// Timestamp provides the human readable timestamp of the restorable point.
+	Timestamp string `json:"Timestamp,omitempty"`
+
+	// EpochSeconds provides the seconds since epoch of the restorable point.
+	EpochSeconds float64 `json:"EpochSeconds,omitempty"`
+
+	// LagSeconds provides how many seconds the restorable point lags behind
+	// the current version of the database.
+	LagSeconds float64 `json:"LagSeconds,omitempty"`
+}
+
+// FoundationDBLiveBackupStatusBytes provides the number of bytes written by
+// the backup.
+type FoundationDBLiveBackupStatusBytes struct {
+	// Written provides the number of bytes written to the blob store.
+	Written int64 `json:"Written,omitempty"`
 }
 
 // FoundationDBLiveBackupStatusState provides the state of a backup in the
@@ -261,10 +474,104 @@ type FoundationDBLiveBackupStatusState struct {
 	Running bool `json:"Running,omitempty"`
 }
 
+// FoundationDBBackupDescription describes the data of a backup in the blob
+// store, as provided by the backup describe command.
+type FoundationDBBackupDescription struct {
+	// Restorable describes whether the backup can be restored.
+	Restorable bool `json:"Restorable,omitempty"`
+
+	// MinRestorablePoint provides the earliest point the backup can be
+	// restored to.
+	MinRestorablePoint *FoundationDBBackupRestorablePoint `json:"MinRestorablePoint,omitempty"`
+
+	// MaxRestorablePoint provides the latest point the backup can be
+	// restored to.
+	MaxRestorablePoint *FoundationDBBackupRestorablePoint `json:"MaxRestorablePoint,omitempty"`
+}
+
+// FoundationDBBackupRestorablePoint describes a point the backup can be
+// restored to.
+type FoundationDBBackupRestorablePoint struct {
+	// Version provides the database version of the restorable point.
+	Version int64 `json:"Version,omitempty"`
+
+	// Timestamp provides the human readable timestamp of the restorable point.
+	Timestamp string `json:"Timestamp,omitempty"`
+
+	// Epochs provides the seconds since epoch of the restorable point.
+	Epochs int64 `json:"Epochs,omitempty"`
+}
+
 // GetDesiredAgentCount determines how many backup agents we should run
 // for a cluster.
 func (backup *FoundationDBBackup) GetDesiredAgentCount() int {
-	return pointer.IntDeref(backup.Spec.AgentCount, 2)
+	if !backup.UseAgentAutoscaling() {
+		return pointer.IntDeref(backup.Spec.AgentCount, 2)
+	}
+
+	// If the agent autoscaling is enabled, the operator manages the agent
+	// count within the defined bounds.
+	minimum, maximum := backup.GetAgentAutoscalingLimits()
+	if backup.Status.AgentAutoscaling == nil || backup.Status.AgentAutoscaling.DesiredAgentCount < minimum {
+		return minimum
+	}
+
+	if backup.Status.AgentAutoscaling.DesiredAgentCount > maximum {
+		return maximum
+	}
+
+	return backup.Status.AgentAutoscaling.DesiredAgentCount
+}
+
+// UseAgentAutoscaling returns true if the operator should scale the number of
+// backup agents based on the lag and the throughput of the backup. Default is
+// false.
+func (backup *FoundationDBBackup) UseAgentAutoscaling() bool {
+	return pointer.BoolDeref(backup.Spec.AgentAutoscaling.Enabled, false)
+}
+
+// GetAgentAutoscalingLimits returns the minimum and maximum number of backup
+// agents of the agent autoscaling. The agent count of the spec is used as the
+// default minimum.
+func (backup *FoundationDBBackup) GetAgentAutoscalingLimits() (int, int) {
+	options := backup.Spec.AgentAutoscaling
+	minimum := pointer.IntDeref(options.MinimumAgentCount, pointer.IntDeref(backup.Spec.AgentCount, 2))
+	maximum := pointer.IntDeref(options.MaximumAgentCount, minimum)
+	if maximum < minimum {
+		maximum = minimum
+	}
+
+	return minimum, maximum
+}
+
+// GetAgentAutoscalingScaleUpLag returns the lag of the latest restorable point
+// above which backup agents will be added. Default is 60 seconds.
+func (backup *FoundationDBBackup) GetAgentAutoscalingScaleUpLag() time.Duration {
+	return time.Duration(pointer.IntDeref(backup.Spec.AgentAutoscaling.ScaleUpLagSeconds, 60)) * time.Second
+}
+
+// GetAgentAutoscalingScaleDownLag returns the lag of the latest restorable
+// point below which backup agents can be removed. Default is 10 seconds.
+func (backup *FoundationDBBackup) GetAgentAutoscalingScaleDownLag() time.Duration {
+	return time.Duration(pointer.IntDeref(backup.Spec.AgentAutoscaling.ScaleDownLagSeconds, 10)) * time.Second
+}
+
+// GetAgentAutoscalingBytesPerSecondPerAgent returns how many bytes per second
+// a single backup agent should write. Default is 10 MiB.
+func (backup *FoundationDBBackup) GetAgentAutoscalingBytesPerSecondPerAgent() int64 {
+	return int64(pointer.IntDeref(backup.Spec.AgentAutoscaling.BytesPerSecondPerAgent, 10*1024*1024))
+}
+
+// GetAgentAutoscalingScaleUpCooldown returns the minimum time between a change
+// of the agent count and adding backup agents. Default is 5 minutes.
+func (backup *FoundationDBBackup) GetAgentAutoscalingScaleUpCooldown() time.Duration {
+	return time.Duration(pointer.IntDeref(backup.Spec.AgentAutoscaling.ScaleUpCooldownSeconds, 300)) * time.Second
+}
+
+// GetAgentAutoscalingScaleDownCooldown returns the minimum time between a
+// change of the agent count and removing backup agents. Default is 15 minutes.
+func (backup *FoundationDBBackup) GetAgentAutoscalingScaleDownCooldown() time.Duration {
+	return time.Duration(pointer.IntDeref(backup.Spec.AgentAutoscaling.ScaleDownCooldownSeconds, 900)) * time.Second
 }
 
 // CheckReconciliation compares the spec and the status to determine if
@@ -310,6 +617,30 @@ func (backup *FoundationDBBackup) CheckReconciliation() (bool, error) {
 	return reconciled, nil
 }
 
+// GetExpiryInterval returns the time between two expiries of the backup data.
+func (backup *FoundationDBBackup) GetExpiryInterval() time.Duration {
+	if backup.Spec.Retention == nil {
+		return 0
+	}
+
+	return time.Duration(pointer.IntDeref(backup.Spec.Retention.ExpiryIntervalSeconds, 86400)) * time.Second
+}
+
+// GetTimeUntilNextExpiry returns the time until the backup data should be expired again. If the backup data should be
+// expired now, 0 will be returned.
+func (backup *FoundationDBBackup) GetTimeUntilNextExpiry(now time.Time) time.Duration {
+	if backup.Status.Expiry == nil || backup.Status.Expiry.LastExpiryTime == nil {
+		return 0
+	}
+
+	remaining := backup.Status.Expiry.LastExpiryTime.Add(backup.GetExpiryInterval()).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
 // GetAllowTagOverride returns the bool value for AllowTagOverride
 func (foundationDBBackupSpec *FoundationDBBackupSpec) GetAllowTagOverride() bool {
 	return pointer.BoolDeref(foundationDBBackupSpec.AllowTagOverride, false)
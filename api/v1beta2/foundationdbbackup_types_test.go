@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 )
 
 var _ = Describe("[api] FoundationDBBackup", func() {
@@ -224,6 +225,45 @@ var _ = Describe("[api] FoundationDBBackup", func() {
 		})
 	})
 
+	When("getting the desired agent count", func() {
+		It("should return the agent count of the spec", func() {
+			Expect(backup.GetDesiredAgentCount()).To(Equal(2))
+
+			backup.Spec.AgentCount = pointer.Int(3)
+			Expect(backup.GetDesiredAgentCount()).To(Equal(3))
+		})
+
+		When("the agent autoscaling is enabled", func() {
+			BeforeEach(func() {
+				backup.Spec.AgentCount = pointer.Int(3)
+				backup.Spec.AgentAutoscaling = BackupAgentAutoscalingOptions{
+					Enabled:           pointer.Bool(true),
+					MaximumAgentCount: pointer.Int(6),
+				}
+			})
+
+			It("should use the agent count as the minimum", func() {
+				minimum, maximum := backup.GetAgentAutoscalingLimits()
+				Expect(minimum).To(Equal(3))
+				Expect(maximum).To(Equal(6))
+				Expect(backup.GetDesiredAgentCount()).To(Equal(3))
+			})
+
+			It("should return the agent count chosen by the autoscaler", func() {
+				backup.Status.AgentAutoscaling = &BackupAgentAutoscalingStatus{DesiredAgentCount: 5}
+				Expect(backup.GetDesiredAgentCount()).To(Equal(5))
+			})
+
+			It("should limit the agent count chosen by the autoscaler", func() {
+				backup.Status.AgentAutoscaling = &BackupAgentAutoscalingStatus{DesiredAgentCount: 10}
+				Expect(backup.GetDesiredAgentCount()).To(Equal(6))
+
+				backup.Status.AgentAutoscaling.DesiredAgentCount = 1
+				Expect(backup.GetDesiredAgentCount()).To(Equal(3))
+			})
+		})
+	})
+
 	When("getting the backup URL", func() {
 		DescribeTable("should generate the correct backup URL",
 			func(backup FoundationDBBackup, expected string) {
@@ -0,0 +1,129 @@
+/*
+ * foundationdbbackup_webhook.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-apps-foundationdb-org-v1beta2-foundationdbbackup,mutating=false,failurePolicy=fail,sideEffects=None,groups=apps.foundationdb.org,resources=foundationdbbackups,verbs=create;update,versions=v1beta2,name=vfoundationdbbackup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FoundationDBBackup{}
+
+// SetupWebhookWithManager registers the validating webhook for the FoundationDBBackup resource.
+func (backup *FoundationDBBackup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(backup).
+		Complete()
+}
+
+// ValidateCreate validates a newly created FoundationDBBackup.
+func (backup *FoundationDBBackup) ValidateCreate() error {
+	return backup.Validate()
+}
+
+// ValidateUpdate validates the update of a FoundationDBBackup.
+func (backup *FoundationDBBackup) ValidateUpdate(_ runtime.Object) error {
+	return backup.Validate()
+}
+
+// ValidateDelete validates the deletion of a FoundationDBBackup. Deletions are always allowed.
+func (backup *FoundationDBBackup) ValidateDelete() error {
+	return nil
+}
+
+// Validate checks if the spec of the FoundationDBBackup is valid.
+func (backup *FoundationDBBackup) Validate() error {
+	var validations []string
+
+	version, err := ParseFdbVersion(backup.Spec.Version)
+	if err != nil {
+		return err
+	}
+
+	if !version.IsSupported() {
+		validations = append(validations, fmt.Sprintf("version: %s is not supported, minimum supported version is: %s", version.String(), Versions.MinimumVersion.String()))
+	}
+
+	if backup.Spec.ClusterName == "" {
+		validations = append(validations, "clusterName must be defined")
+	}
+
+	if backup.Spec.BlobStoreConfiguration == nil {
+		validations = append(validations, "blobStoreConfiguration must be defined")
+	} else {
+		validations = append(validations, backup.Spec.BlobStoreConfiguration.validate()...)
+	}
+
+	if backup.Spec.Retention != nil && backup.Spec.Retention.MinRestorableDays == nil && backup.Spec.Retention.DeleteBeforeDays == nil {
+		validations = append(validations, "retention must define minRestorableDays or deleteBeforeDays")
+	}
+
+	autoscalingOptions := backup.Spec.AgentAutoscaling
+	if autoscalingOptions.MinimumAgentCount != nil && autoscalingOptions.MaximumAgentCount != nil && *autoscalingOptions.MaximumAgentCount < *autoscalingOptions.MinimumAgentCount {
+		validations = append(validations, fmt.Sprintf("agentAutoscaling.maximumAgentCount %d must not be smaller than agentAutoscaling.minimumAgentCount %d", *autoscalingOptions.MaximumAgentCount, *autoscalingOptions.MinimumAgentCount))
+	}
+
+	if backup.GetAgentAutoscalingScaleDownLag() >= backup.GetAgentAutoscalingScaleUpLag() {
+		validations = append(validations, fmt.Sprintf("agentAutoscaling.scaleDownLagSeconds %.0f must be smaller than agentAutoscaling.scaleUpLagSeconds %.0f", backup.GetAgentAutoscalingScaleDownLag().Seconds(), backup.GetAgentAutoscalingScaleUpLag().Seconds()))
+	}
+
+	err = backup.Spec.CustomParameters.ValidateCustomParameters()
+	if err != nil {
+		validations = append(validations, err.Error())
+	}
+
+	if len(validations) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(validations, ", "))
+}
+
+// validate checks if the BlobStoreConfiguration is valid and returns all violations.
+func (configuration *BlobStoreConfiguration) validate() []string {
+	var validations []string
+
+	if configuration.AccountName == "" {
+		validations = append(validations, "blobStoreConfiguration.accountName must be defined")
+	}
+
+	for _, parameter := range configuration.URLParameters {
+		key, _, found := strings.Cut(string(parameter), "=")
+		if !found || key == "" {
+			validations = append(validations, fmt.Sprintf("blobStoreConfiguration.urlParameters: %s must be in the format key=value", parameter))
+			continue
+		}
+
+		// The bucket is defined by the bucket field and must not be overwritten by the URL parameters.
+		if key == "bucket" {
+			validations = append(validations, "blobStoreConfiguration.urlParameters: bucket must be defined with blobStoreConfiguration.bucket")
+		}
+	}
+
+	return validations
+}
@@ -0,0 +1,186 @@
+/*
+ * foundationdbbackup_webhook_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("[api] FoundationDBBackup webhook", func() {
+	When("validating a backup", func() {
+		DescribeTable("it should return if the backup is valid",
+			func(spec FoundationDBBackupSpec, expectedErr string) {
+				backup := &FoundationDBBackup{Spec: spec}
+				err := backup.ValidateCreate()
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("valid backup",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName:   "account@minio",
+						URLParameters: []URLParameter{"secure_connection=0"},
+					},
+				},
+				"",
+			),
+			Entry("unsupported version",
+				FoundationDBBackupSpec{
+					Version:     "6.1.12",
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+				},
+				"version: 6.1.12 is not supported",
+			),
+			Entry("missing cluster name",
+				FoundationDBBackupSpec{
+					Version: Versions.Default.String(),
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+				},
+				"clusterName must be defined",
+			),
+			Entry("missing blob store configuration",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+				},
+				"blobStoreConfiguration must be defined",
+			),
+			Entry("bucket defined as URL parameter",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName:   "account@minio",
+						URLParameters: []URLParameter{"bucket=test"},
+					},
+				},
+				"bucket must be defined with blobStoreConfiguration.bucket",
+			),
+			Entry("invalid URL parameter with a format verb",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName:   "account@minio",
+						URLParameters: []URLParameter{"100%d"},
+					},
+				},
+				"blobStoreConfiguration.urlParameters: 100%d must be in the format key=value",
+			),
+			Entry("valid retention",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					Retention: &BackupRetention{
+						MinRestorableDays: pointer.Int(7),
+					},
+				},
+				"",
+			),
+			Entry("retention without expiry setting",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					Retention: &BackupRetention{
+						ExpiryIntervalSeconds: pointer.Int(3600),
+					},
+				},
+				"retention must define minRestorableDays or deleteBeforeDays",
+			),
+			Entry("valid agent autoscaling",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					AgentAutoscaling: BackupAgentAutoscalingOptions{
+						Enabled:           pointer.Bool(true),
+						MinimumAgentCount: pointer.Int(2),
+						MaximumAgentCount: pointer.Int(10),
+					},
+				},
+				"",
+			),
+			Entry("agent autoscaling with a maximum smaller than the minimum",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					AgentAutoscaling: BackupAgentAutoscalingOptions{
+						Enabled:           pointer.Bool(true),
+						MinimumAgentCount: pointer.Int(4),
+						MaximumAgentCount: pointer.Int(2),
+					},
+				},
+				"agentAutoscaling.maximumAgentCount 2 must not be smaller than agentAutoscaling.minimumAgentCount 4",
+			),
+			Entry("agent autoscaling with a scale down lag larger than the scale up lag",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					AgentAutoscaling: BackupAgentAutoscalingOptions{
+						Enabled:             pointer.Bool(true),
+						ScaleDownLagSeconds: pointer.Int(120),
+					},
+				},
+				"agentAutoscaling.scaleDownLagSeconds 120 must be smaller than agentAutoscaling.scaleUpLagSeconds 60",
+			),
+			Entry("protected custom parameter",
+				FoundationDBBackupSpec{
+					Version:     Versions.Default.String(),
+					ClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					CustomParameters: FoundationDBCustomParameters{
+						"datadir=/tmp",
+					},
+				},
+				"found protected customParameter: datadir",
+			),
+		)
+	})
+})
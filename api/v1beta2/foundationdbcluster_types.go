@@ -21,11 +21,13 @@ import (
 	"math"
 	"math/rand"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -313,8 +315,147 @@ type FoundationDBClusterStatus struct {
 
 	// ReconciledProcessGroups reflects the number of process groups that have no condition and are not marked for removal.
 	ReconciledProcessGroups int `json:"reconciledProcessGroups,omitempty"`
+
+	// Conditions represents the latest available observations of the cluster's state. The conditions are derived
+	// from the result of the last reconciliation and from the machine-readable status of the database.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// BlockingReconciler contains information about the sub-reconciler that blocked the last reconciliation of the
+	// cluster. This will be reset once a reconciliation is not blocked by any sub-reconciler.
+	BlockingReconciler *BlockingReconcilerInfo `json:"blockingReconciler,omitempty"`
+
+	// SuspendedSubReconcilers contains the sub-reconcilers that are suspended by the automation options and are
+	// skipped during reconciliation.
+	SuspendedSubReconcilers []SubReconcilerName `json:"suspendedSubReconcilers,omitempty"`
+
+	// MaintenanceWindow contains information about the maintenance windows if they are configured.
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+
+	// Canary contains information about the current canary rollout.
+	Canary *CanaryStatus `json:"canary,omitempty"`
+
+	// Upgrade contains information about the current protocol compatible upgrade.
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// UpgradeRollback contains information about the last upgrade that was rolled back by the operator. The failed
+	// version will be locked out until the rollback is acknowledged.
+	UpgradeRollback *UpgradeRollbackStatus `json:"upgradeRollback,omitempty"`
+
+	// RegionFailover contains information about an unhealthy primary data center and the last region failover
+	// performed by the operator.
+	RegionFailover *RegionFailoverStatus `json:"regionFailover,omitempty"`
+
+	// StorageWiggle contains information about the progress of the perpetual storage wiggle. This will only be set
+	// if the perpetual storage wiggle is enabled.
+	StorageWiggle *StorageWiggleStatus `json:"storageWiggle,omitempty"`
+
+	// StorageEngineMigration contains information about the migration of the storage process groups to the configured
+	// storage engine.
+	StorageEngineMigration *StorageEngineMigrationStatus `json:"storageEngineMigration,omitempty"`
+
+	// StorageAutoscaling contains information about the scaling of the storage process groups. This will only be set
+	// if the storage autoscaling is enabled.
+	StorageAutoscaling *StorageAutoscalingStatus `json:"storageAutoscaling,omitempty"`
+
+	// StatelessAutoscaling contains information about the scaling of the commit proxies, grv proxies and resolvers.
+	// This will only be set if the stateless autoscaling is enabled.
+	StatelessAutoscaling *StatelessAutoscalingStatus `json:"statelessAutoscaling,omitempty"`
+
+	// Knobs contains the knobs that are in effect for the processes of each process class.
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=map
+	// +listMapKey=processClass
+	Knobs []ProcessClassKnobStatus `json:"knobs,omitempty"`
 }
 
+const (
+	// ClusterConditionReconciled indicates that the operator has reconciled the latest generation of the cluster.
+	ClusterConditionReconciled = "Reconciled"
+	// ClusterConditionAvailable indicates that the database is available.
+	ClusterConditionAvailable = "Available"
+	// ClusterConditionHealthy indicates that the database reports itself as healthy.
+	ClusterConditionHealthy = "Healthy"
+	// ClusterConditionUpgrading indicates that the cluster is running a different version than the desired version.
+	ClusterConditionUpgrading = "Upgrading"
+	// ClusterConditionFullReplication indicates that all data in the database is fully replicated.
+	ClusterConditionFullReplication = "FullReplication"
+	// ClusterConditionMaintenanceModeActive indicates that a maintenance zone is set in the database.
+	ClusterConditionMaintenanceModeActive = "MaintenanceModeActive"
+	// ClusterConditionDegraded indicates that the database is unhealthy or that some process groups have conditions.
+	ClusterConditionDegraded = "Degraded"
+	// ClusterConditionCanaryHalted indicates that a canary rollout is halted and requires a manual promotion.
+	ClusterConditionCanaryHalted = "CanaryHalted"
+	// ClusterConditionUpgradeRolledBack indicates that a stalled upgrade was rolled back and the failed version is
+	// locked out until the rollback is acknowledged.
+	ClusterConditionUpgradeRolledBack = "UpgradeRolledBack"
+)
+
+const (
+	// ConditionReasonReconciliationComplete is the reason for a Reconciled condition if the latest generation was
+	// reconciled.
+	ConditionReasonReconciliationComplete = "ReconciliationComplete"
+	// ConditionReasonReconciliationError is the reason for a Reconciled condition if the reconciliation encountered
+	// an error.
+	ConditionReasonReconciliationError = "ReconciliationError"
+	// ConditionReasonReconciliationBlocked is the reason for a Reconciled condition if a sub-reconciler could not
+	// proceed with the reconciliation.
+	ConditionReasonReconciliationBlocked = "ReconciliationBlocked"
+	// ConditionReasonReconciliationDelayed is the reason for a Reconciled condition if a sub-reconciler delayed the
+	// requeue to the end of the reconciliation.
+	ConditionReasonReconciliationDelayed = "ReconciliationDelayed"
+	// ConditionReasonReconciliationIncomplete is the reason for a Reconciled condition if all sub-reconcilers finished
+	// but the latest generation is not reconciled.
+	ConditionReasonReconciliationIncomplete = "ReconciliationIncomplete"
+	// ConditionReasonDryRun is the reason for a Reconciled condition if the cluster is in dry-run mode and the
+	// operator only recorded the planned actions.
+	ConditionReasonDryRun = "DryRun"
+	// ConditionReasonDatabaseAvailable is the reason for an Available condition if the database is available.
+	ConditionReasonDatabaseAvailable = "DatabaseAvailable"
+	// ConditionReasonDatabaseUnavailable is the reason for an Available condition if the database is unavailable.
+	ConditionReasonDatabaseUnavailable = "DatabaseUnavailable"
+	// ConditionReasonDatabaseHealthy is the reason for a Healthy condition if the database is healthy.
+	ConditionReasonDatabaseHealthy = "DatabaseHealthy"
+	// ConditionReasonDatabaseUnhealthy is the reason for a Healthy or Degraded condition if the database is unhealthy.
+	ConditionReasonDatabaseUnhealthy = "DatabaseUnhealthy"
+	// ConditionReasonVersionChange is the reason for an Upgrading condition if the running version differs from the
+	// desired version.
+	ConditionReasonVersionChange = "VersionChange"
+	// ConditionReasonVersionReconciled is the reason for an Upgrading condition if the running version matches the
+	// desired version.
+	ConditionReasonVersionReconciled = "VersionReconciled"
+	// ConditionReasonFullyReplicated is the reason for a FullReplication condition if all data is fully replicated.
+	ConditionReasonFullyReplicated = "FullyReplicated"
+	// ConditionReasonNotFullyReplicated is the reason for a FullReplication condition if some data is not fully
+	// replicated.
+	ConditionReasonNotFullyReplicated = "NotFullyReplicated"
+	// ConditionReasonMaintenanceZoneSet is the reason for a MaintenanceModeActive condition if a maintenance zone is
+	// set.
+	ConditionReasonMaintenanceZoneSet = "MaintenanceZoneSet"
+	// ConditionReasonNoMaintenanceZone is the reason for a MaintenanceModeActive condition if no maintenance zone is
+	// set.
+	ConditionReasonNoMaintenanceZone = "NoMaintenanceZone"
+	// ConditionReasonProcessGroupsWithConditions is the reason for a Degraded condition if some process groups have
+	// conditions.
+	ConditionReasonProcessGroupsWithConditions = "ProcessGroupsWithConditions"
+	// ConditionReasonNoDegradation is the reason for a Degraded condition if the database is healthy and no process
+	// group has a condition.
+	ConditionReasonNoDegradation = "NoDegradation"
+	// ConditionReasonCanaryAwaitingPromotion is the reason for a CanaryHalted condition if the canary process groups
+	// are healthy after the soak time and the rollout requires a manual promotion.
+	ConditionReasonCanaryAwaitingPromotion = "CanaryAwaitingPromotion"
+	// ConditionReasonCanaryUnhealthy is the reason for a CanaryHalted condition if the health checks failed during the
+	// soak time.
+	ConditionReasonCanaryUnhealthy = "CanaryUnhealthy"
+	// ConditionReasonCanaryProgressing is the reason for a CanaryHalted condition if the canary rollout is not halted.
+	ConditionReasonCanaryProgressing = "CanaryProgressing"
+	// ConditionReasonUpgradeStalled is the reason for an UpgradeRolledBack condition if the upgrade did not finish
+	// within the stall timeout.
+	ConditionReasonUpgradeStalled = "UpgradeStalled"
+)
+
 // MaintenanceModeInfo contains information regarding the zone and process groups that are put
 // into maintenance mode by the operator
 type MaintenanceModeInfo struct {
@@ -329,6 +470,21 @@ type MaintenanceModeInfo struct {
 	ProcessGroups []string `json:"processGroups,omitempty"`
 }
 
+// BlockingReconcilerInfo contains information about the sub-reconciler that blocked the reconciliation of the cluster.
+type BlockingReconcilerInfo struct {
+	// SubReconciler is the name of the sub-reconciler that blocked the reconciliation, e.g. BounceProcesses.
+	SubReconciler string `json:"subReconciler,omitempty"`
+
+	// Message contains the message or the error that was returned by the sub-reconciler.
+	Message string `json:"message,omitempty"`
+
+	// FirstSeen is the time when the sub-reconciler blocked the reconciliation for the first time.
+	FirstSeen *metav1.Time `json:"firstSeen,omitempty"`
+
+	// Count is the number of consecutive reconciliations that were blocked by this sub-reconciler.
+	Count int `json:"count,omitempty"`
+}
+
 // LockSystemStatus provides a summary of the status of the locking system.
 type LockSystemStatus struct {
 	// DenyList contains a list of operator instances that are prevented
@@ -533,6 +689,23 @@ func (processGroupStatus *ProcessGroupStatus) NeedsReplacement(failureTime int,
 	return "", 0
 }
 
+// HasFailureCondition returns true if the process group has at least one condition that indicates a failure,
+// independent of how long the condition is present and if the process group is marked for removal.
+func (processGroupStatus *ProcessGroupStatus) HasFailureCondition() bool {
+	for _, conditionType := range conditionsThatNeedReplacement {
+		// Process groups that are marked for removal will be excluded as part of the removal.
+		if conditionType == ProcessIsMarkedAsExcluded {
+			continue
+		}
+
+		if processGroupStatus.GetConditionTime(conditionType) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AddAddresses adds the new address to the ProcessGroupStatus and removes duplicates and old addresses
 // if the process group is not marked as removal.
 func (processGroupStatus *ProcessGroupStatus) AddAddresses(addresses []string, includeOldAddresses bool) {
@@ -923,6 +1096,8 @@ const (
 	NodeTaintReplacing ProcessGroupConditionType = "NodeTaintReplacing"
 	// ProcessIsMarkedAsExcluded represents a process group where at least one process is excluded.
 	ProcessIsMarkedAsExcluded ProcessGroupConditionType = "ProcessIsMarkedAsExcluded"
+	// ResizingPVC represents a process group whose PVC is expanded in place to the requested storage size.
+	ResizingPVC ProcessGroupConditionType = "ResizingPVC"
 )
 
 // AllProcessGroupConditionTypes returns all ProcessGroupConditionType
@@ -942,6 +1117,7 @@ func AllProcessGroupConditionTypes() []ProcessGroupConditionType {
 		NodeTaintDetected,
 		NodeTaintReplacing,
 		ProcessIsMarkedAsExcluded,
+		ResizingPVC,
 	}
 }
 
@@ -978,6 +1154,8 @@ func GetProcessGroupConditionType(processGroupConditionType string) (ProcessGrou
 		return NodeTaintReplacing, nil
 	case "ProcessIsMarkedAsExcluded":
 		return ProcessIsMarkedAsExcluded, nil
+	case "ResizingPVC":
+		return ResizingPVC, nil
 	}
 
 	return "", fmt.Errorf("unknown process group condition type: %s", processGroupConditionType)
@@ -1167,6 +1345,39 @@ type FoundationDBClusterAutomationOptions struct {
 	// The default is a list that includes "fdb-kubernetes-operator".
 	// +kubebuilder:validation:MaxItems=10
 	IgnoreLogGroupsForUpgrade []LogGroup `json:"ignoreLogGroupsForUpgrade,omitempty"`
+
+	// SuspendedSubReconcilers defines the sub-reconcilers or classes of sub-reconcilers that should not be executed
+	// for this cluster, e.g. to prevent coordinator changes or removals during an incident. The status will still be
+	// refreshed. In contrast to Skip all other sub-reconcilers will continue to run.
+	// +kubebuilder:validation:MaxItems=30
+	SuspendedSubReconcilers []SubReconcilerName `json:"suspendedSubReconcilers,omitempty"`
+
+	// MaintenanceWindowOptions defines the time windows in which the operator is allowed to perform disruptive
+	// operations like bounces, Pod recreations, exclusions and removals.
+	MaintenanceWindowOptions MaintenanceWindowOptions `json:"maintenanceWindowOptions,omitempty"`
+
+	// CanaryOptions defines if changes to the Pod spec, the process configuration or the version should be rolled
+	// out to a subset of process groups first.
+	CanaryOptions CanaryOptions `json:"canaryOptions,omitempty"`
+
+	// UpgradeRollbackOptions defines if protocol compatible upgrades that stall should be rolled back automatically.
+	UpgradeRollbackOptions UpgradeRollbackOptions `json:"upgradeRollbackOptions,omitempty"`
+
+	// RegionFailoverOptions defines if the operator should fail over to another region if the primary data center is
+	// unhealthy. The failover is only performed by the operator instance that configures the database.
+	RegionFailoverOptions RegionFailoverOptions `json:"regionFailoverOptions,omitempty"`
+
+	// StorageEngineMigrationOptions defines if the operator should replace storage process groups that use a different
+	// storage engine than the configured storage engine.
+	StorageEngineMigrationOptions StorageEngineMigrationOptions `json:"storageEngineMigrationOptions,omitempty"`
+
+	// StorageAutoscalingOptions defines if and how the operator scales the number of storage process groups based on
+	// the disk utilization of the storage servers.
+	StorageAutoscalingOptions StorageAutoscalingOptions `json:"storageAutoscalingOptions,omitempty"`
+
+	// StatelessAutoscalingOptions defines if and how the operator scales the number of commit proxies, grv proxies
+	// and resolvers based on the workload of the database.
+	StatelessAutoscalingOptions StatelessAutoscalingOptions `json:"statelessAutoscalingOptions,omitempty"`
 }
 
 // LogGroup represents a LogGroup used by a FoundationDB process to log trace events. The LogGroup can be used to filter
@@ -1174,6 +1385,106 @@ type FoundationDBClusterAutomationOptions struct {
 // +kubebuilder:validation:MaxLength=256
 type LogGroup string
 
+// SubReconcilerName represents the name of a sub-reconciler of the cluster reconciler or the name of a class of
+// sub-reconcilers.
+// +kubebuilder:validation:Enum=UpdateLockConfiguration;UpdateConfigMap;CheckClientCompatibility;FailoverRegion;RollbackUpgrade;UpdateCanary;DeletePodsForBuggification;ReplaceMisconfiguredProcessGroups;ReplaceFailedProcessGroups;MigrateStorageEngine;AutoscaleStorage;AutoscaleStateless;AddProcessGroups;AddServices;AddPVCs;ResizePVCs;AddPods;GenerateInitialClusterFile;RemoveIncompatibleProcesses;UpdateSidecarVersions;UpdatePodConfig;UpdateMetadata;UpdateDatabaseConfiguration;UpdateKnobs;ChooseRemovals;ExcludeProcesses;ChangeCoordinators;BounceProcesses;MaintenanceModeChecker;UpdatePods;RemoveProcessGroups;RemoveServices;Replacements;Removals;PodUpdates
+type SubReconcilerName string
+
+const (
+	// SubReconcilerUpdateLockConfiguration represents the updateLockConfiguration sub-reconciler.
+	SubReconcilerUpdateLockConfiguration SubReconcilerName = "UpdateLockConfiguration"
+	// SubReconcilerUpdateConfigMap represents the updateConfigMap sub-reconciler.
+	SubReconcilerUpdateConfigMap SubReconcilerName = "UpdateConfigMap"
+	// SubReconcilerCheckClientCompatibility represents the checkClientCompatibility sub-reconciler.
+	SubReconcilerCheckClientCompatibility SubReconcilerName = "CheckClientCompatibility"
+	// SubReconcilerUpdateCanary represents the updateCanary sub-reconciler.
+	SubReconcilerUpdateCanary SubReconcilerName = "UpdateCanary"
+	// SubReconcilerFailoverRegion represents the failoverRegion sub-reconciler.
+	SubReconcilerFailoverRegion SubReconcilerName = "FailoverRegion"
+	// SubReconcilerRollbackUpgrade represents the rollbackUpgrade sub-reconciler.
+	SubReconcilerRollbackUpgrade SubReconcilerName = "RollbackUpgrade"
+	// SubReconcilerDeletePodsForBuggification represents the deletePodsForBuggification sub-reconciler.
+	SubReconcilerDeletePodsForBuggification SubReconcilerName = "DeletePodsForBuggification"
+	// SubReconcilerReplaceMisconfiguredProcessGroups represents the replaceMisconfiguredProcessGroups sub-reconciler.
+	SubReconcilerReplaceMisconfiguredProcessGroups SubReconcilerName = "ReplaceMisconfiguredProcessGroups"
+	// SubReconcilerReplaceFailedProcessGroups represents the replaceFailedProcessGroups sub-reconciler.
+	SubReconcilerReplaceFailedProcessGroups SubReconcilerName = "ReplaceFailedProcessGroups"
+	// SubReconcilerMigrateStorageEngine represents the migrateStorageEngine sub-reconciler.
+	SubReconcilerMigrateStorageEngine SubReconcilerName = "MigrateStorageEngine"
+	// SubReconcilerAutoscaleStorage represents the autoscaleStorage sub-reconciler.
+	SubReconcilerAutoscaleStorage SubReconcilerName = "AutoscaleStorage"
+	// SubReconcilerAutoscaleStateless represents the autoscaleStateless sub-reconciler.
+	SubReconcilerAutoscaleStateless SubReconcilerName = "AutoscaleStateless"
+	// SubReconcilerAddProcessGroups represents the addProcessGroups sub-reconciler.
+	SubReconcilerAddProcessGroups SubReconcilerName = "AddProcessGroups"
+	// SubReconcilerAddServices represents the addServices sub-reconciler.
+	SubReconcilerAddServices SubReconcilerName = "AddServices"
+	// SubReconcilerAddPVCs represents the addPVCs sub-reconciler.
+	SubReconcilerAddPVCs SubReconcilerName = "AddPVCs"
+	// SubReconcilerResizePVCs represents the resizePVCs sub-reconciler.
+	SubReconcilerResizePVCs SubReconcilerName = "ResizePVCs"
+	// SubReconcilerAddPods represents the addPods sub-reconciler.
+	SubReconcilerAddPods SubReconcilerName = "AddPods"
+	// SubReconcilerGenerateInitialClusterFile represents the generateInitialClusterFile sub-reconciler.
+	SubReconcilerGenerateInitialClusterFile SubReconcilerName = "GenerateInitialClusterFile"
+	// SubReconcilerRemoveIncompatibleProcesses represents the removeIncompatibleProcesses sub-reconciler.
+	SubReconcilerRemoveIncompatibleProcesses SubReconcilerName = "RemoveIncompatibleProcesses"
+	// SubReconcilerUpdateSidecarVersions represents the updateSidecarVersions sub-reconciler.
+	SubReconcilerUpdateSidecarVersions SubReconcilerName = "UpdateSidecarVersions"
+	// SubReconcilerUpdatePodConfig represents the updatePodConfig sub-reconciler.
+	SubReconcilerUpdatePodConfig SubReconcilerName = "UpdatePodConfig"
+	// SubReconcilerUpdateMetadata represents the updateMetadata sub-reconciler.
+	SubReconcilerUpdateMetadata SubReconcilerName = "UpdateMetadata"
+	// SubReconcilerUpdateDatabaseConfiguration represents the updateDatabaseConfiguration sub-reconciler.
+	SubReconcilerUpdateDatabaseConfiguration SubReconcilerName = "UpdateDatabaseConfiguration"
+	// SubReconcilerUpdateKnobs represents the updateKnobs sub-reconciler.
+	SubReconcilerUpdateKnobs SubReconcilerName = "UpdateKnobs"
+	// SubReconcilerChooseRemovals represents the chooseRemovals sub-reconciler.
+	SubReconcilerChooseRemovals SubReconcilerName = "ChooseRemovals"
+	// SubReconcilerExcludeProcesses represents the excludeProcesses sub-reconciler.
+	SubReconcilerExcludeProcesses SubReconcilerName = "ExcludeProcesses"
+	// SubReconcilerChangeCoordinators represents the changeCoordinators sub-reconciler.
+	SubReconcilerChangeCoordinators SubReconcilerName = "ChangeCoordinators"
+	// SubReconcilerBounceProcesses represents the bounceProcesses sub-reconciler.
+	SubReconcilerBounceProcesses SubReconcilerName = "BounceProcesses"
+	// SubReconcilerMaintenanceModeChecker represents the maintenanceModeChecker sub-reconciler.
+	SubReconcilerMaintenanceModeChecker SubReconcilerName = "MaintenanceModeChecker"
+	// SubReconcilerUpdatePods represents the updatePods sub-reconciler.
+	SubReconcilerUpdatePods SubReconcilerName = "UpdatePods"
+	// SubReconcilerRemoveProcessGroups represents the removeProcessGroups sub-reconciler.
+	SubReconcilerRemoveProcessGroups SubReconcilerName = "RemoveProcessGroups"
+	// SubReconcilerRemoveServices represents the removeServices sub-reconciler.
+	SubReconcilerRemoveServices SubReconcilerName = "RemoveServices"
+	// SubReconcilerClassReplacements represents all sub-reconcilers that replace process groups.
+	SubReconcilerClassReplacements SubReconcilerName = "Replacements"
+	// SubReconcilerClassRemovals represents all sub-reconcilers that exclude and remove process groups.
+	SubReconcilerClassRemovals SubReconcilerName = "Removals"
+	// SubReconcilerClassPodUpdates represents all sub-reconcilers that update or recreate Pods.
+	SubReconcilerClassPodUpdates SubReconcilerName = "PodUpdates"
+)
+
+// disruptiveSubReconcilers contains the sub-reconcilers that are only allowed to run inside the maintenance windows.
+// Some sub-reconcilers that change the cluster are still allowed to run outside of the maintenance windows:
+// ResizePVCs expands the volumes online and the Pods are only recreated by UpdatePods, MigrateStorageEngine only
+// marks process groups for replacement, which are excluded and removed by ExcludeProcesses and RemoveProcessGroups.
+// ChangeCoordinators only selects new coordinators if the current coordinators are not valid anymore and
+// FailoverRegion only fails over if the primary region is unavailable, deferring those would reduce the fault
+// tolerance or the availability of the cluster.
+var disruptiveSubReconcilers = map[SubReconcilerName]None{
+	SubReconcilerRemoveIncompatibleProcesses: {},
+	SubReconcilerExcludeProcesses:            {},
+	SubReconcilerBounceProcesses:             {},
+	SubReconcilerUpdatePods:                  {},
+	SubReconcilerRemoveProcessGroups:         {},
+}
+
+// subReconcilerClasses contains the sub-reconcilers for each class of sub-reconcilers.
+var subReconcilerClasses = map[SubReconcilerName][]SubReconcilerName{
+	SubReconcilerClassReplacements: {SubReconcilerReplaceMisconfiguredProcessGroups, SubReconcilerReplaceFailedProcessGroups, SubReconcilerMigrateStorageEngine},
+	SubReconcilerClassRemovals:     {SubReconcilerChooseRemovals, SubReconcilerExcludeProcesses, SubReconcilerRemoveProcessGroups, SubReconcilerRemoveServices},
+	SubReconcilerClassPodUpdates:   {SubReconcilerUpdateSidecarVersions, SubReconcilerUpdatePodConfig, SubReconcilerUpdatePods},
+}
+
 // MaintenanceModeOptions controls options for placing zones in maintenance mode.
 type MaintenanceModeOptions struct {
 	// UseMaintenanceModeChecker defines whether the operator is allowed to use maintenance mode before updating pods.
@@ -1185,6 +1496,485 @@ type MaintenanceModeOptions struct {
 	MaintenanceModeTimeSeconds *int `json:"maintenanceModeTimeSeconds,omitempty"`
 }
 
+// MaintenanceWindowOptions controls when the operator is allowed to perform disruptive operations. Outside of the
+// allowed windows the disruptive sub-reconcilers will be deferred, all other sub-reconcilers continue to run.
+type MaintenanceWindowOptions struct {
+	// Windows defines the time windows in which disruptive operations are allowed. If no windows are defined,
+	// disruptive operations are allowed at any time, except during the deny windows.
+	// +kubebuilder:validation:MaxItems=20
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+
+	// DenyWindows defines blackout periods in which no disruptive operations are allowed, even if they overlap with
+	// one of the windows.
+	// +kubebuilder:validation:MaxItems=20
+	DenyWindows []MaintenanceWindow `json:"denyWindows,omitempty"`
+
+	// TimeZone defines the IANA time zone that is used to evaluate the schedules of the windows, e.g. "Europe/Berlin".
+	// Default is UTC.
+	// +kubebuilder:validation:MaxLength=64
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// AllowFailedReplacementsOutsideWindow defines if the exclusion and removal of process groups that were
+	// replaced because they failed is allowed outside of the windows. This only takes effect if all process groups
+	// that are marked for removal have failed.
+	// Default is false.
+	AllowFailedReplacementsOutsideWindow *bool `json:"allowFailedReplacementsOutsideWindow,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window.
+type MaintenanceWindow struct {
+	// Schedule defines the start of the window in the standard cron format, e.g. "0 2 * * 1-5" for 2am on every
+	// weekday.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Schedule string `json:"schedule"`
+
+	// DurationSeconds defines how long the window lasts after its start.
+	// +kubebuilder:validation:Minimum=1
+	DurationSeconds int `json:"durationSeconds"`
+}
+
+// MaintenanceWindowStatus provides information about the maintenance windows of the cluster.
+type MaintenanceWindowStatus struct {
+	// DisruptionAllowed defines if disruptive operations were allowed during the last reconciliation.
+	DisruptionAllowed bool `json:"disruptionAllowed"`
+
+	// NextWindowStart defines when disruptive operations will be allowed next.
+	NextWindowStart *metav1.Time `json:"nextWindowStart,omitempty"`
+
+	// DeferredSubReconcilers contains the sub-reconcilers that were deferred during the last reconciliation because
+	// they are not allowed to run outside of the maintenance windows.
+	DeferredSubReconcilers []SubReconcilerName `json:"deferredSubReconcilers,omitempty"`
+}
+
+// CanaryOptions controls how changes are rolled out to a subset of process groups before they are rolled out to the
+// whole cluster.
+type CanaryOptions struct {
+	// Enabled defines if changes should be rolled out to the canary process groups first.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Mode defines how the canary process groups are selected.
+	// Default is ProcessGroups.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ProcessGroups;FaultDomain
+	Mode CanaryMode `json:"mode,omitempty"`
+
+	// ProcessGroupCount defines the number of process groups per process class that are updated in the canary phase
+	// if the mode is ProcessGroups.
+	// Default is 1.
+	// +kubebuilder:validation:Minimum=1
+	ProcessGroupCount *int `json:"processGroupCount,omitempty"`
+
+	// SoakTimeSeconds defines how long the canary process groups must be healthy before the rollout continues.
+	// Default is 600.
+	// +kubebuilder:validation:Minimum=0
+	SoakTimeSeconds *int `json:"soakTimeSeconds,omitempty"`
+
+	// ManualPromotion defines if the rollout should be halted after the soak time until it is promoted by setting
+	// the foundationdb.org/promote-canary annotation.
+	// Default is false.
+	ManualPromotion *bool `json:"manualPromotion,omitempty"`
+}
+
+// CanaryMode defines how the canary process groups are selected.
+// +kubebuilder:validation:MaxLength=32
+type CanaryMode string
+
+const (
+	// CanaryModeProcessGroups selects the configured number of process groups per process class as canaries.
+	CanaryModeProcessGroups CanaryMode = "ProcessGroups"
+	// CanaryModeFaultDomain selects the process groups of one fault domain per process class as canaries.
+	CanaryModeFaultDomain CanaryMode = "FaultDomain"
+)
+
+// CanaryPhase represents the phase of a canary rollout.
+// +kubebuilder:validation:MaxLength=32
+type CanaryPhase string
+
+const (
+	// CanaryPhaseUpdating represents a canary rollout where the canary process groups are being updated.
+	CanaryPhaseUpdating CanaryPhase = "Updating"
+	// CanaryPhaseSoaking represents a canary rollout where the canary process groups are updated and the operator
+	// waits for the soak time.
+	CanaryPhaseSoaking CanaryPhase = "Soaking"
+	// CanaryPhaseAwaitingPromotion represents a canary rollout that passed the soak time and waits for the manual
+	// promotion.
+	CanaryPhaseAwaitingPromotion CanaryPhase = "AwaitingPromotion"
+	// CanaryPhaseHalted represents a canary rollout that failed the health checks during the soak time.
+	CanaryPhaseHalted CanaryPhase = "Halted"
+	// CanaryPhasePromoted represents a canary rollout that is promoted and will be rolled out to all process groups.
+	CanaryPhasePromoted CanaryPhase = "Promoted"
+)
+
+// CanaryStatus provides information about a canary rollout.
+type CanaryStatus struct {
+	// Generation defines the generation of the cluster spec that is rolled out.
+	Generation int64 `json:"generation"`
+
+	// Phase defines the current phase of the canary rollout.
+	Phase CanaryPhase `json:"phase"`
+
+	// ProcessGroups contains the process groups that are updated in the canary phase.
+	// +kubebuilder:validation:MaxItems=1000
+	ProcessGroups []ProcessGroupID `json:"processGroups,omitempty"`
+
+	// SoakStartTime defines when the canary process groups were updated and the soak time started.
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+
+	// Message provides more details about the current phase.
+	Message string `json:"message,omitempty"`
+}
+
+// UpgradeRollbackOptions controls if the operator rolls back protocol compatible upgrades that stall.
+type UpgradeRollbackOptions struct {
+	// Enabled defines if the operator should roll back a protocol compatible upgrade that didn't finish within the
+	// stall timeout. Version incompatible upgrades will never be rolled back.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// StallTimeoutSeconds defines how long a protocol compatible upgrade can take before it is considered stalled.
+	// Default is 3600.
+	// +kubebuilder:validation:Minimum=60
+	StallTimeoutSeconds *int `json:"stallTimeoutSeconds,omitempty"`
+}
+
+// UpgradeStatus provides information about a protocol compatible upgrade in progress.
+type UpgradeStatus struct {
+	// Version defines the version the cluster is upgraded to.
+	Version string `json:"version"`
+
+	// PreviousVersion defines the version the cluster was running before the upgrade started.
+	PreviousVersion string `json:"previousVersion"`
+
+	// StartTime defines when the operator detected the upgrade.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// UpgradeRollbackStatus provides information about an upgrade that was rolled back.
+type UpgradeRollbackStatus struct {
+	// FailedVersion defines the version of the upgrade that was rolled back. This version is locked out until the
+	// rollback is acknowledged with the foundationdb.org/acknowledge-upgrade-rollback annotation.
+	FailedVersion string `json:"failedVersion"`
+
+	// PreviousVersion defines the version the cluster was rolled back to.
+	PreviousVersion string `json:"previousVersion"`
+
+	// Reason provides details why the upgrade was rolled back.
+	Reason string `json:"reason,omitempty"`
+
+	// Timestamp defines when the upgrade was rolled back.
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
+// RegionFailoverOptions controls if the operator fails over to another region if the primary data center is unhealthy.
+type RegionFailoverOptions struct {
+	// Enabled defines if the operator should fail over to another region if no process of the primary data center is
+	// reporting to the cluster. The failover is only performed for clusters with usable_regions set to 2 and if the
+	// operator is allowed to configure the database.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// FailoverDelaySeconds defines how long the primary data center must be unhealthy before the operator fails over.
+	// The same delay is used for the original primary data center to be healthy before the operator fails back.
+	// Default is 300.
+	// +kubebuilder:validation:Minimum=30
+	FailoverDelaySeconds *int `json:"failoverDelaySeconds,omitempty"`
+
+	// MaximumDataCenterLagSeconds defines the maximum lag of the remote data center. The operator will not fail over or
+	// fail back if the remote data center is further behind.
+	// Default is 10.
+	// +kubebuilder:validation:Minimum=0
+	MaximumDataCenterLagSeconds *int `json:"maximumDataCenterLagSeconds,omitempty"`
+
+	// Failback defines if the operator should fail back to the original primary data center once it is healthy again
+	// and has caught up. If disabled the failover stays active until the region priorities in the spec are updated.
+	// Default is false.
+	Failback *bool `json:"failback,omitempty"`
+}
+
+// RegionFailoverStatus provides information about an unhealthy primary data center and a region failover performed by
+// the operator.
+type RegionFailoverStatus struct {
+	// PrimaryUnhealthySince defines since when the primary data center is unhealthy.
+	PrimaryUnhealthySince *metav1.Time `json:"primaryUnhealthySince,omitempty"`
+
+	// OriginalPrimary defines the primary data center from the spec before the operator failed over.
+	OriginalPrimary string `json:"originalPrimary,omitempty"`
+
+	// ActivePrimary defines the data center the operator failed over to. The active primary will have the highest
+	// priority in the database configuration until the operator fails back or the spec is updated.
+	ActivePrimary string `json:"activePrimary,omitempty"`
+
+	// FailoverTime defines when the operator failed over.
+	FailoverTime *metav1.Time `json:"failoverTime,omitempty"`
+
+	// OriginalPrimaryHealthySince defines since when the original primary data center is healthy again and has caught
+	// up with the active primary.
+	OriginalPrimaryHealthySince *metav1.Time `json:"originalPrimaryHealthySince,omitempty"`
+
+	// Message provides details about the last failover decision of the operator.
+	Message string `json:"message,omitempty"`
+}
+
+// StorageWiggleStatus contains information about the progress of the perpetual storage wiggle in the primary region.
+type StorageWiggleStatus struct {
+	// WigglingServerIDs contains the IDs of the storage servers that are currently wiggled.
+	// +kubebuilder:validation:MaxItems=100
+	WigglingServerIDs []string `json:"wigglingServerIDs,omitempty"`
+
+	// WigglingServerAddresses contains the addresses of the storage servers that are currently wiggled.
+	// +kubebuilder:validation:MaxItems=100
+	WigglingServerAddresses []string `json:"wigglingServerAddresses,omitempty"`
+
+	// FinishedRounds defines how many rounds of the perpetual storage wiggle have been finished.
+	FinishedRounds int `json:"finishedRounds,omitempty"`
+
+	// FinishedWiggles defines how many storage servers have been wiggled.
+	FinishedWiggles int `json:"finishedWiggles,omitempty"`
+
+	// LastRoundFinishTime defines when the last round of the perpetual storage wiggle was finished.
+	LastRoundFinishTime *metav1.Time `json:"lastRoundFinishTime,omitempty"`
+}
+
+// StorageEngineMigrationOptions defines how the operator migrates storage process groups to the configured storage
+// engine.
+type StorageEngineMigrationOptions struct {
+	// Enabled defines if the operator should replace storage process groups that use a different storage engine than
+	// the configured storage engine. The process groups will be replaced in batches, one fault domain at a time.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaximumInFlightBytes defines the maximum number of bytes that can be actively moved by data distribution before
+	// the operator starts the next batch.
+	// Default is 0.
+	// +kubebuilder:validation:Minimum=0
+	MaximumInFlightBytes *int `json:"maximumInFlightBytes,omitempty"`
+
+	// MaximumInQueueBytes defines the maximum number of bytes that can be pending for data movement before the
+	// operator starts the next batch.
+	// Default is 0.
+	// +kubebuilder:validation:Minimum=0
+	MaximumInQueueBytes *int `json:"maximumInQueueBytes,omitempty"`
+}
+
+// StorageEngineMigrationStatus contains information about the migration of the storage process groups to the
+// configured storage engine.
+type StorageEngineMigrationStatus struct {
+	// StorageEngine defines the storage engine the storage process groups are migrated to.
+	StorageEngine StorageEngine `json:"storageEngine,omitempty"`
+
+	// StorageServers contains the number of storage servers for each storage engine.
+	StorageServers map[StorageEngine]int `json:"storageServers,omitempty"`
+
+	// FaultDomain defines the fault domain of the current batch.
+	FaultDomain FaultDomain `json:"faultDomain,omitempty"`
+
+	// ProcessGroups contains the process groups of the current batch.
+	// +kubebuilder:validation:MaxItems=1000
+	ProcessGroups []ProcessGroupID `json:"processGroups,omitempty"`
+
+	// BatchStartTime defines when the current batch was started.
+	BatchStartTime *metav1.Time `json:"batchStartTime,omitempty"`
+
+	// CompletedBatches defines how many batches have been completed.
+	CompletedBatches int `json:"completedBatches,omitempty"`
+
+	// Message provides details about the current state of the migration.
+	Message string `json:"message,omitempty"`
+}
+
+// StorageAutoscalingOptions defines how the operator scales the number of storage process groups based on the disk
+// utilization of the storage servers.
+type StorageAutoscalingOptions struct {
+	// Enabled defines if the operator should scale the number of storage process groups based on the disk
+	// utilization. If enabled the storage process count is managed by the operator within the defined bounds.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinimumStorageProcessGroups defines the lower bound for the number of storage process groups.
+	// Default is the storage process count of the cluster spec.
+	// +kubebuilder:validation:Minimum=1
+	MinimumStorageProcessGroups *int `json:"minimumStorageProcessGroups,omitempty"`
+
+	// MaximumStorageProcessGroups defines the upper bound for the number of storage process groups.
+	// Default is the minimum number of storage process groups.
+	// +kubebuilder:validation:Minimum=1
+	MaximumStorageProcessGroups *int `json:"maximumStorageProcessGroups,omitempty"`
+
+	// ScaleUpUtilization defines the disk utilization in percent above which the operator adds storage process
+	// groups.
+	// Default is 80.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ScaleUpUtilization *int `json:"scaleUpUtilization,omitempty"`
+
+	// ScaleDownUtilization defines the disk utilization in percent below which the operator removes storage process
+	// groups.
+	// Default is 40.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ScaleDownUtilization *int `json:"scaleDownUtilization,omitempty"`
+
+	// ScaleUpDelaySeconds defines how long the disk utilization must stay above the scale up utilization before the
+	// operator adds storage process groups.
+	// Default is 600.
+	// +kubebuilder:validation:Minimum=0
+	ScaleUpDelaySeconds *int `json:"scaleUpDelaySeconds,omitempty"`
+
+	// ScaleDownDelaySeconds defines how long the disk utilization must stay below the scale down utilization before
+	// the operator removes a storage process group.
+	// Default is 3600.
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownDelaySeconds *int `json:"scaleDownDelaySeconds,omitempty"`
+
+	// CooldownSeconds defines the minimum time between two scaling decisions.
+	// Default is 1800.
+	// +kubebuilder:validation:Minimum=0
+	CooldownSeconds *int `json:"cooldownSeconds,omitempty"`
+}
+
+// StorageAutoscalingStatus contains information about the scaling of the storage process groups.
+type StorageAutoscalingStatus struct {
+	// DesiredStorageProcessGroups defines the number of storage process groups chosen by the autoscaler.
+	DesiredStorageProcessGroups int `json:"desiredStorageProcessGroups,omitempty"`
+
+	// Utilization defines the disk utilization in percent of all storage servers of the last check.
+	Utilization int `json:"utilization,omitempty"`
+
+	// KVBytes defines the total key-value size of the database of the last check.
+	KVBytes int `json:"kvBytes,omitempty"`
+
+	// HighUtilizationSince defines since when the disk utilization is above the scale up utilization.
+	HighUtilizationSince *metav1.Time `json:"highUtilizationSince,omitempty"`
+
+	// LowUtilizationSince defines since when the disk utilization is below the scale down utilization.
+	LowUtilizationSince *metav1.Time `json:"lowUtilizationSince,omitempty"`
+
+	// Decisions contains the most recent scaling decisions, the latest decision is the last entry.
+	// +kubebuilder:validation:MaxItems=10
+	Decisions []StorageAutoscalingDecision `json:"decisions,omitempty"`
+}
+
+// StorageAutoscalingDecision describes a single scaling decision of the storage autoscaler.
+type StorageAutoscalingDecision struct {
+	// Timestamp defines when the decision was made.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// From defines the number of storage process groups before the decision.
+	From int `json:"from"`
+
+	// To defines the number of storage process groups after the decision.
+	To int `json:"to"`
+
+	// Utilization defines the disk utilization in percent that led to the decision.
+	Utilization int `json:"utilization"`
+
+	// KVBytes defines the total key-value size of the database when the decision was made.
+	KVBytes int `json:"kvBytes,omitempty"`
+}
+
+// StatelessAutoscalingOptions defines how the operator scales the number of commit proxies, grv proxies and resolvers
+// based on the workload of the database.
+type StatelessAutoscalingOptions struct {
+	// Enabled defines if the operator should scale the number of commit proxies, grv proxies and resolvers based on
+	// the workload of the database. This requires that the commit proxies and grv proxies are configured in the
+	// database configuration.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CommitProxies defines the bounds for the number of commit proxies.
+	CommitProxies RoleCountLimits `json:"commitProxies,omitempty"`
+
+	// GrvProxies defines the bounds for the number of grv proxies.
+	GrvProxies RoleCountLimits `json:"grvProxies,omitempty"`
+
+	// Resolvers defines the bounds for the number of resolvers.
+	Resolvers RoleCountLimits `json:"resolvers,omitempty"`
+
+	// CommittedTransactionsPerCommitProxy defines how many committed transactions per second a single commit proxy
+	// should handle.
+	// Default is 5000.
+	// +kubebuilder:validation:Minimum=1
+	CommittedTransactionsPerCommitProxy *int `json:"committedTransactionsPerCommitProxy,omitempty"`
+
+	// StartedTransactionsPerGrvProxy defines how many started transactions per second a single grv proxy should
+	// handle.
+	// Default is 20000.
+	// +kubebuilder:validation:Minimum=1
+	StartedTransactionsPerGrvProxy *int `json:"startedTransactionsPerGrvProxy,omitempty"`
+
+	// ResolvedTransactionsPerResolver defines how many resolved transactions per second, committed and conflicted,
+	// a single resolver should handle.
+	// Default is 10000.
+	// +kubebuilder:validation:Minimum=1
+	ResolvedTransactionsPerResolver *int `json:"resolvedTransactionsPerResolver,omitempty"`
+
+	// MaximumProxyCPUUtilization defines the average CPU utilization in percent of a core above which the operator
+	// adds commit proxies or grv proxies, independent of the transaction rates.
+	// Default is 70.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MaximumProxyCPUUtilization *int `json:"maximumProxyCPUUtilization,omitempty"`
+
+	// ScaleDownHysteresis defines in percent how far the workload must be below the capacity of the current role
+	// counts before the operator removes roles. This prevents the role counts from flapping.
+	// Default is 20.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90
+	ScaleDownHysteresis *int `json:"scaleDownHysteresis,omitempty"`
+
+	// CooldownSeconds defines the minimum time between two changes of the role counts.
+	// Default is 300.
+	// +kubebuilder:validation:Minimum=0
+	CooldownSeconds *int `json:"cooldownSeconds,omitempty"`
+}
+
+// RoleCountLimits defines the bounds for the number of processes of a role.
+type RoleCountLimits struct {
+	// Minimum defines the lower bound for the role count.
+	// Default is the role count of the database configuration.
+	// +kubebuilder:validation:Minimum=1
+	Minimum *int `json:"minimum,omitempty"`
+
+	// Maximum defines the upper bound for the role count.
+	// Default is the minimum.
+	// +kubebuilder:validation:Minimum=1
+	Maximum *int `json:"maximum,omitempty"`
+}
+
+// StatelessAutoscalingStatus contains information about the scaling of the commit proxies, grv proxies and resolvers.
+type StatelessAutoscalingStatus struct {
+	// CommitProxies defines the number of commit proxies chosen by the autoscaler.
+	CommitProxies int `json:"commitProxies,omitempty"`
+
+	// GrvProxies defines the number of grv proxies chosen by the autoscaler.
+	GrvProxies int `json:"grvProxies,omitempty"`
+
+	// Resolvers defines the number of resolvers chosen by the autoscaler.
+	Resolvers int `json:"resolvers,omitempty"`
+
+	// StartedTransactionsPerSecond defines the rate of started transactions of the last check.
+	StartedTransactionsPerSecond int `json:"startedTransactionsPerSecond,omitempty"`
+
+	// CommittedTransactionsPerSecond defines the rate of committed transactions of the last check.
+	CommittedTransactionsPerSecond int `json:"committedTransactionsPerSecond,omitempty"`
+
+	// ConflictRate defines the percentage of resolved transactions that conflicted in the last check.
+	ConflictRate int `json:"conflictRate,omitempty"`
+
+	// CommitProxyCPUUtilization defines the average CPU utilization of the commit proxies in percent of a core of
+	// the last check.
+	CommitProxyCPUUtilization int `json:"commitProxyCPUUtilization,omitempty"`
+
+	// GrvProxyCPUUtilization defines the average CPU utilization of the grv proxies in percent of a core of the last
+	// check.
+	GrvProxyCPUUtilization int `json:"grvProxyCPUUtilization,omitempty"`
+
+	// LastScaleTime defines when the role counts were changed the last time.
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
 // TaintReplacementOption defines the taint key and taint duration the operator will react to a tainted node
 // Example of TaintReplacementOption
 //   - key: "example.org/maintenance"
@@ -1261,6 +2051,14 @@ type ProcessSettings struct {
 	// CustomParameters defines additional parameters to pass to the fdbserver
 	// process.
 	CustomParameters FoundationDBCustomParameters `json:"customParameters,omitempty"`
+
+	// Knobs defines the knobs that should be set for the fdbserver processes. The knobs are validated against the
+	// knob catalog of the operator. Knobs that can be updated on running processes will be applied without a restart
+	// if the version supports it, all other knobs will be passed as command line arguments.
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=name
+	Knobs []FoundationDBKnob `json:"knobs,omitempty"`
 }
 
 // GetProcessSettings gets settings for a process.
@@ -1284,6 +2082,9 @@ func (cluster *FoundationDBCluster) GetProcessSettings(processClass ProcessClass
 		if merged.CustomParameters == nil {
 			merged.CustomParameters = entry.CustomParameters
 		}
+		if merged.Knobs == nil {
+			merged.Knobs = entry.Knobs
+		}
 	}
 
 	return merged
@@ -1309,9 +2110,14 @@ func (cluster *FoundationDBCluster) GetProcessSettings(processClass ProcessClass
 // the UsableRegions is greater than 1. It will be equal to -1 when the
 // UsableRegions is less than or equal to 1.
 func (cluster *FoundationDBCluster) GetRoleCountsWithDefaults() RoleCounts {
-	// We can ignore the error here since the version will be validated in an earlier step.
-	version, _ := ParseFdbVersion(cluster.GetRunningVersion())
-	return cluster.Spec.DatabaseConfiguration.GetRoleCountsWithDefaults(version, cluster.DesiredFaultTolerance())
+	roleCounts := cluster.getConfiguredRoleCounts()
+	// If the stateless autoscaling is enabled, the operator manages the commit proxies, grv proxies and resolvers
+	// within the defined bounds.
+	if cluster.UseStatelessAutoscaling() {
+		cluster.applyStatelessAutoscaling(&roleCounts)
+	}
+
+	return roleCounts
 }
 
 // calculateProcessCount determines the process count from a given role count.
@@ -1411,6 +2217,11 @@ func (cluster *FoundationDBCluster) GetProcessCountsWithDefaults() (ProcessCount
 			roleCounts.Storage)
 	}
 
+	// If the storage autoscaling is enabled, the operator manages the storage process count within the defined bounds.
+	if cluster.UseStorageAutoscaling() && processCounts.Storage > 0 {
+		processCounts.Storage = cluster.getDesiredStorageProcessGroups(processCounts.Storage)
+	}
+
 	if processCounts.Log == 0 {
 		processCounts.Log = cluster.calculateProcessCount(true,
 			cluster.calculateProcessCountFromRole(roleCounts.Logs+satelliteLogs, processCounts.Log),
@@ -1441,6 +2252,10 @@ func (cluster *FoundationDBCluster) GetProcessCountsWithDefaults() (ProcessCount
 			primaryStatelessCount,
 			cluster.calculateProcessCountFromRole(roleCounts.LogRouters),
 		)
+	} else if cluster.UseStatelessAutoscaling() && processCounts.Stateless > 0 {
+		// If the stateless process count is defined in the spec, the roles added or removed by the stateless
+		// autoscaling must be reflected in the stateless process count.
+		processCounts.Stateless += cluster.getStatelessAutoscalingProcessDelta(roleCounts)
 	}
 
 	return *processCounts, nil
@@ -1825,9 +2640,17 @@ type ContainerOverrides struct {
 }
 
 // DesiredDatabaseConfiguration builds the database configuration for the
-// cluster based on its spec.
+// cluster based on its spec and an active region failover.
 func (cluster *FoundationDBCluster) DesiredDatabaseConfiguration() DatabaseConfiguration {
-	configuration := cluster.Spec.DatabaseConfiguration.NormalizeConfigurationWithSeparatedProxies(cluster.GetRunningVersion(), cluster.Spec.DatabaseConfiguration.AreSeparatedProxiesConfigured())
+	databaseConfiguration := cluster.Spec.DatabaseConfiguration
+	// If the operator failed over to another region, the active primary keeps the highest priority until the
+	// operator fails back or the spec is updated.
+	activePrimary := cluster.GetActivePrimaryDataCenter()
+	if activePrimary != "" {
+		databaseConfiguration = databaseConfiguration.FailOverTo(activePrimary)
+	}
+
+	configuration := databaseConfiguration.NormalizeConfigurationWithSeparatedProxies(cluster.GetRunningVersion(), cluster.Spec.DatabaseConfiguration.AreSeparatedProxiesConfigured())
 	configuration.RoleCounts = cluster.GetRoleCountsWithDefaults()
 	configuration.RoleCounts.Storage = 0
 
@@ -1863,9 +2686,40 @@ func (cluster *FoundationDBCluster) ClearMissingVersionFlags(configuration *Data
 	}
 }
 
+// ClearMissingStorageWiggleSettings clears the perpetual storage wiggle and storage migration settings in the given
+// configuration that are not set in the configuration in the cluster spec.
+//
+// This allows us to compare the spec to the live configuration while ignoring settings that are not managed by the
+// operator.
+func (cluster *FoundationDBCluster) ClearMissingStorageWiggleSettings(configuration *DatabaseConfiguration) {
+	if cluster.Spec.DatabaseConfiguration.PerpetualStorageWiggle == nil {
+		configuration.PerpetualStorageWiggle = nil
+	}
+	if cluster.Spec.DatabaseConfiguration.PerpetualStorageWiggleLocality == nil {
+		configuration.PerpetualStorageWiggleLocality = nil
+	}
+	if cluster.Spec.DatabaseConfiguration.StorageMigrationType == nil {
+		configuration.StorageMigrationType = nil
+	}
+	if cluster.Spec.DatabaseConfiguration.PerpetualStorageWiggleEngine == nil {
+		configuration.PerpetualStorageWiggleEngine = nil
+	}
+}
+
+// ClearMissingTenantMode clears the tenant mode in the given configuration if it is not set in the configuration in
+// the cluster spec.
+//
+// This allows us to compare the spec to the live configuration while ignoring settings that are not managed by the
+// operator.
+func (cluster *FoundationDBCluster) ClearMissingTenantMode(configuration *DatabaseConfiguration) {
+	if cluster.Spec.DatabaseConfiguration.TenantMode == nil {
+		configuration.TenantMode = nil
+	}
+}
+
 // IsBeingUpgraded determines whether the cluster has a pending upgrade.
 func (cluster *FoundationDBCluster) IsBeingUpgraded() bool {
-	return cluster.Status.RunningVersion != "" && cluster.Status.RunningVersion != cluster.Spec.Version
+	return cluster.Status.RunningVersion != "" && cluster.Status.RunningVersion != cluster.GetDesiredVersion()
 }
 
 // IsBeingUpgradedWithVersionIncompatibleVersion determines whether the cluster has a pending upgrade to a version incompatible version.
@@ -1875,7 +2729,7 @@ func (cluster *FoundationDBCluster) IsBeingUpgradedWithVersionIncompatibleVersio
 	}
 
 	runningVersion, _ := ParseFdbVersion(cluster.Status.RunningVersion)
-	desiredVersion, _ := ParseFdbVersion(cluster.Spec.Version)
+	desiredVersion, _ := ParseFdbVersion(cluster.GetDesiredVersion())
 
 	return !runningVersion.IsProtocolCompatible(desiredVersion)
 }
@@ -1888,7 +2742,7 @@ func (cluster *FoundationDBCluster) VersionCompatibleUpgradeInProgress() bool {
 	}
 
 	runningVersion, _ := ParseFdbVersion(cluster.Status.RunningVersion)
-	desiredVersion, _ := ParseFdbVersion(cluster.Spec.Version)
+	desiredVersion, _ := ParseFdbVersion(cluster.GetDesiredVersion())
 
 	return runningVersion.IsProtocolCompatible(desiredVersion)
 }
@@ -2784,6 +3638,63 @@ func (cluster *FoundationDBCluster) Validate() error {
 		validations = append(validations, fmt.Sprintf("storage engine %s is not supported on version %s", cluster.Spec.DatabaseConfiguration.StorageEngine, cluster.Spec.Version))
 	}
 
+	// Check if the perpetual storage wiggle settings are supported by the defined FDB version.
+	databaseConfiguration := cluster.Spec.DatabaseConfiguration
+	if !version.SupportsPerpetualStorageWiggle() && (databaseConfiguration.PerpetualStorageWiggle != nil || databaseConfiguration.PerpetualStorageWiggleLocality != nil || databaseConfiguration.StorageMigrationType != nil) {
+		validations = append(validations, fmt.Sprintf("perpetual storage wiggle settings are not supported on version %s", cluster.Spec.Version))
+	}
+
+	if databaseConfiguration.PerpetualStorageWiggleEngine != nil {
+		if !version.SupportsPerpetualStorageWiggleEngine() {
+			validations = append(validations, fmt.Sprintf("perpetual storage wiggle engine is not supported on version %s", cluster.Spec.Version))
+		} else if !version.IsStorageEngineSupported(*databaseConfiguration.PerpetualStorageWiggleEngine) {
+			validations = append(validations, fmt.Sprintf("perpetual storage wiggle engine %s is not supported on version %s", *databaseConfiguration.PerpetualStorageWiggleEngine, cluster.Spec.Version))
+		}
+	}
+
+	if databaseConfiguration.TenantMode != nil && !version.SupportsTenants() {
+		validations = append(validations, fmt.Sprintf("tenant mode is not supported on version %s", cluster.Spec.Version))
+	}
+
+	// Check if the storage autoscaling settings are consistent.
+	autoscalingOptions := cluster.Spec.AutomationOptions.StorageAutoscalingOptions
+	if autoscalingOptions.MinimumStorageProcessGroups != nil && autoscalingOptions.MaximumStorageProcessGroups != nil && *autoscalingOptions.MaximumStorageProcessGroups < *autoscalingOptions.MinimumStorageProcessGroups {
+		validations = append(validations, fmt.Sprintf("storage autoscaling maximum %d must not be smaller than the minimum %d", *autoscalingOptions.MaximumStorageProcessGroups, *autoscalingOptions.MinimumStorageProcessGroups))
+	}
+
+	if cluster.GetStorageAutoscalingScaleDownUtilization() >= cluster.GetStorageAutoscalingScaleUpUtilization() {
+		validations = append(validations, fmt.Sprintf("storage autoscaling scale down utilization %d must be smaller than the scale up utilization %d", cluster.GetStorageAutoscalingScaleDownUtilization(), cluster.GetStorageAutoscalingScaleUpUtilization()))
+	}
+
+	// Check if the stateless autoscaling settings are consistent.
+	if cluster.UseStatelessAutoscaling() && (!version.HasSeparatedProxies() || !databaseConfiguration.AreSeparatedProxiesConfigured()) {
+		validations = append(validations, "stateless autoscaling requires commit proxies and grv proxies to be configured")
+	}
+
+	statelessAutoscalingOptions := cluster.Spec.AutomationOptions.StatelessAutoscalingOptions
+	roleLimits := []struct {
+		role   string
+		limits RoleCountLimits
+	}{
+		{role: "commit proxies", limits: statelessAutoscalingOptions.CommitProxies},
+		{role: "grv proxies", limits: statelessAutoscalingOptions.GrvProxies},
+		{role: "resolvers", limits: statelessAutoscalingOptions.Resolvers},
+	}
+	for _, roleLimit := range roleLimits {
+		limits := roleLimit.limits
+		if limits.Minimum != nil && limits.Maximum != nil && *limits.Maximum < *limits.Minimum {
+			validations = append(validations, fmt.Sprintf("stateless autoscaling maximum %d for %s must not be smaller than the minimum %d", *limits.Maximum, roleLimit.role, *limits.Minimum))
+		}
+	}
+
+	// Check if the knobs are part of the knob catalog for the defined FDB version.
+	for processClass, settings := range cluster.Spec.Processes {
+		err = ValidateKnobs(settings.Knobs, settings.CustomParameters, version)
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("process class %s: %s", processClass, err.Error()))
+		}
+	}
+
 	// Check if all coordinator processes are stateful
 	for _, selection := range cluster.Spec.CoordinatorSelection {
 		if !selection.ProcessClass.IsStateful() {
@@ -2791,6 +3702,22 @@ func (cluster *FoundationDBCluster) Validate() error {
 		}
 	}
 
+	// Check if the maintenance windows are valid
+	if cluster.HasMaintenanceWindows() {
+		_, err = time.LoadLocation(cluster.GetMaintenanceWindowTimeZone())
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("time zone %s for maintenance windows is not valid: %s", cluster.GetMaintenanceWindowTimeZone(), err.Error()))
+		}
+
+		options := cluster.Spec.AutomationOptions.MaintenanceWindowOptions
+		for _, windows := range [][]MaintenanceWindow{options.Windows, options.DenyWindows} {
+			_, err = parseMaintenanceWindows(windows)
+			if err != nil {
+				validations = append(validations, err.Error())
+			}
+		}
+	}
+
 	if len(validations) == 0 {
 		return nil
 	}
@@ -2816,6 +3743,565 @@ func (cluster *FoundationDBCluster) CacheDatabaseStatusForReconciliation(default
 	return pointer.BoolDeref(cluster.Spec.AutomationOptions.CacheDatabaseStatusForReconciliation, defaultValue)
 }
 
+// GetSuspendedSubReconcilers returns the sorted list of suspended sub-reconcilers. Classes of sub-reconcilers will
+// be expanded to the sub-reconcilers of that class.
+func (cluster *FoundationDBCluster) GetSuspendedSubReconcilers() []SubReconcilerName {
+	if len(cluster.Spec.AutomationOptions.SuspendedSubReconcilers) == 0 {
+		return nil
+	}
+
+	suspended := map[SubReconcilerName]None{}
+	for _, name := range cluster.Spec.AutomationOptions.SuspendedSubReconcilers {
+		subReconcilers, isClass := subReconcilerClasses[name]
+		if !isClass {
+			suspended[name] = None{}
+			continue
+		}
+
+		for _, subReconciler := range subReconcilers {
+			suspended[subReconciler] = None{}
+		}
+	}
+
+	result := make([]SubReconcilerName, 0, len(suspended))
+	for name := range suspended {
+		result = append(result, name)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// IsSubReconcilerSuspended returns true if the provided sub-reconciler is suspended, either directly or by its class.
+func (cluster *FoundationDBCluster) IsSubReconcilerSuspended(name SubReconcilerName) bool {
+	for _, suspended := range cluster.GetSuspendedSubReconcilers() {
+		if suspended == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDisruptiveSubReconciler returns true if the provided sub-reconciler performs disruptive operations and is
+// therefore only allowed to run inside the maintenance windows.
+func IsDisruptiveSubReconciler(name SubReconcilerName) bool {
+	_, ok := disruptiveSubReconcilers[name]
+	return ok
+}
+
+// HasMaintenanceWindows returns true if windows or deny windows are defined for the cluster.
+func (cluster *FoundationDBCluster) HasMaintenanceWindows() bool {
+	options := cluster.Spec.AutomationOptions.MaintenanceWindowOptions
+	return len(options.Windows) > 0 || len(options.DenyWindows) > 0
+}
+
+// GetMaintenanceWindowTimeZone returns the time zone that is used to evaluate the maintenance windows. Default is UTC.
+func (cluster *FoundationDBCluster) GetMaintenanceWindowTimeZone() string {
+	return pointer.StringDeref(cluster.Spec.AutomationOptions.MaintenanceWindowOptions.TimeZone, "UTC")
+}
+
+// AllowFailedReplacementsOutsideMaintenanceWindow returns true if the exclusion and removal of failed process groups
+// is allowed outside of the maintenance windows. Default is false.
+func (cluster *FoundationDBCluster) AllowFailedReplacementsOutsideMaintenanceWindow() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.MaintenanceWindowOptions.AllowFailedReplacementsOutsideWindow, false)
+}
+
+// maxMaintenanceWindowIterations defines how many windows will be checked to find the next time when disruptive
+// operations are allowed.
+const maxMaintenanceWindowIterations = 100
+
+// parsedMaintenanceWindow represents a MaintenanceWindow with a parsed schedule.
+type parsedMaintenanceWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// activeUntil returns the end of the window if the window is active at the provided time.
+func (window parsedMaintenanceWindow) activeUntil(now time.Time) (time.Time, bool) {
+	// The first start after now - duration is the earliest start of a window that could still be active.
+	start := window.schedule.Next(now.Add(-window.duration))
+	if start.After(now) {
+		return time.Time{}, false
+	}
+
+	return start.Add(window.duration), true
+}
+
+// parseMaintenanceWindows parses the schedules of the provided windows.
+func parseMaintenanceWindows(windows []MaintenanceWindow) ([]parsedMaintenanceWindow, error) {
+	parsed := make([]parsedMaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		schedule, err := cron.ParseStandard(window.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule \"%s\": %w", window.Schedule, err)
+		}
+
+		if window.DurationSeconds <= 0 {
+			return nil, fmt.Errorf("invalid duration %d for schedule \"%s\", duration must be positive", window.DurationSeconds, window.Schedule)
+		}
+
+		parsed = append(parsed, parsedMaintenanceWindow{
+			schedule: schedule,
+			duration: time.Duration(window.DurationSeconds) * time.Second,
+		})
+	}
+
+	return parsed, nil
+}
+
+// IsDisruptionAllowed returns true if disruptive operations are allowed at the provided time based on the maintenance
+// windows of the cluster. If disruptive operations are not allowed the time when they are allowed next will be
+// returned, if such a time could be found.
+func (cluster *FoundationDBCluster) IsDisruptionAllowed(now time.Time) (bool, *time.Time, error) {
+	if !cluster.HasMaintenanceWindows() {
+		return true, nil, nil
+	}
+
+	location, err := time.LoadLocation(cluster.GetMaintenanceWindowTimeZone())
+	if err != nil {
+		return false, nil, err
+	}
+
+	windows, err := parseMaintenanceWindows(cluster.Spec.AutomationOptions.MaintenanceWindowOptions.Windows)
+	if err != nil {
+		return false, nil, err
+	}
+
+	denyWindows, err := parseMaintenanceWindows(cluster.Spec.AutomationOptions.MaintenanceWindowOptions.DenyWindows)
+	if err != nil {
+		return false, nil, err
+	}
+
+	candidate := now.In(location)
+	found := false
+	for i := 0; i < maxMaintenanceWindowIterations; i++ {
+		// If a deny window is active, the next candidate is the end of this deny window.
+		denied := false
+		for _, window := range denyWindows {
+			end, active := window.activeUntil(candidate)
+			if active {
+				candidate = end
+				denied = true
+				break
+			}
+		}
+
+		if denied {
+			continue
+		}
+
+		if len(windows) == 0 {
+			found = true
+			break
+		}
+
+		// If no window is active, the next candidate is the earliest start of the next window.
+		var nextStart time.Time
+		allowed := false
+		for _, window := range windows {
+			if _, active := window.activeUntil(candidate); active {
+				allowed = true
+				break
+			}
+
+			start := window.schedule.Next(candidate)
+			if nextStart.IsZero() || start.Before(nextStart) {
+				nextStart = start
+			}
+		}
+
+		if allowed {
+			found = true
+			break
+		}
+
+		// The schedule has no next start, e.g. because the date is not valid.
+		if nextStart.IsZero() {
+			return false, nil, nil
+		}
+
+		candidate = nextStart
+	}
+
+	// No time could be found in which disruptive operations are allowed.
+	if !found {
+		return false, nil, nil
+	}
+
+	if candidate.Equal(now) {
+		return true, nil, nil
+	}
+
+	next := candidate.UTC()
+	return false, &next, nil
+}
+
+// UseCanaryRollouts returns true if changes should be rolled out to canary process groups first. Default is false.
+func (cluster *FoundationDBCluster) UseCanaryRollouts() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.CanaryOptions.Enabled, false)
+}
+
+// GetCanaryMode returns the mode to select the canary process groups. Default is ProcessGroups.
+func (cluster *FoundationDBCluster) GetCanaryMode() CanaryMode {
+	if cluster.Spec.AutomationOptions.CanaryOptions.Mode == "" {
+		return CanaryModeProcessGroups
+	}
+
+	return cluster.Spec.AutomationOptions.CanaryOptions.Mode
+}
+
+// GetCanaryProcessGroupCount returns the number of canary process groups per process class. Default is 1.
+func (cluster *FoundationDBCluster) GetCanaryProcessGroupCount() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.CanaryOptions.ProcessGroupCount, 1)
+}
+
+// GetCanarySoakTimeSeconds returns how long the canary process groups must be healthy before the rollout continues.
+// Default is 600.
+func (cluster *FoundationDBCluster) GetCanarySoakTimeSeconds() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.CanaryOptions.SoakTimeSeconds, 600)
+}
+
+// UseManualCanaryPromotion returns true if a canary rollout must be promoted manually. Default is false.
+func (cluster *FoundationDBCluster) UseManualCanaryPromotion() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.CanaryOptions.ManualPromotion, false)
+}
+
+// IsCanaryPromotionRequested returns true if the current canary rollout was promoted with the
+// foundationdb.org/promote-canary annotation.
+func (cluster *FoundationDBCluster) IsCanaryPromotionRequested() bool {
+	if cluster.Status.Canary == nil {
+		return false
+	}
+
+	return cluster.GetAnnotations()[CanaryPromotionAnnotation] == strconv.FormatInt(cluster.Status.Canary.Generation, 10)
+}
+
+// IsCanaryUpdateAllowed returns true if the provided process group is allowed to be updated based on the current
+// canary rollout. Process groups that are not part of the canary will only be updated once the canary is promoted.
+func (cluster *FoundationDBCluster) IsCanaryUpdateAllowed(processGroupID ProcessGroupID) bool {
+	canary := cluster.Status.Canary
+	if !cluster.UseCanaryRollouts() || canary == nil || canary.Phase == CanaryPhasePromoted {
+		return true
+	}
+
+	for _, canaryProcessGroupID := range canary.ProcessGroups {
+		if canaryProcessGroupID == processGroupID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UseAutomaticUpgradeRollback returns true if stalled protocol compatible upgrades should be rolled back. Default is
+// false.
+func (cluster *FoundationDBCluster) UseAutomaticUpgradeRollback() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.UpgradeRollbackOptions.Enabled, false)
+}
+
+// GetUpgradeStallTimeout returns the duration after which a protocol compatible upgrade is considered stalled.
+// Default is 3600 seconds.
+func (cluster *FoundationDBCluster) GetUpgradeStallTimeout() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.UpgradeRollbackOptions.StallTimeoutSeconds, 3600)) * time.Second
+}
+
+// IsUpgradeRollbackAcknowledged returns true if the last upgrade rollback was acknowledged with the
+// foundationdb.org/acknowledge-upgrade-rollback annotation.
+func (cluster *FoundationDBCluster) IsUpgradeRollbackAcknowledged() bool {
+	if cluster.Status.UpgradeRollback == nil {
+		return true
+	}
+
+	return cluster.GetAnnotations()[AcknowledgeUpgradeRollbackAnnotation] == cluster.Status.UpgradeRollback.FailedVersion
+}
+
+// IsVersionLockedOut returns true if the provided version was rolled back and the rollback was not yet acknowledged.
+func (cluster *FoundationDBCluster) IsVersionLockedOut(version string) bool {
+	if cluster.Status.UpgradeRollback == nil {
+		return false
+	}
+
+	return cluster.Status.UpgradeRollback.FailedVersion == version && !cluster.IsUpgradeRollbackAcknowledged()
+}
+
+// GetDesiredVersion returns the version that the processes of the cluster should run. If the version defined in the
+// cluster spec is locked out after an upgrade rollback, the previous version of the rollback will be returned. The
+// version in the cluster spec is not modified by the rollback.
+func (cluster *FoundationDBCluster) GetDesiredVersion() string {
+	if cluster.IsVersionLockedOut(cluster.Spec.Version) {
+		return cluster.Status.UpgradeRollback.PreviousVersion
+	}
+
+	return cluster.Spec.Version
+}
+
+// UseAutomaticRegionFailover returns true if the operator should fail over to another region if the primary data
+// center is unhealthy. Default is false.
+func (cluster *FoundationDBCluster) UseAutomaticRegionFailover() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.RegionFailoverOptions.Enabled, false)
+}
+
+// UseAutomaticRegionFailback returns true if the operator should fail back to the original primary data center once
+// it is healthy again. Default is false.
+func (cluster *FoundationDBCluster) UseAutomaticRegionFailback() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.RegionFailoverOptions.Failback, false)
+}
+
+// GetRegionFailoverDelay returns the duration the primary data center must be unhealthy before the operator fails
+// over. Default is 300 seconds.
+func (cluster *FoundationDBCluster) GetRegionFailoverDelay() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.RegionFailoverOptions.FailoverDelaySeconds, 300)) * time.Second
+}
+
+// GetMaximumDataCenterLag returns the maximum lag of the remote data center to allow a failover or a failback.
+// Default is 10 seconds.
+func (cluster *FoundationDBCluster) GetMaximumDataCenterLag() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.RegionFailoverOptions.MaximumDataCenterLagSeconds, 10)) * time.Second
+}
+
+// GetActivePrimaryDataCenter returns the data center the operator failed over to. If the operator didn't fail over an
+// empty string will be returned.
+func (cluster *FoundationDBCluster) GetActivePrimaryDataCenter() string {
+	if cluster.Status.RegionFailover == nil {
+		return ""
+	}
+
+	return cluster.Status.RegionFailover.ActivePrimary
+}
+
+// UseStorageEngineMigration returns true if the operator should replace storage process groups that use a different
+// storage engine than the configured storage engine.
+func (cluster *FoundationDBCluster) UseStorageEngineMigration() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.StorageEngineMigrationOptions.Enabled, false)
+}
+
+// GetStorageEngineMigrationMaximumInFlightBytes returns the maximum number of bytes that can be actively moved by data
+// distribution before the next batch of the storage engine migration is started.
+func (cluster *FoundationDBCluster) GetStorageEngineMigrationMaximumInFlightBytes() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StorageEngineMigrationOptions.MaximumInFlightBytes, 0)
+}
+
+// GetStorageEngineMigrationMaximumInQueueBytes returns the maximum number of bytes that can be pending for data
+// movement before the next batch of the storage engine migration is started.
+func (cluster *FoundationDBCluster) GetStorageEngineMigrationMaximumInQueueBytes() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StorageEngineMigrationOptions.MaximumInQueueBytes, 0)
+}
+
+// UseStorageAutoscaling returns true if the operator should scale the number of storage process groups based on the
+// disk utilization. Default is false.
+func (cluster *FoundationDBCluster) UseStorageAutoscaling() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.StorageAutoscalingOptions.Enabled, false)
+}
+
+// GetStorageAutoscalingLimits returns the minimum and maximum number of storage process groups for the storage
+// autoscaling. The storage process count of the cluster spec is used as the default minimum.
+func (cluster *FoundationDBCluster) GetStorageAutoscalingLimits() (int, int) {
+	storageProcessCount := cluster.Spec.ProcessCounts.Storage
+	if storageProcessCount == 0 {
+		storageProcessCount = cluster.calculateProcessCount(false, cluster.GetRoleCountsWithDefaults().Storage)
+	}
+
+	return cluster.getStorageAutoscalingLimits(storageProcessCount)
+}
+
+// getStorageAutoscalingLimits returns the minimum and maximum number of storage process groups for the storage
+// autoscaling. The provided storage process count is used as the default minimum.
+func (cluster *FoundationDBCluster) getStorageAutoscalingLimits(storageProcessCount int) (int, int) {
+	options := cluster.Spec.AutomationOptions.StorageAutoscalingOptions
+	minimum := pointer.IntDeref(options.MinimumStorageProcessGroups, storageProcessCount)
+	maximum := pointer.IntDeref(options.MaximumStorageProcessGroups, minimum)
+	if maximum < minimum {
+		maximum = minimum
+	}
+
+	return minimum, maximum
+}
+
+// getDesiredStorageProcessGroups returns the number of storage process groups chosen by the storage autoscaling within
+// the limits of the storage autoscaling. If the autoscaling hasn't made a decision yet the minimum will be returned.
+func (cluster *FoundationDBCluster) getDesiredStorageProcessGroups(storageProcessCount int) int {
+	minimum, maximum := cluster.getStorageAutoscalingLimits(storageProcessCount)
+	if cluster.Status.StorageAutoscaling == nil {
+		return minimum
+	}
+
+	desired := cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups
+	if desired < minimum {
+		return minimum
+	}
+
+	if desired > maximum {
+		return maximum
+	}
+
+	return desired
+}
+
+// GetStorageAutoscalingScaleUpUtilization returns the disk utilization in percent above which the operator adds
+// storage process groups. Default is 80.
+func (cluster *FoundationDBCluster) GetStorageAutoscalingScaleUpUtilization() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StorageAutoscalingOptions.ScaleUpUtilization, 80)
+}
+
+// GetStorageAutoscalingScaleDownUtilization returns the disk utilization in percent below which the operator removes
+// storage process groups. Default is 40.
+func (cluster *FoundationDBCluster) GetStorageAutoscalingScaleDownUtilization() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StorageAutoscalingOptions.ScaleDownUtilization, 40)
+}
+
+// GetStorageAutoscalingScaleUpDelay returns how long the disk utilization must stay above the scale up utilization
+// before the operator adds storage process groups. Default is 600 seconds.
+func (cluster *FoundationDBCluster) GetStorageAutoscalingScaleUpDelay() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.StorageAutoscalingOptions.ScaleUpDelaySeconds, 600)) * time.Second
+}
+
+// GetStorageAutoscalingScaleDownDelay returns how long the disk utilization must stay below the scale down
+// utilization before the operator removes a storage process group. Default is 3600 seconds.
+func (cluster *FoundationDBCluster) GetStorageAutoscalingScaleDownDelay() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.StorageAutoscalingOptions.ScaleDownDelaySeconds, 3600)) * time.Second
+}
+
+// GetStorageAutoscalingCooldown returns the minimum time between two scaling decisions of the storage autoscaling.
+// Default is 1800 seconds.
+func (cluster *FoundationDBCluster) GetStorageAutoscalingCooldown() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.StorageAutoscalingOptions.CooldownSeconds, 1800)) * time.Second
+}
+
+// UseStatelessAutoscaling returns true if the operator should scale the number of commit proxies, grv proxies and
+// resolvers based on the workload of the database. Default is false.
+func (cluster *FoundationDBCluster) UseStatelessAutoscaling() bool {
+	return pointer.BoolDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.Enabled, false)
+}
+
+// GetStatelessAutoscalingLimits returns the minimum and maximum role counts for the commit proxies, grv proxies and
+// resolvers of the stateless autoscaling. The role counts of the database configuration are used as the default
+// minimum. All other role counts are left unset.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingLimits() (RoleCounts, RoleCounts) {
+	return cluster.getStatelessAutoscalingLimits(cluster.getConfiguredRoleCounts())
+}
+
+// getStatelessAutoscalingLimits returns the minimum and maximum role counts of the stateless autoscaling. The provided
+// role counts are used as the default minimum.
+func (cluster *FoundationDBCluster) getStatelessAutoscalingLimits(roleCounts RoleCounts) (RoleCounts, RoleCounts) {
+	options := cluster.Spec.AutomationOptions.StatelessAutoscalingOptions
+	var minimum, maximum RoleCounts
+	minimum.CommitProxies, maximum.CommitProxies = options.CommitProxies.getLimits(roleCounts.CommitProxies)
+	minimum.GrvProxies, maximum.GrvProxies = options.GrvProxies.getLimits(roleCounts.GrvProxies)
+	minimum.Resolvers, maximum.Resolvers = options.Resolvers.getLimits(roleCounts.Resolvers)
+
+	return minimum, maximum
+}
+
+// getLimits returns the minimum and maximum role count. The provided role count is used as the default minimum.
+func (limits RoleCountLimits) getLimits(roleCount int) (int, int) {
+	minimum := pointer.IntDeref(limits.Minimum, roleCount)
+	maximum := pointer.IntDeref(limits.Maximum, minimum)
+	if maximum < minimum {
+		maximum = minimum
+	}
+
+	return minimum, maximum
+}
+
+// getConfiguredRoleCounts returns the role counts of the database configuration with the default values filled in,
+// without the role counts chosen by the stateless autoscaling.
+func (cluster *FoundationDBCluster) getConfiguredRoleCounts() RoleCounts {
+	// We can ignore the error here since the version will be validated in an earlier step.
+	version, _ := ParseFdbVersion(cluster.GetRunningVersion())
+	return cluster.Spec.DatabaseConfiguration.GetRoleCountsWithDefaults(version, cluster.DesiredFaultTolerance())
+}
+
+// applyStatelessAutoscaling replaces the commit proxies, grv proxies and resolvers in the provided role counts with the
+// role counts chosen by the stateless autoscaling within the limits of the stateless autoscaling. If the autoscaling
+// hasn't made a decision yet the minimum will be used.
+func (cluster *FoundationDBCluster) applyStatelessAutoscaling(roleCounts *RoleCounts) {
+	minimum, maximum := cluster.getStatelessAutoscalingLimits(*roleCounts)
+	desired := minimum
+	if cluster.Status.StatelessAutoscaling != nil {
+		desired.CommitProxies = clampRoleCount(cluster.Status.StatelessAutoscaling.CommitProxies, minimum.CommitProxies, maximum.CommitProxies)
+		desired.GrvProxies = clampRoleCount(cluster.Status.StatelessAutoscaling.GrvProxies, minimum.GrvProxies, maximum.GrvProxies)
+		desired.Resolvers = clampRoleCount(cluster.Status.StatelessAutoscaling.Resolvers, minimum.Resolvers, maximum.Resolvers)
+	}
+
+	// The commit proxies and grv proxies are only managed if they are configured.
+	if roleCounts.CommitProxies > 0 && roleCounts.GrvProxies > 0 {
+		roleCounts.CommitProxies = desired.CommitProxies
+		roleCounts.GrvProxies = desired.GrvProxies
+	}
+
+	roleCounts.Resolvers = desired.Resolvers
+}
+
+// clampRoleCount returns the provided role count within the provided bounds.
+func clampRoleCount(roleCount int, minimum int, maximum int) int {
+	if roleCount < minimum {
+		return minimum
+	}
+
+	if roleCount > maximum {
+		return maximum
+	}
+
+	return roleCount
+}
+
+// getStatelessAutoscalingProcessDelta returns the number of stateless processes that must be added to a stateless
+// process count defined in the spec to host the additional roles of the stateless autoscaling. The result is negative if
+// the stateless autoscaling chose fewer roles than configured.
+func (cluster *FoundationDBCluster) getStatelessAutoscalingProcessDelta(roleCounts RoleCounts) int {
+	configured := cluster.getConfiguredRoleCounts()
+	delta := roleCounts.CommitProxies + roleCounts.GrvProxies + roleCounts.Resolvers - configured.CommitProxies - configured.GrvProxies - configured.Resolvers
+	if delta > 0 {
+		return cluster.calculateProcessCountFromRole(cluster.calculateProcessCount(false, delta))
+	}
+
+	if delta < 0 {
+		return -cluster.calculateProcessCountFromRole(cluster.calculateProcessCount(false, -delta))
+	}
+
+	return 0
+}
+
+// GetStatelessAutoscalingCommittedTransactionsPerCommitProxy returns how many committed transactions per second a
+// single commit proxy should handle. Default is 5000.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingCommittedTransactionsPerCommitProxy() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.CommittedTransactionsPerCommitProxy, 5000)
+}
+
+// GetStatelessAutoscalingStartedTransactionsPerGrvProxy returns how many started transactions per second a single grv
+// proxy should handle. Default is 20000.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingStartedTransactionsPerGrvProxy() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.StartedTransactionsPerGrvProxy, 20000)
+}
+
+// GetStatelessAutoscalingResolvedTransactionsPerResolver returns how many resolved transactions per second a single
+// resolver should handle. Default is 10000.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingResolvedTransactionsPerResolver() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.ResolvedTransactionsPerResolver, 10000)
+}
+
+// GetStatelessAutoscalingMaximumProxyCPUUtilization returns the average CPU utilization in percent of a core above
+// which the operator adds proxies. Default is 70.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingMaximumProxyCPUUtilization() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.MaximumProxyCPUUtilization, 70)
+}
+
+// GetStatelessAutoscalingScaleDownHysteresis returns in percent how far the workload must be below the capacity of
+// the current role counts before the operator removes roles. Default is 20.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingScaleDownHysteresis() int {
+	return pointer.IntDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.ScaleDownHysteresis, 20)
+}
+
+// GetStatelessAutoscalingCooldown returns the minimum time between two changes of the role counts of the stateless
+// autoscaling. Default is 5 minutes.
+func (cluster *FoundationDBCluster) GetStatelessAutoscalingCooldown() time.Duration {
+	return time.Duration(pointer.IntDeref(cluster.Spec.AutomationOptions.StatelessAutoscalingOptions.CooldownSeconds, 300)) * time.Second
+}
+
 // GetIgnoreLogGroupsForUpgrade will return the IgnoreLogGroupsForUpgrade, if the value is not set it will include the default `fdb-kubernetes-operator`
 // LogGroup.
 func (cluster *FoundationDBCluster) GetIgnoreLogGroupsForUpgrade() []LogGroup {
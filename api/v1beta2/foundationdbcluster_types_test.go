@@ -438,6 +438,67 @@ var _ = Describe("[api] FoundationDBCluster", func() {
 			})
 		})
 
+		When("the storage autoscaling is enabled", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.StorageAutoscalingOptions = StorageAutoscalingOptions{
+					Enabled:                     pointer.Bool(true),
+					MaximumStorageProcessGroups: pointer.Int(8),
+				}
+			})
+
+			It("should use the minimum if the autoscaling made no decision yet", func() {
+				counts, err := cluster.GetProcessCountsWithDefaults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts.Storage).To(Equal(5))
+			})
+
+			It("should use the desired storage process groups within the limits", func() {
+				cluster.Status.StorageAutoscaling = &StorageAutoscalingStatus{DesiredStorageProcessGroups: 7}
+				counts, err := cluster.GetProcessCountsWithDefaults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts.Storage).To(Equal(7))
+
+				cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups = 20
+				counts, err = cluster.GetProcessCountsWithDefaults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts.Storage).To(Equal(8))
+			})
+		})
+
+		When("the stateless autoscaling is enabled", func() {
+			BeforeEach(func() {
+				cluster.Spec.Version = "7.1.0"
+				cluster.Spec.AutomationOptions.StatelessAutoscalingOptions = StatelessAutoscalingOptions{
+					Enabled:       pointer.Bool(true),
+					CommitProxies: RoleCountLimits{Maximum: pointer.Int(16)},
+					Resolvers:     RoleCountLimits{Maximum: pointer.Int(2)},
+				}
+				cluster.Status.StatelessAutoscaling = &StatelessAutoscalingStatus{
+					CommitProxies: 14,
+					GrvProxies:    10,
+					Resolvers:     2,
+				}
+			})
+
+			It("should use the role counts of the autoscaling within the limits", func() {
+				roleCounts := cluster.GetRoleCountsWithDefaults()
+				Expect(roleCounts.CommitProxies).To(Equal(14))
+				Expect(roleCounts.GrvProxies).To(Equal(4))
+				Expect(roleCounts.Resolvers).To(Equal(2))
+
+				counts, err := cluster.GetProcessCountsWithDefaults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts.Stateless).To(Equal(25))
+			})
+
+			It("should add the additional roles to a stateless process count defined in the spec", func() {
+				cluster.Spec.ProcessCounts.Stateless = 20
+				counts, err := cluster.GetProcessCountsWithDefaults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts.Stateless).To(Equal(23))
+			})
+		})
+
 		It("should return the default process counts when proxies are unset", func() {
 			cluster.Spec.Version = "7.1.0-rc2"
 			cluster.Spec.DatabaseConfiguration.Proxies = 0
@@ -1037,6 +1098,67 @@ var _ = Describe("[api] FoundationDBCluster", func() {
 				})
 			})
 		})
+
+		When("the operator failed over to another region", func() {
+			BeforeEach(func() {
+				cluster = &FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						DatabaseConfiguration: DatabaseConfiguration{
+							UsableRegions: 2,
+							Regions: []Region{
+								{
+									DataCenters: []DataCenter{
+										{ID: "primary", Priority: 1},
+										{ID: "primary-sat", Priority: 1, Satellite: 1},
+									},
+								},
+								{
+									DataCenters: []DataCenter{
+										{ID: "remote", Priority: 0},
+										{ID: "remote-sat", Priority: 1, Satellite: 1},
+									},
+								},
+							},
+						},
+						Version: "7.1.26",
+					},
+					Status: FoundationDBClusterStatus{
+						RegionFailover: &RegionFailoverStatus{
+							OriginalPrimary: "primary",
+							ActivePrimary:   "remote",
+						},
+					},
+				}
+			})
+
+			It("should use the active primary as primary data center", func() {
+				configuration := cluster.DesiredDatabaseConfiguration()
+				Expect(configuration.GetPrimaryDataCenter()).To(Equal("remote"))
+				Expect(configuration.Regions[0].DataCenters[0]).To(Equal(DataCenter{ID: "remote", Priority: 1}))
+				Expect(configuration.Regions[1].DataCenters[0]).To(Equal(DataCenter{ID: "primary", Priority: 0}))
+				Expect(cluster.Spec.DatabaseConfiguration.GetPrimaryDataCenter()).To(Equal("primary"))
+			})
+
+			It("should return the default region failover options", func() {
+				Expect(cluster.UseAutomaticRegionFailover()).To(BeFalse())
+				Expect(cluster.UseAutomaticRegionFailback()).To(BeFalse())
+				Expect(cluster.GetRegionFailoverDelay()).To(Equal(300 * time.Second))
+				Expect(cluster.GetMaximumDataCenterLag()).To(Equal(10 * time.Second))
+				Expect(cluster.GetActivePrimaryDataCenter()).To(Equal("remote"))
+			})
+
+			When("the primary data center is only tracked as unhealthy", func() {
+				BeforeEach(func() {
+					cluster.Status.RegionFailover = &RegionFailoverStatus{
+						PrimaryUnhealthySince: &metav1.Time{Time: time.Now()},
+					}
+				})
+
+				It("should use the primary data center from the spec", func() {
+					Expect(cluster.DesiredDatabaseConfiguration().GetPrimaryDataCenter()).To(Equal("primary"))
+				})
+			})
+		})
 	})
 
 	When("getting the configuration string", func() {
@@ -1073,6 +1195,19 @@ var _ = Describe("[api] FoundationDBCluster", func() {
 
 			Expect(configuration.GetConfigurationString("7.0.0")).To(Equal("double ssd usable_regions=1 logs=5 resolvers=0 log_routers=0 remote_logs=0 commit_proxies=4 grv_proxies=2 log_spill:=3 regions=[]"))
 			Expect(configuration.GetConfigurationString("7.1.0-rc1")).To(Equal("double ssd usable_regions=1 logs=5 resolvers=0 log_routers=0 remote_logs=0 commit_proxies=4 grv_proxies=2 log_spill:=3 regions=[]"))
+			configuration.VersionFlags.LogSpill = 0
+
+			configuration.PerpetualStorageWiggle = pointer.Int(1)
+			configuration.PerpetualStorageWiggleLocality = pointer.String("zoneid:zone-1")
+			migrationType := StorageMigrationTypeGradual
+			configuration.StorageMigrationType = &migrationType
+			wiggleEngine := StorageEngineRedwood1
+			configuration.PerpetualStorageWiggleEngine = &wiggleEngine
+			Expect(configuration.GetConfigurationString("7.3.0")).To(Equal("double ssd usable_regions=1 logs=5 resolvers=0 log_routers=0 remote_logs=0 commit_proxies=4 grv_proxies=2 perpetual_storage_wiggle=1 perpetual_storage_wiggle_locality=zoneid:zone-1 storage_migration_type=gradual perpetual_storage_wiggle_engine=ssd-redwood-1 regions=[]"))
+
+			tenantMode := TenantModeOptionalExperimental
+			configuration.TenantMode = &tenantMode
+			Expect(configuration.GetConfigurationString("7.3.0")).To(Equal("double ssd usable_regions=1 logs=5 resolvers=0 log_routers=0 remote_logs=0 commit_proxies=4 grv_proxies=2 perpetual_storage_wiggle=1 perpetual_storage_wiggle_locality=zoneid:zone-1 storage_migration_type=gradual perpetual_storage_wiggle_engine=ssd-redwood-1 tenant_mode=optional_experimental regions=[]"))
 		})
 
 		When("CommitProxies and GrvProxies are not configured", func() {
@@ -4765,6 +4900,11 @@ var _ = Describe("[api] FoundationDBCluster", func() {
 	})
 
 	When("validating a cluster", func() {
+		gradualMigration := StorageMigrationTypeGradual
+		redwoodEngine := StorageEngineRedwood1
+		rocksDBExperimentalEngine := StorageEngineRocksDbExperimental
+		optionalTenantMode := TenantModeOptionalExperimental
+
 		DescribeTable("it should return if the cluster is valid",
 			func(cluster *FoundationDBCluster, expected error) {
 				if expected == nil {
@@ -4908,6 +5048,196 @@ var _ = Describe("[api] FoundationDBCluster", func() {
 				},
 				nil,
 			),
+			Entry("using the perpetual storage wiggle with a supported version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.26",
+						DatabaseConfiguration: DatabaseConfiguration{
+							PerpetualStorageWiggle:         pointer.Int(1),
+							PerpetualStorageWiggleLocality: pointer.String("zoneid:zone-1"),
+							StorageMigrationType:           &gradualMigration,
+						},
+					},
+				},
+				nil,
+			),
+			Entry("using the perpetual storage wiggle with an unsupported version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "6.3.24",
+						DatabaseConfiguration: DatabaseConfiguration{
+							PerpetualStorageWiggle: pointer.Int(1),
+						},
+					},
+				},
+				fmt.Errorf("perpetual storage wiggle settings are not supported on version 6.3.24"),
+			),
+			Entry("using the perpetual storage wiggle engine with an unsupported version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.26",
+						DatabaseConfiguration: DatabaseConfiguration{
+							PerpetualStorageWiggleEngine: &redwoodEngine,
+						},
+					},
+				},
+				fmt.Errorf("perpetual storage wiggle engine is not supported on version 7.1.26"),
+			),
+			Entry("using an unsupported perpetual storage wiggle engine",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.3.0",
+						DatabaseConfiguration: DatabaseConfiguration{
+							PerpetualStorageWiggleEngine: &rocksDBExperimentalEngine,
+						},
+					},
+				},
+				fmt.Errorf("perpetual storage wiggle engine ssd-rocksdb-experimental is not supported on version 7.3.0"),
+			),
+			Entry("using the tenant mode with an unsupported version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.0.0",
+						DatabaseConfiguration: DatabaseConfiguration{
+							TenantMode: &optionalTenantMode,
+						},
+					},
+				},
+				fmt.Errorf("tenant mode is not supported on version 7.0.0"),
+			),
+			Entry("using the tenant mode with a supported version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.0",
+						DatabaseConfiguration: DatabaseConfiguration{
+							TenantMode: &optionalTenantMode,
+						},
+					},
+				},
+				nil,
+			),
+			Entry("using a knob that is not supported by the version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.0.0",
+						Processes: map[ProcessClass]ProcessSettings{
+							ProcessClassStorage: {
+								Knobs: []FoundationDBKnob{{Name: "rocksdb_block_cache_size", Value: "1000"}},
+							},
+						},
+					},
+				},
+				fmt.Errorf("process class storage: found the following knob violations:\nknob rocksdb_block_cache_size is not supported on version 7.0.0"),
+			),
+			Entry("using a storage autoscaling maximum that is smaller than the minimum",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						AutomationOptions: FoundationDBClusterAutomationOptions{
+							StorageAutoscalingOptions: StorageAutoscalingOptions{
+								MinimumStorageProcessGroups: pointer.Int(10),
+								MaximumStorageProcessGroups: pointer.Int(5),
+							},
+						},
+					},
+				},
+				fmt.Errorf("storage autoscaling maximum 5 must not be smaller than the minimum 10"),
+			),
+			Entry("using a storage autoscaling scale down utilization above the scale up utilization",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						AutomationOptions: FoundationDBClusterAutomationOptions{
+							StorageAutoscalingOptions: StorageAutoscalingOptions{
+								ScaleDownUtilization: pointer.Int(90),
+							},
+						},
+					},
+				},
+				fmt.Errorf("storage autoscaling scale down utilization 90 must be smaller than the scale up utilization 80"),
+			),
+			Entry("using the stateless autoscaling without separated proxies",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.0",
+						AutomationOptions: FoundationDBClusterAutomationOptions{
+							StatelessAutoscalingOptions: StatelessAutoscalingOptions{
+								Enabled: pointer.Bool(true),
+							},
+						},
+					},
+				},
+				fmt.Errorf("stateless autoscaling requires commit proxies and grv proxies to be configured"),
+			),
+			Entry("using a stateless autoscaling maximum that is smaller than the minimum",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.0",
+						AutomationOptions: FoundationDBClusterAutomationOptions{
+							StatelessAutoscalingOptions: StatelessAutoscalingOptions{
+								Enabled:   pointer.Bool(true),
+								Resolvers: RoleCountLimits{Minimum: pointer.Int(3), Maximum: pointer.Int(2)},
+							},
+						},
+						DatabaseConfiguration: DatabaseConfiguration{
+							RoleCounts: RoleCounts{
+								CommitProxies: 2,
+								GrvProxies:    1,
+							},
+						},
+					},
+				},
+				fmt.Errorf("stateless autoscaling maximum 2 for resolvers must not be smaller than the minimum 3"),
+			),
+			Entry("using a supported perpetual storage wiggle engine",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.3.0",
+						DatabaseConfiguration: DatabaseConfiguration{
+							PerpetualStorageWiggleEngine: &redwoodEngine,
+						},
+					},
+				},
+				nil,
+			),
+			Entry("using valid maintenance windows",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.26",
+						AutomationOptions: FoundationDBClusterAutomationOptions{
+							MaintenanceWindowOptions: MaintenanceWindowOptions{
+								Windows: []MaintenanceWindow{
+									{
+										Schedule:        "0 2 * * 1-5",
+										DurationSeconds: 7200,
+									},
+								},
+								TimeZone: pointer.String("Europe/Berlin"),
+							},
+						},
+					},
+				},
+				nil,
+			),
+			Entry("using invalid maintenance windows",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.26",
+						AutomationOptions: FoundationDBClusterAutomationOptions{
+							MaintenanceWindowOptions: MaintenanceWindowOptions{
+								DenyWindows: []MaintenanceWindow{
+									{
+										Schedule:        "0 2 * * 1-5",
+										DurationSeconds: 0,
+									},
+								},
+								TimeZone: pointer.String("Mars/Olympus"),
+							},
+						},
+					},
+				},
+				fmt.Errorf("time zone Mars/Olympus for maintenance windows is not valid: unknown time zone Mars/Olympus, invalid duration 0 for schedule \"0 2 * * 1-5\", duration must be positive"),
+			),
 			Entry("using an unsupported FDB version",
 				&FoundationDBCluster{
 					Spec: FoundationDBClusterSpec{
@@ -5620,6 +5950,154 @@ var _ = Describe("[api] FoundationDBCluster", func() {
 			}, "testing"),
 	)
 
+	DescribeTable("when getting the suspended sub-reconcilers", func(suspended []SubReconcilerName, expected []SubReconcilerName) {
+		cluster := &FoundationDBCluster{
+			Spec: FoundationDBClusterSpec{
+				AutomationOptions: FoundationDBClusterAutomationOptions{
+					SuspendedSubReconcilers: suspended,
+				},
+			},
+		}
+
+		Expect(cluster.GetSuspendedSubReconcilers()).To(Equal(expected))
+		for _, subReconciler := range expected {
+			Expect(cluster.IsSubReconcilerSuspended(subReconciler)).To(BeTrue())
+		}
+		Expect(cluster.IsSubReconcilerSuspended(SubReconcilerAddPods)).To(BeFalse())
+	},
+		Entry("no sub-reconcilers are suspended", nil, nil),
+		Entry("a single sub-reconciler is suspended",
+			[]SubReconcilerName{SubReconcilerChangeCoordinators},
+			[]SubReconcilerName{SubReconcilerChangeCoordinators}),
+		Entry("multiple sub-reconcilers are suspended",
+			[]SubReconcilerName{SubReconcilerUpdatePods, SubReconcilerChangeCoordinators},
+			[]SubReconcilerName{SubReconcilerChangeCoordinators, SubReconcilerUpdatePods}),
+		Entry("a class of sub-reconcilers is suspended",
+			[]SubReconcilerName{SubReconcilerClassRemovals},
+			[]SubReconcilerName{SubReconcilerChooseRemovals, SubReconcilerExcludeProcesses, SubReconcilerRemoveProcessGroups, SubReconcilerRemoveServices}),
+		Entry("a class and a sub-reconciler of the class are suspended",
+			[]SubReconcilerName{SubReconcilerClassPodUpdates, SubReconcilerUpdatePods},
+			[]SubReconcilerName{SubReconcilerUpdatePodConfig, SubReconcilerUpdatePods, SubReconcilerUpdateSidecarVersions}),
+	)
+
+	DescribeTable("when checking if disruptive operations are allowed", func(options MaintenanceWindowOptions, now time.Time, expectedAllowed bool, expectedNext time.Time) {
+		cluster := &FoundationDBCluster{
+			Spec: FoundationDBClusterSpec{
+				AutomationOptions: FoundationDBClusterAutomationOptions{
+					MaintenanceWindowOptions: options,
+				},
+			},
+		}
+
+		allowed, next, err := cluster.IsDisruptionAllowed(now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(Equal(expectedAllowed))
+		if expectedNext.IsZero() {
+			Expect(next).To(BeNil())
+			return
+		}
+		Expect(next).NotTo(BeNil())
+		Expect(next.Equal(expectedNext)).To(BeTrue(), "expected %s to equal %s", next, expectedNext)
+	},
+		Entry("no windows are defined",
+			MaintenanceWindowOptions{},
+			time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC),
+			true,
+			time.Time{}),
+		Entry("inside a window",
+			MaintenanceWindowOptions{
+				Windows: []MaintenanceWindow{{Schedule: "0 2 * * *", DurationSeconds: 7200}},
+			},
+			time.Date(2023, 10, 2, 3, 0, 0, 0, time.UTC),
+			true,
+			time.Time{}),
+		Entry("outside a window",
+			MaintenanceWindowOptions{
+				Windows: []MaintenanceWindow{{Schedule: "0 2 * * *", DurationSeconds: 7200}},
+			},
+			time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC),
+			false,
+			time.Date(2023, 10, 3, 2, 0, 0, 0, time.UTC)),
+		Entry("outside a window with a time zone",
+			MaintenanceWindowOptions{
+				Windows:  []MaintenanceWindow{{Schedule: "0 2 * * *", DurationSeconds: 7200}},
+				TimeZone: pointer.String("Europe/Berlin"),
+			},
+			time.Date(2023, 10, 2, 4, 0, 0, 0, time.UTC),
+			false,
+			time.Date(2023, 10, 3, 0, 0, 0, 0, time.UTC)),
+		Entry("inside a window and a deny window",
+			MaintenanceWindowOptions{
+				Windows:     []MaintenanceWindow{{Schedule: "0 2 * * *", DurationSeconds: 7200}},
+				DenyWindows: []MaintenanceWindow{{Schedule: "0 0 2 10 *", DurationSeconds: 86400}},
+			},
+			time.Date(2023, 10, 2, 3, 0, 0, 0, time.UTC),
+			false,
+			time.Date(2023, 10, 3, 2, 0, 0, 0, time.UTC)),
+		Entry("inside a deny window without windows",
+			MaintenanceWindowOptions{
+				DenyWindows: []MaintenanceWindow{{Schedule: "0 0 2 10 *", DurationSeconds: 86400}},
+			},
+			time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC),
+			false,
+			time.Date(2023, 10, 3, 0, 0, 0, 0, time.UTC)),
+		Entry("outside of a deny window without windows",
+			MaintenanceWindowOptions{
+				DenyWindows: []MaintenanceWindow{{Schedule: "0 0 2 10 *", DurationSeconds: 86400}},
+			},
+			time.Date(2023, 10, 3, 12, 0, 0, 0, time.UTC),
+			true,
+			time.Time{}),
+		Entry("a deny window that never ends",
+			MaintenanceWindowOptions{
+				DenyWindows: []MaintenanceWindow{{Schedule: "* * * * *", DurationSeconds: 3600}},
+			},
+			time.Date(2023, 10, 3, 12, 0, 0, 0, time.UTC),
+			false,
+			time.Time{}),
+	)
+
+	DescribeTable("when checking if a process group is allowed to be updated during a canary rollout", func(options CanaryOptions, canary *CanaryStatus, processGroupID ProcessGroupID, expected bool) {
+		cluster := &FoundationDBCluster{
+			Spec: FoundationDBClusterSpec{
+				AutomationOptions: FoundationDBClusterAutomationOptions{
+					CanaryOptions: options,
+				},
+			},
+			Status: FoundationDBClusterStatus{
+				Canary: canary,
+			},
+		}
+
+		Expect(cluster.IsCanaryUpdateAllowed(processGroupID)).To(Equal(expected))
+	},
+		Entry("canary rollouts are disabled",
+			CanaryOptions{},
+			&CanaryStatus{Phase: CanaryPhaseUpdating, ProcessGroups: []ProcessGroupID{"storage-1"}},
+			ProcessGroupID("storage-2"),
+			true),
+		Entry("no canary rollout is in progress",
+			CanaryOptions{Enabled: pointer.Bool(true)},
+			nil,
+			ProcessGroupID("storage-2"),
+			true),
+		Entry("the process group is a canary",
+			CanaryOptions{Enabled: pointer.Bool(true)},
+			&CanaryStatus{Phase: CanaryPhaseSoaking, ProcessGroups: []ProcessGroupID{"storage-1"}},
+			ProcessGroupID("storage-1"),
+			true),
+		Entry("the process group is not a canary",
+			CanaryOptions{Enabled: pointer.Bool(true)},
+			&CanaryStatus{Phase: CanaryPhaseSoaking, ProcessGroups: []ProcessGroupID{"storage-1"}},
+			ProcessGroupID("storage-2"),
+			false),
+		Entry("the canary rollout is promoted",
+			CanaryOptions{Enabled: pointer.Bool(true)},
+			&CanaryStatus{Phase: CanaryPhasePromoted, ProcessGroups: []ProcessGroupID{"storage-1"}},
+			ProcessGroupID("storage-2"),
+			true),
+	)
+
 	When("creating a new ProcessGroup", func() {
 		var processGroupID ProcessGroupID
 		var processClass ProcessClass
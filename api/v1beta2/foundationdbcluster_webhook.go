@@ -0,0 +1,153 @@
+/*
+ * foundationdbcluster_webhook.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-apps-foundationdb-org-v1beta2-foundationdbcluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=apps.foundationdb.org,resources=foundationdbclusters,verbs=create;update,versions=v1beta2,name=vfoundationdbcluster.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FoundationDBCluster{}
+
+// SetupWebhookWithManager registers the validating webhook for the FoundationDBCluster resource.
+func (cluster *FoundationDBCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(cluster).
+		Complete()
+}
+
+// ValidateCreate validates a newly created FoundationDBCluster.
+func (cluster *FoundationDBCluster) ValidateCreate() error {
+	return cluster.validateSpec()
+}
+
+// ValidateUpdate validates the update of a FoundationDBCluster. In addition to the validation of the new spec, this
+// method rejects transitions that the operator is not able to perform safely.
+func (cluster *FoundationDBCluster) ValidateUpdate(old runtime.Object) error {
+	oldCluster, ok := old.(*FoundationDBCluster)
+	if !ok {
+		return fmt.Errorf("expected a FoundationDBCluster but got %T", old)
+	}
+
+	err := cluster.validateSpec()
+	if err != nil {
+		return err
+	}
+
+	return cluster.ValidateTransition(oldCluster)
+}
+
+// ValidateDelete validates the deletion of a FoundationDBCluster. Deletions are always allowed.
+func (cluster *FoundationDBCluster) ValidateDelete() error {
+	return nil
+}
+
+// validateSpec runs all the validations that are independent of the previous state of the cluster.
+func (cluster *FoundationDBCluster) validateSpec() error {
+	err := cluster.Validate()
+	if err != nil {
+		return err
+	}
+
+	var validations []string
+	for processClass, settings := range cluster.Spec.Processes {
+		err = settings.CustomParameters.ValidateCustomParameters()
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("process class %s: %s", processClass, err.Error()))
+		}
+	}
+
+	if len(validations) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(validations, ", "))
+}
+
+// ValidateTransition checks if the transition from the provided old cluster to the current cluster is safe. This
+// rejects downgrades across protocol versions and changes of the redundancy mode that the desired process counts
+// cannot satisfy.
+func (cluster *FoundationDBCluster) ValidateTransition(oldCluster *FoundationDBCluster) error {
+	var validations []string
+
+	runningVersion, err := ParseFdbVersion(oldCluster.GetRunningVersion())
+	if err != nil {
+		return err
+	}
+
+	desiredVersion, err := ParseFdbVersion(cluster.Spec.Version)
+	if err != nil {
+		return err
+	}
+
+	if !runningVersion.SupportsVersionChange(desiredVersion) {
+		validations = append(validations, fmt.Sprintf("version change from %s to %s is not supported, downgrades are only supported between protocol compatible versions", runningVersion, desiredVersion))
+	}
+
+	// The status of the new object is not updated by the client, so we have to check the rollback in the old status.
+	rollback := oldCluster.Status.UpgradeRollback
+	if oldCluster.Spec.Version != cluster.Spec.Version && rollback != nil && rollback.FailedVersion == cluster.Spec.Version && cluster.GetAnnotations()[AcknowledgeUpgradeRollbackAnnotation] != rollback.FailedVersion {
+		validations = append(validations, fmt.Sprintf("version %s was rolled back and is locked out until the %s annotation is set to %s", rollback.FailedVersion, AcknowledgeUpgradeRollbackAnnotation, rollback.FailedVersion))
+	}
+
+	if oldCluster.Spec.DatabaseConfiguration.RedundancyMode != cluster.Spec.DatabaseConfiguration.RedundancyMode {
+		validations = append(validations, cluster.validateRedundancyModeProcessCounts()...)
+	}
+
+	if len(validations) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(validations, ", "))
+}
+
+// validateRedundancyModeProcessCounts checks if the desired process counts provide enough log and storage processes
+// for the minimum number of fault domains required by the redundancy mode.
+func (cluster *FoundationDBCluster) validateRedundancyModeProcessCounts() []string {
+	processCounts, err := cluster.GetProcessCountsWithDefaults()
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var validations []string
+	minimumFaultDomains := cluster.MinimumFaultDomains()
+	counts := processCounts.Map()
+	for _, processClass := range []ProcessClass{ProcessClassStorage, ProcessClassLog} {
+		count := counts[processClass]
+		// A count of zero or less means that this cluster doesn't run processes of this class, e.g. for satellites.
+		if count <= 0 {
+			continue
+		}
+
+		if count < minimumFaultDomains {
+			validations = append(validations, fmt.Sprintf("redundancy mode %s requires at least %d %s processes but only %d are desired", cluster.Spec.DatabaseConfiguration.RedundancyMode, minimumFaultDomains, processClass, count))
+		}
+	}
+
+	return validations
+}
@@ -0,0 +1,233 @@
+/*
+ * foundationdbcluster_webhook_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[api] FoundationDBCluster webhook", func() {
+	When("validating the creation of a cluster", func() {
+		DescribeTable("it should return if the cluster is valid",
+			func(cluster *FoundationDBCluster, expectedErr string) {
+				err := cluster.ValidateCreate()
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("valid cluster spec",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+					},
+				},
+				"",
+			),
+			Entry("unsupported storage engine",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "6.3.24",
+						DatabaseConfiguration: DatabaseConfiguration{
+							StorageEngine: StorageEngineRocksDbV1,
+						},
+					},
+				},
+				"storage engine ssd-rocksdb-v1 is not supported on version 6.3.24",
+			),
+			Entry("protected custom parameter",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						Processes: map[ProcessClass]ProcessSettings{
+							ProcessClassGeneral: {
+								CustomParameters: FoundationDBCustomParameters{
+									"datadir=/tmp",
+								},
+							},
+						},
+					},
+				},
+				"process class general: found the following customParameters violations",
+			),
+		)
+	})
+
+	When("validating the update of a cluster", func() {
+		DescribeTable("it should return if the transition is valid",
+			func(oldCluster *FoundationDBCluster, cluster *FoundationDBCluster, expectedErr string) {
+				err := cluster.ValidateUpdate(oldCluster)
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("upgrade to a new minor version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.25",
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.3.0",
+					},
+				},
+				"",
+			),
+			Entry("downgrade to a protocol compatible version",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.25",
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.21",
+					},
+				},
+				"",
+			),
+			Entry("downgrade across protocol versions",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.25",
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "6.3.24",
+					},
+				},
+				"version change from 7.1.25 to 6.3.24 is not supported",
+			),
+			Entry("downgrade across protocol versions during an ongoing upgrade",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.25",
+					},
+					Status: FoundationDBClusterStatus{
+						RunningVersion: "7.1.25",
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "6.3.24",
+					},
+				},
+				"version change from 7.1.25 to 6.3.24 is not supported",
+			),
+			Entry("upgrade to a version that was rolled back",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.21",
+					},
+					Status: FoundationDBClusterStatus{
+						UpgradeRollback: &UpgradeRollbackStatus{
+							FailedVersion:   "7.1.25",
+							PreviousVersion: "7.1.21",
+						},
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.25",
+					},
+				},
+				"version 7.1.25 was rolled back and is locked out until the foundationdb.org/acknowledge-upgrade-rollback annotation is set to 7.1.25",
+			),
+			Entry("upgrade to a version that was rolled back with an acknowledged rollback",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.21",
+					},
+					Status: FoundationDBClusterStatus{
+						UpgradeRollback: &UpgradeRollbackStatus{
+							FailedVersion:   "7.1.25",
+							PreviousVersion: "7.1.21",
+						},
+					},
+				},
+				&FoundationDBCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							AcknowledgeUpgradeRollbackAnnotation: "7.1.25",
+						},
+					},
+					Spec: FoundationDBClusterSpec{
+						Version: "7.1.25",
+					},
+				},
+				"",
+			),
+			Entry("redundancy mode change with default process counts",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						DatabaseConfiguration: DatabaseConfiguration{
+							RedundancyMode: RedundancyModeDouble,
+						},
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						DatabaseConfiguration: DatabaseConfiguration{
+							RedundancyMode: RedundancyModeTriple,
+						},
+					},
+				},
+				"",
+			),
+			Entry("redundancy mode change with too few storage processes",
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						DatabaseConfiguration: DatabaseConfiguration{
+							RedundancyMode: RedundancyModeDouble,
+						},
+						ProcessCounts: ProcessCounts{
+							Storage: 2,
+						},
+					},
+				},
+				&FoundationDBCluster{
+					Spec: FoundationDBClusterSpec{
+						Version: Versions.Default.String(),
+						DatabaseConfiguration: DatabaseConfiguration{
+							RedundancyMode: RedundancyModeTriple,
+						},
+						ProcessCounts: ProcessCounts{
+							Storage: 2,
+						},
+					},
+				},
+				"redundancy mode triple requires at least 3 storage processes but only 2 are desired",
+			),
+		)
+	})
+})
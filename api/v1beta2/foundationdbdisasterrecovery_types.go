@@ -0,0 +1,403 @@
+/*
+Copyright 2023 FoundationDB project authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=fdbdr
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Generation",type="integer",JSONPath=".metadata.generation",description="Latest generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Reconciled",type="integer",JSONPath=".status.generations.reconciled",description="Last reconciled generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.drDetails.state",description="State of the replication",priority=0
+// +kubebuilder:printcolumn:name="Lag",type="integer",JSONPath=".status.drDetails.lagMilliseconds",description="Replication lag in milliseconds",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// FoundationDBDisasterRecovery is the Schema for the foundationdbdisasterrecoveries API
+type FoundationDBDisasterRecovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FoundationDBDisasterRecoverySpec   `json:"spec,omitempty"`
+	Status FoundationDBDisasterRecoveryStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FoundationDBDisasterRecoveryList contains a list of FoundationDBDisasterRecovery objects
+type FoundationDBDisasterRecoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FoundationDBDisasterRecovery `json:"items"`
+}
+
+// FoundationDBDisasterRecoverySpec describes the desired state of the
+// replication between two clusters.
+type FoundationDBDisasterRecoverySpec struct {
+	// The version of FoundationDB that the DR agents should run.
+	Version string `json:"version"`
+
+	// SourceCluster defines the cluster that is initially replicated to the
+	// destination cluster.
+	SourceCluster DisasterRecoveryClusterReference `json:"sourceCluster"`
+
+	// DestinationCluster defines the cluster that initially receives the
+	// data of the source cluster.
+	DestinationCluster DisasterRecoveryClusterReference `json:"destinationCluster"`
+
+	// +kubebuilder:validation:Enum=Running;Stopped;Paused
+	// The desired state of the replication.
+	// The default is Running.
+	DRState DisasterRecoveryState `json:"drState,omitempty"`
+
+	// +kubebuilder:validation:Enum=Source;Destination
+	// ActiveCluster defines which cluster accepts writes. The data is
+	// replicated from the active cluster to the other cluster. Changing
+	// this field will make the operator perform a switchover.
+	// The default is Source.
+	ActiveCluster DisasterRecoveryClusterRole `json:"activeCluster,omitempty"`
+
+	// AgentCount defines the number of DR agents to run.
+	// The default is run 2 agents.
+	AgentCount *int `json:"agentCount,omitempty"`
+
+	// AgentDeploymentMetadata allows customizing labels and annotations on
+	// the deployment for the DR agents.
+	AgentDeploymentMetadata *metav1.ObjectMeta `json:"agentDeploymentMetadata,omitempty"`
+
+	// PodTemplateSpec allows customizing the pod template for the DR agents.
+	PodTemplateSpec *corev1.PodTemplateSpec `json:"podTemplateSpec,omitempty"`
+
+	// CustomParameters defines additional parameters to pass to the DR
+	// agents.
+	CustomParameters FoundationDBCustomParameters `json:"customParameters,omitempty"`
+
+	// This setting defines if a user provided image can have it's own tag
+	// rather than getting the provided version appended.
+	// You have to ensure that the specified version in the Spec is compatible
+	// with the given version in your custom image.
+	// +kubebuilder:default:=false
+	AllowTagOverride *bool `json:"allowTagOverride,omitempty"`
+
+	// MainContainer defines customization for the foundationdb container.
+	MainContainer ContainerOverrides `json:"mainContainer,omitempty"`
+
+	// SidecarContainer defines customization for the
+	// foundationdb-kubernetes-sidecar container.
+	SidecarContainer ContainerOverrides `json:"sidecarContainer,omitempty"`
+}
+
+// DisasterRecoveryClusterReference references a cluster that takes part in
+// the replication.
+type DisasterRecoveryClusterReference struct {
+	// Name defines the name of the FoundationDBCluster resource.
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// Namespace defines the namespace of the FoundationDBCluster resource.
+	// The default is the namespace of the disaster recovery.
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// ConnectionString defines the connection string of a cluster that is
+	// not managed in this Kubernetes cluster. If a connection string is
+	// defined, the name and namespace will be ignored.
+	ConnectionString string `json:"connectionString,omitempty"`
+}
+
+// FoundationDBDisasterRecoveryStatus describes the current status of the
+// replication between two clusters.
+type FoundationDBDisasterRecoveryStatus struct {
+	// AgentCount provides the number of agents that are up-to-date, ready,
+	// and not terminated.
+	AgentCount int `json:"agentCount,omitempty"`
+
+	// DeploymentConfigured indicates whether the deployment is correctly
+	// configured.
+	DeploymentConfigured bool `json:"deploymentConfigured,omitempty"`
+
+	// DRDetails provides information about the state of the replication.
+	DRDetails *FoundationDBDisasterRecoveryStatusDetails `json:"drDetails,omitempty"`
+
+	// ActiveCluster provides the cluster that currently accepts writes.
+	ActiveCluster DisasterRecoveryClusterRole `json:"activeCluster,omitempty"`
+
+	// Generations provides information about the latest generation to be
+	// reconciled, or to reach other stages in reconciliation.
+	Generations DisasterRecoveryGenerationStatus `json:"generations,omitempty"`
+
+	// Conditions represents the latest available observations of the
+	// disaster recovery's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// DisasterRecoveryConditionReconciled indicates that the operator has
+	// reconciled the latest generation of the disaster recovery.
+	DisasterRecoveryConditionReconciled = "Reconciled"
+	// DisasterRecoveryConditionRunning indicates that the replication is
+	// running.
+	DisasterRecoveryConditionRunning = "Running"
+	// DisasterRecoveryConditionPaused indicates that the replication is
+	// paused.
+	DisasterRecoveryConditionPaused = "Paused"
+)
+
+const (
+	// ConditionReasonDisasterRecoveryRunning is the reason for a Running
+	// condition if the replication is running.
+	ConditionReasonDisasterRecoveryRunning = "DisasterRecoveryRunning"
+	// ConditionReasonDisasterRecoveryNotRunning is the reason for a Running
+	// condition if the replication is not running.
+	ConditionReasonDisasterRecoveryNotRunning = "DisasterRecoveryNotRunning"
+	// ConditionReasonDisasterRecoveryPaused is the reason for a Paused
+	// condition if the replication is paused.
+	ConditionReasonDisasterRecoveryPaused = "DisasterRecoveryPaused"
+	// ConditionReasonDisasterRecoveryNotPaused is the reason for a Paused
+	// condition if the replication is not paused.
+	ConditionReasonDisasterRecoveryNotPaused = "DisasterRecoveryNotPaused"
+)
+
+// FoundationDBDisasterRecoveryStatusDetails provides information about the
+// state of the replication.
+type FoundationDBDisasterRecoveryStatusDetails struct {
+	// State provides the state of the replication.
+	State DisasterRecoveryReplicationState `json:"state,omitempty"`
+
+	// Running indicates whether the replication is running.
+	Running bool `json:"running,omitempty"`
+
+	// Paused indicates whether the replication is paused.
+	Paused bool `json:"paused,omitempty"`
+
+	// LagMilliseconds provides how far the destination cluster is behind
+	// the active cluster.
+	LagMilliseconds int64 `json:"lagMilliseconds,omitempty"`
+}
+
+// DisasterRecoveryGenerationStatus stores information on which generations
+// have reached different stages in reconciliation for the disaster recovery.
+type DisasterRecoveryGenerationStatus struct {
+	// Reconciled provides the last generation that was fully reconciled.
+	Reconciled int64 `json:"reconciled,omitempty"`
+
+	// NeedsAgentUpdate provides the last generation that could not complete
+	// reconciliation because the DR agent deployment needs to be updated.
+	NeedsAgentUpdate int64 `json:"needsAgentUpdate,omitempty"`
+
+	// NeedsDRStart provides the last generation that could not complete
+	// reconciliation because we need to start the replication.
+	NeedsDRStart int64 `json:"needsDRStart,omitempty"`
+
+	// NeedsDRStop provides the last generation that could not complete
+	// reconciliation because we need to abort the replication.
+	NeedsDRStop int64 `json:"needsDRStop,omitempty"`
+
+	// NeedsDRPauseToggle provides the last generation that needs to have the
+	// replication paused or resumed.
+	NeedsDRPauseToggle int64 `json:"needsDRPauseToggle,omitempty"`
+
+	// NeedsSwitchover provides the last generation that could not complete
+	// reconciliation because the active cluster must be switched.
+	NeedsSwitchover int64 `json:"needsSwitchover,omitempty"`
+}
+
+// DisasterRecoveryState defines the desired state of a disaster recovery.
+type DisasterRecoveryState string
+
+const (
+	// DisasterRecoveryStateRunning defines the running state
+	DisasterRecoveryStateRunning DisasterRecoveryState = "Running"
+	// DisasterRecoveryStatePaused defines the paused state
+	DisasterRecoveryStatePaused DisasterRecoveryState = "Paused"
+	// DisasterRecoveryStateStopped defines the stopped state
+	DisasterRecoveryStateStopped DisasterRecoveryState = "Stopped"
+)
+
+// DisasterRecoveryClusterRole defines one of the clusters of a disaster
+// recovery.
+type DisasterRecoveryClusterRole string
+
+const (
+	// DisasterRecoveryClusterRoleSource defines the source cluster of the spec.
+	DisasterRecoveryClusterRoleSource DisasterRecoveryClusterRole = "Source"
+	// DisasterRecoveryClusterRoleDestination defines the destination cluster
+	// of the spec.
+	DisasterRecoveryClusterRoleDestination DisasterRecoveryClusterRole = "Destination"
+)
+
+// DisasterRecoveryReplicationState defines the state of the replication as
+// reported by fdbdr.
+type DisasterRecoveryReplicationState string
+
+const (
+	// DisasterRecoveryReplicationStateStarting indicates that the replication
+	// was submitted but has not copied any data yet.
+	DisasterRecoveryReplicationStateStarting DisasterRecoveryReplicationState = "Starting"
+	// DisasterRecoveryReplicationStateCopying indicates that the initial copy
+	// of the data is in progress.
+	DisasterRecoveryReplicationStateCopying DisasterRecoveryReplicationState = "Copying"
+	// DisasterRecoveryReplicationStateReplicating indicates that the
+	// destination cluster is a complete copy of the active cluster and that
+	// new mutations are replicated.
+	DisasterRecoveryReplicationStateReplicating DisasterRecoveryReplicationState = "Replicating"
+	// DisasterRecoveryReplicationStateCompleted indicates that the replication
+	// has completed.
+	DisasterRecoveryReplicationStateCompleted DisasterRecoveryReplicationState = "Completed"
+	// DisasterRecoveryReplicationStateAborted indicates that the replication
+	// was aborted.
+	DisasterRecoveryReplicationStateAborted DisasterRecoveryReplicationState = "Aborted"
+)
+
+// IsRunning returns true if the replication is in progress in this state.
+func (state DisasterRecoveryReplicationState) IsRunning() bool {
+	return state == DisasterRecoveryReplicationStateStarting ||
+		state == DisasterRecoveryReplicationStateCopying ||
+		state == DisasterRecoveryReplicationStateReplicating
+}
+
+// FoundationDBLiveDisasterRecoveryStatus describes the live status of the
+// replication, as provided by the fdbdr status command.
+type FoundationDBLiveDisasterRecoveryStatus struct {
+	// State provides the state of the replication.
+	State DisasterRecoveryReplicationState `json:"state,omitempty"`
+
+	// Paused describes whether the replication is paused.
+	Paused bool `json:"paused,omitempty"`
+
+	// LagMilliseconds provides how far the destination cluster is behind
+	// the source cluster.
+	LagMilliseconds int64 `json:"lagMilliseconds,omitempty"`
+}
+
+// ShouldRun determines whether the replication should be running.
+func (dr *FoundationDBDisasterRecovery) ShouldRun() bool {
+	return dr.Spec.DRState == "" || dr.Spec.DRState == DisasterRecoveryStateRunning || dr.Spec.DRState == DisasterRecoveryStatePaused
+}
+
+// ShouldBePaused determines whether the replication should be paused.
+func (dr *FoundationDBDisasterRecovery) ShouldBePaused() bool {
+	return dr.Spec.DRState == DisasterRecoveryStatePaused
+}
+
+// GetDesiredAgentCount determines how many DR agents we should run.
+func (dr *FoundationDBDisasterRecovery) GetDesiredAgentCount() int {
+	return pointer.IntDeref(dr.Spec.AgentCount, 2)
+}
+
+// GetDesiredActiveCluster returns the cluster that should accept writes.
+func (dr *FoundationDBDisasterRecovery) GetDesiredActiveCluster() DisasterRecoveryClusterRole {
+	if dr.Spec.ActiveCluster == "" {
+		return DisasterRecoveryClusterRoleSource
+	}
+
+	return dr.Spec.ActiveCluster
+}
+
+// GetActiveCluster returns the cluster that currently accepts writes.
+func (dr *FoundationDBDisasterRecovery) GetActiveCluster() DisasterRecoveryClusterRole {
+	if dr.Status.ActiveCluster == "" {
+		return DisasterRecoveryClusterRoleSource
+	}
+
+	return dr.Status.ActiveCluster
+}
+
+// NeedsSwitchover determines whether the active cluster must be switched.
+func (dr *FoundationDBDisasterRecovery) NeedsSwitchover() bool {
+	return dr.GetDesiredActiveCluster() != dr.GetActiveCluster()
+}
+
+// GetReplicationClusters returns the cluster that is currently replicated and
+// the cluster that currently receives the replicated data.
+func (dr *FoundationDBDisasterRecovery) GetReplicationClusters() (DisasterRecoveryClusterReference, DisasterRecoveryClusterReference) {
+	if dr.GetActiveCluster() == DisasterRecoveryClusterRoleDestination {
+		return dr.Spec.DestinationCluster, dr.Spec.SourceCluster
+	}
+
+	return dr.Spec.SourceCluster, dr.Spec.DestinationCluster
+}
+
+// GetNamespace returns the namespace of the referenced cluster, which defaults
+// to the provided namespace.
+func (reference DisasterRecoveryClusterReference) GetNamespace(defaultNamespace string) string {
+	if reference.Namespace == "" {
+		return defaultNamespace
+	}
+
+	return reference.Namespace
+}
+
+// CheckReconciliation compares the spec and the status to determine if
+// reconciliation is complete.
+func (dr *FoundationDBDisasterRecovery) CheckReconciliation() (bool, error) {
+	var reconciled = true
+
+	desiredAgentCount := dr.GetDesiredAgentCount()
+	if dr.Status.AgentCount != desiredAgentCount || !dr.Status.DeploymentConfigured {
+		dr.Status.Generations.NeedsAgentUpdate = dr.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	isRunning := dr.Status.DRDetails != nil && dr.Status.DRDetails.Running
+	isPaused := dr.Status.DRDetails != nil && dr.Status.DRDetails.Paused
+
+	if dr.ShouldRun() && !isRunning {
+		dr.Status.Generations.NeedsDRStart = dr.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	if !dr.ShouldRun() && isRunning {
+		dr.Status.Generations.NeedsDRStop = dr.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	if dr.ShouldBePaused() != isPaused {
+		dr.Status.Generations.NeedsDRPauseToggle = dr.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	if dr.NeedsSwitchover() {
+		dr.Status.Generations.NeedsSwitchover = dr.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	if reconciled {
+		dr.Status.Generations = DisasterRecoveryGenerationStatus{
+			Reconciled: dr.ObjectMeta.Generation,
+		}
+	}
+
+	return reconciled, nil
+}
+
+// GetAllowTagOverride returns the bool value for AllowTagOverride
+func (foundationDBDisasterRecoverySpec *FoundationDBDisasterRecoverySpec) GetAllowTagOverride() bool {
+	return pointer.BoolDeref(foundationDBDisasterRecoverySpec.AllowTagOverride, false)
+}
+
+func init() {
+	SchemeBuilder.Register(&FoundationDBDisasterRecovery{}, &FoundationDBDisasterRecoveryList{})
+}
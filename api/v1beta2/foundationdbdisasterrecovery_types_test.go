@@ -0,0 +1,158 @@
+/*
+ * foundationdbdisasterrecovery_types_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("[api] FoundationDBDisasterRecovery", func() {
+	var dr *FoundationDBDisasterRecovery
+
+	BeforeEach(func() {
+		dr = &FoundationDBDisasterRecovery{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "sample-dr",
+				Namespace:  "default",
+				Generation: 2,
+			},
+			Spec: FoundationDBDisasterRecoverySpec{
+				SourceCluster:      DisasterRecoveryClusterReference{Name: "source"},
+				DestinationCluster: DisasterRecoveryClusterReference{Name: "destination", Namespace: "remote"},
+				AgentCount:         pointer.Int(3),
+			},
+			Status: FoundationDBDisasterRecoveryStatus{
+				Generations: DisasterRecoveryGenerationStatus{
+					Reconciled: 1,
+				},
+				AgentCount:           3,
+				DeploymentConfigured: true,
+				DRDetails: &FoundationDBDisasterRecoveryStatusDetails{
+					State:   DisasterRecoveryReplicationStateReplicating,
+					Running: true,
+				},
+			},
+		}
+	})
+
+	When("getting the defaults", func() {
+		It("should return the default values", func() {
+			dr = &FoundationDBDisasterRecovery{}
+			Expect(dr.ShouldRun()).To(BeTrue())
+			Expect(dr.ShouldBePaused()).To(BeFalse())
+			Expect(dr.GetDesiredAgentCount()).To(Equal(2))
+			Expect(dr.GetDesiredActiveCluster()).To(Equal(DisasterRecoveryClusterRoleSource))
+			Expect(dr.GetActiveCluster()).To(Equal(DisasterRecoveryClusterRoleSource))
+			Expect(dr.NeedsSwitchover()).To(BeFalse())
+			Expect(dr.Spec.GetAllowTagOverride()).To(BeFalse())
+		})
+	})
+
+	When("getting the namespace of a cluster reference", func() {
+		It("should fall back to the provided namespace", func() {
+			Expect(dr.Spec.SourceCluster.GetNamespace(dr.Namespace)).To(Equal("default"))
+			Expect(dr.Spec.DestinationCluster.GetNamespace(dr.Namespace)).To(Equal("remote"))
+		})
+	})
+
+	When("getting the replication clusters", func() {
+		It("should replicate from the source cluster", func() {
+			source, destination := dr.GetReplicationClusters()
+			Expect(source.Name).To(Equal("source"))
+			Expect(destination.Name).To(Equal("destination"))
+		})
+
+		When("the destination cluster is active", func() {
+			BeforeEach(func() {
+				dr.Status.ActiveCluster = DisasterRecoveryClusterRoleDestination
+			})
+
+			It("should replicate from the destination cluster", func() {
+				source, destination := dr.GetReplicationClusters()
+				Expect(source.Name).To(Equal("destination"))
+				Expect(destination.Name).To(Equal("source"))
+			})
+		})
+	})
+
+	DescribeTable("checking the replication state",
+		func(state DisasterRecoveryReplicationState, expected bool) {
+			Expect(state.IsRunning()).To(Equal(expected))
+		},
+		Entry("starting", DisasterRecoveryReplicationStateStarting, true),
+		Entry("copying", DisasterRecoveryReplicationStateCopying, true),
+		Entry("replicating", DisasterRecoveryReplicationStateReplicating, true),
+		Entry("completed", DisasterRecoveryReplicationStateCompleted, false),
+		Entry("aborted", DisasterRecoveryReplicationStateAborted, false),
+	)
+
+	When("checking reconciliation", func() {
+		It("should be reconciled when the replication matches the spec", func() {
+			result, err := dr.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeTrue())
+			Expect(dr.Status.Generations).To(Equal(DisasterRecoveryGenerationStatus{Reconciled: 2}))
+		})
+
+		It("should require an agent update when the agent count differs", func() {
+			dr.Status.AgentCount = 5
+			result, err := dr.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeFalse())
+			Expect(dr.Status.Generations).To(Equal(DisasterRecoveryGenerationStatus{Reconciled: 1, NeedsAgentUpdate: 2}))
+		})
+
+		It("should require a start when the replication is not running", func() {
+			dr.Status.DRDetails = nil
+			result, err := dr.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeFalse())
+			Expect(dr.Status.Generations).To(Equal(DisasterRecoveryGenerationStatus{Reconciled: 1, NeedsDRStart: 2}))
+		})
+
+		It("should require a stop when the replication should be stopped", func() {
+			dr.Spec.DRState = DisasterRecoveryStateStopped
+			result, err := dr.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeFalse())
+			Expect(dr.Status.Generations).To(Equal(DisasterRecoveryGenerationStatus{Reconciled: 1, NeedsDRStop: 2}))
+		})
+
+		It("should require a pause toggle when the replication should be paused", func() {
+			dr.Spec.DRState = DisasterRecoveryStatePaused
+			result, err := dr.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeFalse())
+			Expect(dr.Status.Generations).To(Equal(DisasterRecoveryGenerationStatus{Reconciled: 1, NeedsDRPauseToggle: 2}))
+		})
+
+		It("should require a switchover when the active cluster differs", func() {
+			dr.Spec.ActiveCluster = DisasterRecoveryClusterRoleDestination
+			result, err := dr.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(BeFalse())
+			Expect(dr.Status.Generations).To(Equal(DisasterRecoveryGenerationStatus{Reconciled: 1, NeedsSwitchover: 2}))
+		})
+	})
+})
@@ -0,0 +1,285 @@
+/*
+Copyright 2023 FoundationDB project authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=fdbmrc
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Generation",type="integer",JSONPath=".metadata.generation",description="Latest generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Reconciled",type="integer",JSONPath=".status.generations.reconciled",description="Last reconciled generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Configuring",type="string",JSONPath=".status.configuringDataCenter",description="Data center that changes the database configuration",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// FoundationDBMultiRegionCluster is the Schema for the foundationdbmultiregionclusters API
+type FoundationDBMultiRegionCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FoundationDBMultiRegionClusterSpec   `json:"spec,omitempty"`
+	Status FoundationDBMultiRegionClusterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FoundationDBMultiRegionClusterList contains a list of FoundationDBMultiRegionCluster objects
+type FoundationDBMultiRegionClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FoundationDBMultiRegionCluster `json:"items"`
+}
+
+// FoundationDBMultiRegionClusterSpec describes the desired state of a
+// cluster that spans multiple data centers.
+type FoundationDBMultiRegionClusterSpec struct {
+	// ClusterSpec defines the configuration that is shared by the
+	// FoundationDBClusters of all data centers. The regions in the database
+	// configuration define the data centers, the operator creates one
+	// FoundationDBCluster for every data center. The data center, the seed
+	// connection string and the configureDatabase automation option of the
+	// generated clusters are managed by the operator.
+	ClusterSpec FoundationDBClusterSpec `json:"clusterSpec"`
+
+	// DataCenters defines settings for the FoundationDBCluster of a single
+	// data center.
+	// +kubebuilder:validation:MaxItems=10
+	DataCenters []MultiRegionDataCenter `json:"dataCenters,omitempty"`
+}
+
+// MultiRegionDataCenter defines the settings for the FoundationDBCluster of a
+// single data center.
+type MultiRegionDataCenter struct {
+	// ID defines the ID of the data center. The ID must be used in the
+	// regions of the database configuration.
+	// +kubebuilder:validation:MaxLength=63
+	ID string `json:"id"`
+
+	// Namespace defines the namespace of the FoundationDBCluster for this
+	// data center.
+	// The default is the namespace of the multi-region cluster.
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// Labels defines additional labels for the FoundationDBCluster of this
+	// data center.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ProcessCounts overrides the process counts of the shared cluster spec
+	// for this data center, e.g. to only run log processes in a satellite.
+	ProcessCounts *ProcessCounts `json:"processCounts,omitempty"`
+}
+
+// FoundationDBMultiRegionClusterStatus describes the current status of a
+// cluster that spans multiple data centers.
+type FoundationDBMultiRegionClusterStatus struct {
+	// ConnectionString provides the connection string of the cluster.
+	ConnectionString string `json:"connectionString,omitempty"`
+
+	// ConfiguringDataCenter provides the data center whose FoundationDBCluster
+	// is allowed to change the database configuration. All other data centers
+	// will not change the database configuration.
+	ConfiguringDataCenter string `json:"configuringDataCenter,omitempty"`
+
+	// DataCenters provides the state of the FoundationDBCluster of every data
+	// center.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	DataCenters []MultiRegionDataCenterStatus `json:"dataCenters,omitempty"`
+
+	// Generations provides information about the latest generation to be
+	// reconciled, or to reach other stages in reconciliation.
+	Generations MultiRegionClusterGenerationStatus `json:"generations,omitempty"`
+
+	// Conditions represents the latest available observations of the
+	// multi-region cluster's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// MultiRegionDataCenterStatus describes the state of the FoundationDBCluster
+// of a single data center.
+type MultiRegionDataCenterStatus struct {
+	// ID provides the ID of the data center.
+	ID string `json:"id"`
+
+	// ClusterName provides the name of the FoundationDBCluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Namespace provides the namespace of the FoundationDBCluster.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Created indicates whether the FoundationDBCluster exists.
+	Created bool `json:"created,omitempty"`
+
+	// Reconciled indicates whether the FoundationDBCluster has the desired
+	// spec and has reconciled it.
+	Reconciled bool `json:"reconciled,omitempty"`
+}
+
+// MultiRegionClusterGenerationStatus stores information on which generations
+// have reached different stages in reconciliation for the multi-region
+// cluster.
+type MultiRegionClusterGenerationStatus struct {
+	// Reconciled provides the last generation that was fully reconciled.
+	Reconciled int64 `json:"reconciled,omitempty"`
+
+	// NeedsBootstrap provides the last generation that could not complete
+	// reconciliation because the cluster of the configuring data center is not
+	// configured yet.
+	NeedsBootstrap int64 `json:"needsBootstrap,omitempty"`
+
+	// NeedsClusterUpdate provides the last generation that could not complete
+	// reconciliation because the FoundationDBCluster of a data center is not
+	// created, not updated or not reconciled.
+	NeedsClusterUpdate int64 `json:"needsClusterUpdate,omitempty"`
+}
+
+const (
+	// MultiRegionClusterConditionReconciled indicates that the operator has
+	// reconciled the latest generation of the multi-region cluster.
+	MultiRegionClusterConditionReconciled = "Reconciled"
+)
+
+// GetDataCenterIDs returns the unique IDs of the data centers defined in the
+// regions of the database configuration, ordered by their first occurrence.
+func (mrc *FoundationDBMultiRegionCluster) GetDataCenterIDs() []string {
+	dcSet := map[string]struct{}{}
+	dcIDs := make([]string, 0)
+
+	for _, region := range mrc.Spec.ClusterSpec.DatabaseConfiguration.Regions {
+		for _, dc := range region.DataCenters {
+			if _, ok := dcSet[dc.ID]; ok {
+				continue
+			}
+
+			dcSet[dc.ID] = struct{}{}
+			dcIDs = append(dcIDs, dc.ID)
+		}
+	}
+
+	return dcIDs
+}
+
+// GetPrimaryDataCenter returns the ID of the main data center with the
+// highest priority. Satellites are never considered as primary data center.
+func (mrc *FoundationDBMultiRegionCluster) GetPrimaryDataCenter() string {
+	var primary string
+	priority := 0
+
+	for _, region := range mrc.Spec.ClusterSpec.DatabaseConfiguration.Regions {
+		for _, dc := range region.DataCenters {
+			if dc.Satellite != 0 {
+				continue
+			}
+
+			if primary == "" || dc.Priority > priority {
+				primary = dc.ID
+				priority = dc.Priority
+			}
+		}
+	}
+
+	return primary
+}
+
+// GetDataCenter returns the settings for the provided data center. If no
+// settings are defined an empty MultiRegionDataCenter will be returned.
+func (mrc *FoundationDBMultiRegionCluster) GetDataCenter(dcID string) MultiRegionDataCenter {
+	for _, dc := range mrc.Spec.DataCenters {
+		if dc.ID == dcID {
+			return dc
+		}
+	}
+
+	return MultiRegionDataCenter{ID: dcID}
+}
+
+// GetClusterName returns the name of the FoundationDBCluster for the provided
+// data center.
+func (mrc *FoundationDBMultiRegionCluster) GetClusterName(dcID string) string {
+	return fmt.Sprintf("%s-%s", mrc.Name, dcID)
+}
+
+// GetClusterNamespace returns the namespace of the FoundationDBCluster for
+// the provided data center.
+func (mrc *FoundationDBMultiRegionCluster) GetClusterNamespace(dcID string) string {
+	dc := mrc.GetDataCenter(dcID)
+	if dc.Namespace == "" {
+		return mrc.Namespace
+	}
+
+	return dc.Namespace
+}
+
+// GetDataCenterStatus returns the status of the provided data center or nil if
+// no status is present.
+func (mrc *FoundationDBMultiRegionCluster) GetDataCenterStatus(dcID string) *MultiRegionDataCenterStatus {
+	for idx, dc := range mrc.Status.DataCenters {
+		if dc.ID == dcID {
+			return &mrc.Status.DataCenters[idx]
+		}
+	}
+
+	return nil
+}
+
+// CheckReconciliation compares the spec and the status to determine if
+// reconciliation is complete.
+func (mrc *FoundationDBMultiRegionCluster) CheckReconciliation() (bool, error) {
+	var reconciled = true
+
+	dcIDs := mrc.GetDataCenterIDs()
+	if len(dcIDs) == 0 {
+		return false, fmt.Errorf("multi-region cluster %s/%s has no data centers defined in the regions of the database configuration", mrc.Namespace, mrc.Name)
+	}
+
+	configuring := mrc.GetDataCenterStatus(mrc.Status.ConfiguringDataCenter)
+	if configuring == nil || !configuring.Created || mrc.Status.ConnectionString == "" {
+		mrc.Status.Generations.NeedsBootstrap = mrc.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	for _, dcID := range dcIDs {
+		dcStatus := mrc.GetDataCenterStatus(dcID)
+		if dcStatus == nil || !dcStatus.Created || !dcStatus.Reconciled {
+			mrc.Status.Generations.NeedsClusterUpdate = mrc.ObjectMeta.Generation
+			reconciled = false
+			break
+		}
+	}
+
+	if reconciled {
+		mrc.Status.Generations = MultiRegionClusterGenerationStatus{
+			Reconciled: mrc.ObjectMeta.Generation,
+		}
+	}
+
+	return reconciled, nil
+}
+
+func init() {
+	SchemeBuilder.Register(&FoundationDBMultiRegionCluster{}, &FoundationDBMultiRegionClusterList{})
+}
@@ -0,0 +1,158 @@
+/*
+ * foundationdbmultiregioncluster_types_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[api] FoundationDBMultiRegionCluster", func() {
+	var mrc *FoundationDBMultiRegionCluster
+
+	BeforeEach(func() {
+		mrc = &FoundationDBMultiRegionCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "sample-mrc",
+				Namespace:  "default",
+				Generation: 2,
+			},
+			Spec: FoundationDBMultiRegionClusterSpec{
+				ClusterSpec: FoundationDBClusterSpec{
+					DatabaseConfiguration: DatabaseConfiguration{
+						UsableRegions: 2,
+						Regions: []Region{
+							{
+								DataCenters: []DataCenter{
+									{ID: "primary", Priority: 1},
+									{ID: "satellite", Satellite: 1, Priority: 1},
+								},
+							},
+							{
+								DataCenters: []DataCenter{
+									{ID: "remote", Priority: 2},
+									{ID: "satellite", Satellite: 1},
+								},
+							},
+						},
+					},
+				},
+				DataCenters: []MultiRegionDataCenter{
+					{ID: "satellite", Namespace: "satellite-ns"},
+				},
+			},
+			Status: FoundationDBMultiRegionClusterStatus{
+				ConnectionString:      "test:test@127.0.0.1:4501",
+				ConfiguringDataCenter: "remote",
+				DataCenters: []MultiRegionDataCenterStatus{
+					{ID: "primary", Created: true, Reconciled: true},
+					{ID: "satellite", Created: true, Reconciled: true},
+					{ID: "remote", Created: true, Reconciled: true},
+				},
+				Generations: MultiRegionClusterGenerationStatus{
+					Reconciled: 1,
+				},
+			},
+		}
+	})
+
+	When("getting the data centers", func() {
+		It("should return the unique data centers", func() {
+			Expect(mrc.GetDataCenterIDs()).To(Equal([]string{"primary", "satellite", "remote"}))
+		})
+
+		It("should return the main data center with the highest priority", func() {
+			Expect(mrc.GetPrimaryDataCenter()).To(Equal("remote"))
+		})
+
+		It("should return the data center settings", func() {
+			Expect(mrc.GetDataCenter("satellite").Namespace).To(Equal("satellite-ns"))
+			Expect(mrc.GetDataCenter("primary")).To(Equal(MultiRegionDataCenter{ID: "primary"}))
+		})
+	})
+
+	When("getting the cluster of a data center", func() {
+		It("should return the name and namespace", func() {
+			Expect(mrc.GetClusterName("primary")).To(Equal("sample-mrc-primary"))
+			Expect(mrc.GetClusterNamespace("primary")).To(Equal("default"))
+			Expect(mrc.GetClusterNamespace("satellite")).To(Equal("satellite-ns"))
+		})
+	})
+
+	When("checking the reconciliation", func() {
+		var reconciled bool
+		var err error
+
+		JustBeforeEach(func() {
+			reconciled, err = mrc.CheckReconciliation()
+		})
+
+		When("all data centers are reconciled", func() {
+			It("should mark the multi-region cluster as reconciled", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reconciled).To(BeTrue())
+				Expect(mrc.Status.Generations).To(Equal(MultiRegionClusterGenerationStatus{Reconciled: 2}))
+			})
+		})
+
+		When("the database is not yet configured", func() {
+			BeforeEach(func() {
+				mrc.Status.ConnectionString = ""
+			})
+
+			It("should need a bootstrap", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reconciled).To(BeFalse())
+				Expect(mrc.Status.Generations).To(Equal(MultiRegionClusterGenerationStatus{
+					Reconciled:     1,
+					NeedsBootstrap: 2,
+				}))
+			})
+		})
+
+		When("a data center is not reconciled", func() {
+			BeforeEach(func() {
+				mrc.Status.DataCenters[1].Reconciled = false
+			})
+
+			It("should need a cluster update", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reconciled).To(BeFalse())
+				Expect(mrc.Status.Generations).To(Equal(MultiRegionClusterGenerationStatus{
+					Reconciled:         1,
+					NeedsClusterUpdate: 2,
+				}))
+			})
+		})
+
+		When("no regions are defined", func() {
+			BeforeEach(func() {
+				mrc.Spec.ClusterSpec.DatabaseConfiguration.Regions = nil
+			})
+
+			It("should return an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(reconciled).To(BeFalse())
+			})
+		})
+	})
+})
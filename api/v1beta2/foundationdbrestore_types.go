@@ -23,6 +23,8 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:shortName=fdbrestore
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Phase of the restore",priority=0
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.progressPercent",description="Percentage of the restored data",priority=0
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:storageversion
 
@@ -59,12 +61,171 @@ type FoundationDBRestoreSpec struct {
 	// CustomParameters defines additional parameters to pass to the backup
 	// agents.
 	CustomParameters FoundationDBCustomParameters `json:"customParameters,omitempty"`
+
+	// TargetVersion defines the database version the backup will be restored
+	// to. If neither a target version nor a target timestamp is defined, the
+	// backup will be restored to the latest restorable version.
+	// +kubebuilder:validation:Minimum=0
+	TargetVersion *int64 `json:"targetVersion,omitempty"`
+
+	// TargetTimestamp defines the point in time the backup will be restored
+	// to. The timestamp is resolved to a version by the timekeeper of the
+	// destination cluster, so it is only supported if the backup was created
+	// by a FoundationDBBackup for the destination cluster. This setting is
+	// mutually exclusive with TargetVersion.
+	TargetTimestamp *metav1.Time `json:"targetTimestamp,omitempty"`
+
+	// AddPrefix defines a prefix that will be added to all restored keys. This
+	// allows to restore the data into a separate keyspace.
+	// +kubebuilder:validation:Pattern:=^[A-Za-z0-9\/\\-]+$
+	AddPrefix string `json:"addPrefix,omitempty"`
+
+	// RemovePrefix defines a prefix that will be removed from all restored
+	// keys. All restored key ranges must start with this prefix.
+	// +kubebuilder:validation:Pattern:=^[A-Za-z0-9\/\\-]+$
+	RemovePrefix string `json:"removePrefix,omitempty"`
+
+	// Cancel defines if the restore should be cancelled. If the restore is
+	// running, the operator will abort it and the restore will move into the
+	// Failed phase. Data that was already restored will not be removed.
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // FoundationDBRestoreStatus describes the current status of the restore for a cluster.
 type FoundationDBRestoreStatus struct {
 	// Running describes whether the restore is currently running.
 	Running bool `json:"running,omitempty"`
+
+	// Phase provides the current phase of the restore. The Completed and
+	// Failed phases are terminal.
+	Phase RestorePhase `json:"phase,omitempty"`
+
+	// RestoredBytes provides the number of bytes that were written to the
+	// destination cluster.
+	RestoredBytes int64 `json:"restoredBytes,omitempty"`
+
+	// ProgressPercent provides the percentage of the restored data blocks.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ProgressPercent int `json:"progressPercent,omitempty"`
+
+	// TargetVersion provides the database version the backup is restored to.
+	TargetVersion int64 `json:"targetVersion,omitempty"`
+
+	// StartTime provides the timestamp when the restore was started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime provides the timestamp when the restore reached a terminal
+	// phase.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// Error provides the last error reported by the restore.
+	Error string `json:"error,omitempty"`
+
+	// Conditions represents the latest available observations of the
+	// restore's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RestorePhase represents the phase of a restore.
+// +kubebuilder:validation:MaxLength=64
+type RestorePhase string
+
+const (
+	// RestorePhasePending indicates that the restore was not started yet.
+	RestorePhasePending RestorePhase = "Pending"
+	// RestorePhaseRunning indicates that the restore is running.
+	RestorePhaseRunning RestorePhase = "Running"
+	// RestorePhaseCompleted indicates that the restore has completed.
+	RestorePhaseCompleted RestorePhase = "Completed"
+	// RestorePhaseFailed indicates that the restore was aborted, either
+	// because of an error or because it was cancelled.
+	RestorePhaseFailed RestorePhase = "Failed"
+)
+
+// IsTerminal returns true if the restore will not make any further progress.
+func (phase RestorePhase) IsTerminal() bool {
+	return phase == RestorePhaseCompleted || phase == RestorePhaseFailed
+}
+
+const (
+	// RestoreConditionReconciled indicates that the operator has reconciled
+	// the latest generation of the restore.
+	RestoreConditionReconciled = "Reconciled"
+	// RestoreConditionRunning indicates that the restore is running.
+	RestoreConditionRunning = "Running"
+)
+
+const (
+	// ConditionReasonRestoreRunning is the reason for a Running condition if
+	// the restore is running.
+	ConditionReasonRestoreRunning = "RestoreRunning"
+	// ConditionReasonRestoreNotRunning is the reason for a Running condition
+	// if the restore is not running.
+	ConditionReasonRestoreNotRunning = "RestoreNotRunning"
+)
+
+// RestoreState represents the state of a restore as reported by the restore
+// status command.
+type RestoreState string
+
+const (
+	// RestoreStateQueued indicates that the restore is queued.
+	RestoreStateQueued RestoreState = "queued"
+	// RestoreStateStarting indicates that the restore is starting.
+	RestoreStateStarting RestoreState = "starting"
+	// RestoreStateRunning indicates that the restore is running.
+	RestoreStateRunning RestoreState = "running"
+	// RestoreStateCompleted indicates that the restore has completed.
+	RestoreStateCompleted RestoreState = "completed"
+	// RestoreStateAborted indicates that the restore was aborted.
+	RestoreStateAborted RestoreState = "aborted"
+)
+
+// FoundationDBLiveRestoreStatus describes the live status of the restore
+// for a cluster, as provided by the restore status command.
+type FoundationDBLiveRestoreStatus struct {
+	// Tag provides the tag of the restore.
+	Tag string `json:"tag,omitempty"`
+
+	// State provides the current state of the restore.
+	State RestoreState `json:"state,omitempty"`
+
+	// BlocksCompleted provides the number of restored data blocks.
+	BlocksCompleted int64 `json:"blocksCompleted,omitempty"`
+
+	// BlocksTotal provides the number of data blocks that must be restored.
+	BlocksTotal int64 `json:"blocksTotal,omitempty"`
+
+	// BytesWritten provides the number of bytes written to the destination
+	// cluster.
+	BytesWritten int64 `json:"bytesWritten,omitempty"`
+
+	// LastError provides the last error reported by the restore.
+	LastError string `json:"lastError,omitempty"`
+
+	// URL provides the URL of the backup that is restored.
+	URL string `json:"url,omitempty"`
+
+	// TargetVersion provides the database version the backup is restored
+	// to.
+	TargetVersion int64 `json:"targetVersion,omitempty"`
+}
+
+// GetProgressPercent returns the percentage of the restored data blocks.
+func (status *FoundationDBLiveRestoreStatus) GetProgressPercent() int {
+	if status.State == RestoreStateCompleted {
+		return 100
+	}
+
+	if status.BlocksTotal <= 0 {
+		return 0
+	}
+
+	return int(status.BlocksCompleted * 100 / status.BlocksTotal)
 }
 
 // FoundationDBKeyRange describes a range of keys for a command.
@@ -82,6 +243,20 @@ type FoundationDBKeyRange struct {
 	End string `json:"end"`
 }
 
+// GetPhase returns the phase of the restore. Restores without a phase were
+// started by an older operator version if they are marked as running.
+func (restore *FoundationDBRestore) GetPhase() RestorePhase {
+	if restore.Status.Phase != "" {
+		return restore.Status.Phase
+	}
+
+	if restore.Status.Running {
+		return RestorePhaseRunning
+	}
+
+	return RestorePhasePending
+}
+
 // BackupName gets the name of the backup for the source backup.
 // This will fill in a default value if the backup name in the spec is empty.
 func (restore *FoundationDBRestore) BackupName() string {
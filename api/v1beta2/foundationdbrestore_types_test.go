@@ -118,4 +118,39 @@ var _ = Describe("[api] FoundationDBRestore", func() {
 				"blobstore://account@account/mybackup?bucket=fdb-backups&secure_connection=0"),
 		)
 	})
+
+	When("getting the phase", func() {
+		DescribeTable("should return the expected phase",
+			func(status FoundationDBRestoreStatus, expected RestorePhase) {
+				restore := FoundationDBRestore{Status: status}
+				Expect(restore.GetPhase()).To(Equal(expected))
+			},
+			Entry("A new restore",
+				FoundationDBRestoreStatus{},
+				RestorePhasePending),
+			Entry("A restore started by an older operator version",
+				FoundationDBRestoreStatus{Running: true},
+				RestorePhaseRunning),
+			Entry("A completed restore",
+				FoundationDBRestoreStatus{Phase: RestorePhaseCompleted},
+				RestorePhaseCompleted),
+		)
+	})
+
+	When("getting the progress of a restore", func() {
+		DescribeTable("should return the expected progress",
+			func(status FoundationDBLiveRestoreStatus, expected int) {
+				Expect(status.GetProgressPercent()).To(Equal(expected))
+			},
+			Entry("A restore without blocks",
+				FoundationDBLiveRestoreStatus{State: RestoreStateQueued},
+				0),
+			Entry("A running restore",
+				FoundationDBLiveRestoreStatus{State: RestoreStateRunning, BlocksCompleted: 33, BlocksTotal: 120},
+				27),
+			Entry("A completed restore",
+				FoundationDBLiveRestoreStatus{State: RestoreStateCompleted, BlocksCompleted: 119, BlocksTotal: 120},
+				100),
+		)
+	})
 })
@@ -0,0 +1,286 @@
+/*
+ * foundationdbrestore_webhook.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/validate-apps-foundationdb-org-v1beta2-foundationdbrestore,mutating=false,failurePolicy=fail,sideEffects=None,groups=apps.foundationdb.org,resources=foundationdbrestores,verbs=create;update,versions=v1beta2,name=vfoundationdbrestore.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FoundationDBRestore{}
+
+// SetupWebhookWithManager registers the validating webhook for the FoundationDBRestore resource.
+func (restore *FoundationDBRestore) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(restore).
+		Complete()
+}
+
+// ValidateCreate validates a newly created FoundationDBRestore.
+func (restore *FoundationDBRestore) ValidateCreate() error {
+	return restore.Validate()
+}
+
+// ValidateUpdate validates the update of a FoundationDBRestore.
+func (restore *FoundationDBRestore) ValidateUpdate(_ runtime.Object) error {
+	return restore.Validate()
+}
+
+// ValidateDelete validates the deletion of a FoundationDBRestore. Deletions are always allowed.
+func (restore *FoundationDBRestore) ValidateDelete() error {
+	return nil
+}
+
+// Validate checks if the spec of the FoundationDBRestore is valid.
+func (restore *FoundationDBRestore) Validate() error {
+	var validations []string
+
+	if restore.Spec.DestinationClusterName == "" {
+		validations = append(validations, "destinationClusterName must be defined")
+	}
+
+	if restore.Spec.BlobStoreConfiguration == nil {
+		validations = append(validations, "blobStoreConfiguration must be defined")
+	} else {
+		validations = append(validations, restore.Spec.BlobStoreConfiguration.validate()...)
+	}
+
+	for idx, keyRange := range restore.Spec.KeyRanges {
+		err := keyRange.Validate()
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("keyRanges[%d]: %s", idx, err.Error()))
+		}
+	}
+
+	if restore.Spec.TargetVersion != nil && restore.Spec.TargetTimestamp != nil {
+		validations = append(validations, "only one of targetVersion and targetTimestamp can be defined")
+	}
+
+	validations = append(validations, restore.validatePrefixes()...)
+
+	err := restore.Spec.CustomParameters.ValidateCustomParameters()
+	if err != nil {
+		validations = append(validations, err.Error())
+	}
+
+	if len(validations) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(validations, ", "))
+}
+
+// validatePrefixes checks that the add and remove prefix can be decoded and that all restored key ranges start with
+// the remove prefix.
+func (restore *FoundationDBRestore) validatePrefixes() []string {
+	var validations []string
+
+	if restore.Spec.AddPrefix != "" {
+		_, err := decodeKey(restore.Spec.AddPrefix)
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("invalid addPrefix %s: %s", restore.Spec.AddPrefix, err.Error()))
+		}
+	}
+
+	if restore.Spec.RemovePrefix == "" {
+		return validations
+	}
+
+	removePrefix, err := decodeKey(restore.Spec.RemovePrefix)
+	if err != nil {
+		return append(validations, fmt.Sprintf("invalid removePrefix %s: %s", restore.Spec.RemovePrefix, err.Error()))
+	}
+
+	if len(restore.Spec.KeyRanges) == 0 {
+		return append(validations, "removePrefix requires keyRanges that start with the prefix")
+	}
+
+	for idx, keyRange := range restore.Spec.KeyRanges {
+		start, err := decodeKey(keyRange.Start)
+		if err != nil {
+			continue
+		}
+
+		end, err := decodeKey(keyRange.End)
+		if err != nil {
+			continue
+		}
+
+		if !bytes.HasPrefix(start, removePrefix) || bytes.Compare(end, getPrefixEnd(removePrefix)) > 0 {
+			validations = append(validations, fmt.Sprintf("keyRanges[%d]: key range must start with removePrefix %s", idx, restore.Spec.RemovePrefix))
+		}
+	}
+
+	return validations
+}
+
+// ValidateKeyRangesForBackup checks that all restored key ranges are included in the key ranges of the backup and that
+// the restored keys are still in the normal keyspace after the prefixes are applied. If the restore defines no key
+// ranges, the key ranges of the backup will be restored.
+func (restore *FoundationDBRestore) ValidateKeyRangesForBackup(backupKeyRanges []FoundationDBKeyRange) error {
+	var validations []string
+
+	addPrefix, err := decodeKey(restore.Spec.AddPrefix)
+	if err != nil {
+		return fmt.Errorf("invalid addPrefix %s: %w", restore.Spec.AddPrefix, err)
+	}
+
+	removePrefix, err := decodeKey(restore.Spec.RemovePrefix)
+	if err != nil {
+		return fmt.Errorf("invalid removePrefix %s: %w", restore.Spec.RemovePrefix, err)
+	}
+
+	keyRanges := restore.Spec.KeyRanges
+	if len(keyRanges) == 0 {
+		keyRanges = backupKeyRanges
+	}
+
+	for _, keyRange := range keyRanges {
+		start, err := decodeKey(keyRange.Start)
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("invalid start key %s: %s", keyRange.Start, err.Error()))
+			continue
+		}
+
+		end, err := decodeKey(keyRange.End)
+		if err != nil {
+			validations = append(validations, fmt.Sprintf("invalid end key %s: %s", keyRange.End, err.Error()))
+			continue
+		}
+
+		if !isKeyRangeIncluded(start, end, backupKeyRanges) {
+			validations = append(validations, fmt.Sprintf("key range %s - %s is not included in the backup", keyRange.Start, keyRange.End))
+		}
+
+		if !bytes.HasPrefix(start, removePrefix) {
+			validations = append(validations, fmt.Sprintf("key range %s - %s does not start with removePrefix %s", keyRange.Start, keyRange.End, restore.Spec.RemovePrefix))
+			continue
+		}
+
+		restoredEnd := append(append([]byte{}, addPrefix...), bytes.TrimPrefix(end, removePrefix)...)
+		if bytes.Compare(restoredEnd, []byte{0xff}) > 0 {
+			validations = append(validations, fmt.Sprintf("key range %s - %s is restored outside of the normal keyspace", keyRange.Start, keyRange.End))
+		}
+	}
+
+	if len(validations) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(validations, ", "))
+}
+
+// isKeyRangeIncluded returns true if the key range from start to end is included in one of the provided key ranges.
+func isKeyRangeIncluded(start []byte, end []byte, keyRanges []FoundationDBKeyRange) bool {
+	for _, keyRange := range keyRanges {
+		rangeStart, err := decodeKey(keyRange.Start)
+		if err != nil {
+			continue
+		}
+
+		rangeEnd, err := decodeKey(keyRange.End)
+		if err != nil {
+			continue
+		}
+
+		if bytes.Compare(rangeStart, start) <= 0 && bytes.Compare(end, rangeEnd) <= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getPrefixEnd returns the first key that is greater than all keys starting with the prefix.
+func getPrefixEnd(prefix []byte) []byte {
+	for idx := len(prefix) - 1; idx >= 0; idx-- {
+		if prefix[idx] == 0xff {
+			continue
+		}
+
+		result := make([]byte, idx+1)
+		copy(result, prefix)
+		result[idx]++
+
+		return result
+	}
+
+	return []byte{0xff}
+}
+
+// Validate checks that the start and end key of the key range can be decoded and that the start key is before the
+// end key.
+func (keyRange FoundationDBKeyRange) Validate() error {
+	start, err := decodeKey(keyRange.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start key %s: %w", keyRange.Start, err)
+	}
+
+	end, err := decodeKey(keyRange.End)
+	if err != nil {
+		return fmt.Errorf("invalid end key %s: %w", keyRange.End, err)
+	}
+
+	if bytes.Compare(start, end) >= 0 {
+		return fmt.Errorf("start key %s must be before end key %s", keyRange.Start, keyRange.End)
+	}
+
+	return nil
+}
+
+// decodeKey decodes a key that uses the `\xBB` escape sequences into its raw bytes.
+func decodeKey(key string) ([]byte, error) {
+	result := make([]byte, 0, len(key))
+
+	for idx := 0; idx < len(key); idx++ {
+		if key[idx] != '\\' {
+			result = append(result, key[idx])
+			continue
+		}
+
+		if idx+3 >= len(key) {
+			return nil, fmt.Errorf("incomplete escape sequence at position %d", idx)
+		}
+
+		if key[idx+1] != 'x' {
+			return nil, fmt.Errorf("unsupported escape sequence at position %d", idx)
+		}
+
+		decoded, err := hex.DecodeString(key[idx+2 : idx+4])
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, decoded...)
+		idx += 3
+	}
+
+	return result, nil
+}
@@ -0,0 +1,264 @@
+/*
+ * foundationdbrestore_webhook_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("[api] FoundationDBRestore webhook", func() {
+	When("validating a restore", func() {
+		DescribeTable("it should return if the restore is valid",
+			func(spec FoundationDBRestoreSpec, expectedErr string) {
+				restore := &FoundationDBRestore{Spec: spec}
+				err := restore.ValidateCreate()
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("valid restore",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: "a",
+							End:   "b",
+						},
+						{
+							Start: `\x00`,
+							End:   `\xff`,
+						},
+					},
+				},
+				"",
+			),
+			Entry("missing blob store configuration",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+				},
+				"blobStoreConfiguration must be defined",
+			),
+			Entry("missing account name",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{},
+				},
+				"blobStoreConfiguration.accountName must be defined",
+			),
+			Entry("invalid URL parameter",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName:   "account@minio",
+						URLParameters: []URLParameter{"secure_connection"},
+					},
+				},
+				"blobStoreConfiguration.urlParameters: secure_connection must be in the format key=value",
+			),
+			Entry("key range with start after end",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: "b",
+							End:   "a",
+						},
+					},
+				},
+				"keyRanges[0]: start key b must be before end key a",
+			),
+			Entry("key range with escaped start after end",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: `\xff`,
+							End:   "a",
+						},
+					},
+				},
+				`keyRanges[0]: start key \xff must be before end key a`,
+			),
+			Entry("key range with incomplete escape sequence",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: `\x0`,
+							End:   "a",
+						},
+					},
+				},
+				"incomplete escape sequence at position 0",
+			),
+			Entry("target version and target timestamp",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					TargetVersion:   pointer.Int64(1000),
+					TargetTimestamp: &metav1.Time{Time: time.Now()},
+				},
+				"only one of targetVersion and targetTimestamp can be defined",
+			),
+			Entry("valid add and remove prefix",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: "app/",
+							End:   "app0",
+						},
+						{
+							Start: "app/users",
+							End:   "app/users0",
+						},
+					},
+					AddPrefix:    "restored/",
+					RemovePrefix: "app/",
+				},
+				"",
+			),
+			Entry("remove prefix without key ranges",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					RemovePrefix: "app/",
+				},
+				"removePrefix requires keyRanges that start with the prefix",
+			),
+			Entry("key range outside of the remove prefix",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: "app/",
+							End:   "b",
+						},
+					},
+					RemovePrefix: "app/",
+				},
+				"keyRanges[0]: key range must start with removePrefix app/",
+			),
+			Entry("add prefix with incomplete escape sequence",
+				FoundationDBRestoreSpec{
+					DestinationClusterName: "test",
+					BlobStoreConfiguration: &BlobStoreConfiguration{
+						AccountName: "account@minio",
+					},
+					AddPrefix: `\xf`,
+				},
+				`invalid addPrefix \xf: incomplete escape sequence at position 0`,
+			),
+		)
+	})
+
+	When("validating the key ranges of a restore against the backup", func() {
+		DescribeTable("it should return if the key ranges are valid",
+			func(spec FoundationDBRestoreSpec, expectedErr string) {
+				restore := &FoundationDBRestore{Spec: spec}
+				backup := &FoundationDBBackup{}
+				err := restore.ValidateKeyRangesForBackup(backup.KeyRanges())
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("restore without key ranges",
+				FoundationDBRestoreSpec{},
+				"",
+			),
+			Entry("restore with prefixes",
+				FoundationDBRestoreSpec{
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: "app/",
+							End:   "app0",
+						},
+					},
+					AddPrefix:    "restored/",
+					RemovePrefix: "app/",
+				},
+				"",
+			),
+			Entry("key range in the system keyspace",
+				FoundationDBRestoreSpec{
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: `\xff\x02`,
+							End:   `\xff\x03`,
+						},
+					},
+				},
+				`key range \xff\x02 - \xff\x03 is not included in the backup`,
+			),
+			Entry("remove prefix without key ranges",
+				FoundationDBRestoreSpec{
+					RemovePrefix: "app/",
+				},
+				`key range  - \xff does not start with removePrefix app/`,
+			),
+			Entry("add prefix in the system keyspace",
+				FoundationDBRestoreSpec{
+					KeyRanges: []FoundationDBKeyRange{
+						{
+							Start: "a",
+							End:   "b",
+						},
+					},
+					AddPrefix: `\xff`,
+				},
+				"key range a - b is restored outside of the normal keyspace",
+			),
+		)
+	})
+})
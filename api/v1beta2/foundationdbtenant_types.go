@@ -0,0 +1,168 @@
+/*
+Copyright 2023 FoundationDB project authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=fdbtenant
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Generation",type="integer",JSONPath=".metadata.generation",description="Latest generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Reconciled",type="integer",JSONPath=".status.generations.reconciled",description="Last reconciled generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".status.tenantName",description="Name of the tenant in the database",priority=0
+// +kubebuilder:printcolumn:name="Created",type="boolean",JSONPath=".status.created",description="Whether the tenant exists in the database",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// FoundationDBTenant is the Schema for the foundationdbtenants API
+type FoundationDBTenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FoundationDBTenantSpec   `json:"spec,omitempty"`
+	Status FoundationDBTenantStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FoundationDBTenantList contains a list of FoundationDBTenant objects
+type FoundationDBTenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FoundationDBTenant `json:"items"`
+}
+
+// FoundationDBTenantSpec describes the desired state of a tenant.
+type FoundationDBTenantSpec struct {
+	// ClusterName defines the name of the FoundationDBCluster in the same
+	// namespace that should contain the tenant.
+	// +kubebuilder:validation:MaxLength=253
+	ClusterName string `json:"clusterName"`
+
+	// TenantName defines the name of the tenant in the database. The name
+	// can't be changed once the tenant is created.
+	// The default is the name of the FoundationDBTenant.
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9][a-zA-Z0-9_.\-]*$
+	TenantName string `json:"tenantName,omitempty"`
+
+	// ForceDeletion defines if the operator should clear all keys of the
+	// tenant when the FoundationDBTenant is deleted. If false, the operator
+	// will not delete a tenant that contains data and the FoundationDBTenant
+	// will be kept until the data is removed.
+	// +kubebuilder:default:=false
+	ForceDeletion *bool `json:"forceDeletion,omitempty"`
+}
+
+// FoundationDBTenantStatus describes the current status of a tenant.
+type FoundationDBTenantStatus struct {
+	// TenantName provides the name of the tenant in the database.
+	TenantName string `json:"tenantName,omitempty"`
+
+	// Created indicates whether the tenant exists in the database.
+	Created bool `json:"created,omitempty"`
+
+	// ID provides the ID of the tenant that was assigned by the database.
+	ID int64 `json:"id,omitempty"`
+
+	// Prefix provides the printable key prefix of the tenant.
+	Prefix string `json:"prefix,omitempty"`
+
+	// Generations provides information about the latest generation to be
+	// reconciled, or to reach other stages in reconciliation.
+	Generations TenantGenerationStatus `json:"generations,omitempty"`
+
+	// Conditions represents the latest available observations of the
+	// tenant's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// TenantGenerationStatus stores information on which generations have reached
+// different stages in reconciliation for the tenant.
+type TenantGenerationStatus struct {
+	// Reconciled provides the last generation that was fully reconciled.
+	Reconciled int64 `json:"reconciled,omitempty"`
+
+	// NeedsCreation provides the last generation that could not complete
+	// reconciliation because the tenant must be created.
+	NeedsCreation int64 `json:"needsCreation,omitempty"`
+}
+
+const (
+	// TenantConditionReconciled indicates that the operator has reconciled
+	// the latest generation of the tenant.
+	TenantConditionReconciled = "Reconciled"
+
+	// FoundationDBTenantFinalizer defines the finalizer that prevents the
+	// deletion of a FoundationDBTenant until the tenant is deleted in the
+	// database.
+	FoundationDBTenantFinalizer = "foundationdb.org/tenant"
+)
+
+// FoundationDBLiveTenantStatus describes the live status of a tenant, as
+// provided by fdbcli.
+type FoundationDBLiveTenantStatus struct {
+	// ID provides the ID of the tenant.
+	ID int64 `json:"id,omitempty"`
+
+	// Prefix provides the printable key prefix of the tenant.
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// GetTenantName returns the name of the tenant in the database.
+func (tenant *FoundationDBTenant) GetTenantName() string {
+	if tenant.Spec.TenantName == "" {
+		return tenant.Name
+	}
+
+	return tenant.Spec.TenantName
+}
+
+// ShouldForceDeletion returns true if the tenant should be deleted even if it
+// contains data.
+func (tenant *FoundationDBTenant) ShouldForceDeletion() bool {
+	return pointer.BoolDeref(tenant.Spec.ForceDeletion, false)
+}
+
+// CheckReconciliation compares the spec and the status to determine if
+// reconciliation is complete.
+func (tenant *FoundationDBTenant) CheckReconciliation() (bool, error) {
+	var reconciled = true
+
+	if !tenant.Status.Created || tenant.Status.TenantName != tenant.GetTenantName() {
+		tenant.Status.Generations.NeedsCreation = tenant.ObjectMeta.Generation
+		reconciled = false
+	}
+
+	if reconciled {
+		tenant.Status.Generations = TenantGenerationStatus{
+			Reconciled: tenant.ObjectMeta.Generation,
+		}
+	}
+
+	return reconciled, nil
+}
+
+func init() {
+	SchemeBuilder.Register(&FoundationDBTenant{}, &FoundationDBTenantList{})
+}
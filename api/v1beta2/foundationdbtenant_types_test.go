@@ -0,0 +1,107 @@
+/*
+ * foundationdbtenant_types_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("[api] FoundationDBTenant", func() {
+	var tenant *FoundationDBTenant
+
+	BeforeEach(func() {
+		tenant = &FoundationDBTenant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "sample-tenant",
+				Namespace:  "default",
+				Generation: 2,
+			},
+			Spec: FoundationDBTenantSpec{
+				ClusterName: "sample-cluster",
+			},
+		}
+	})
+
+	When("getting the tenant name", func() {
+		It("should default to the name of the resource", func() {
+			Expect(tenant.GetTenantName()).To(Equal("sample-tenant"))
+		})
+
+		When("a tenant name is defined", func() {
+			BeforeEach(func() {
+				tenant.Spec.TenantName = "app"
+			})
+
+			It("should return the tenant name", func() {
+				Expect(tenant.GetTenantName()).To(Equal("app"))
+			})
+		})
+	})
+
+	When("checking if the deletion should be forced", func() {
+		It("should not force the deletion by default", func() {
+			Expect(tenant.ShouldForceDeletion()).To(BeFalse())
+		})
+
+		When("the deletion is forced", func() {
+			BeforeEach(func() {
+				tenant.Spec.ForceDeletion = pointer.Bool(true)
+			})
+
+			It("should force the deletion", func() {
+				Expect(tenant.ShouldForceDeletion()).To(BeTrue())
+			})
+		})
+	})
+
+	When("checking the reconciliation", func() {
+		var reconciled bool
+
+		JustBeforeEach(func() {
+			var err error
+			reconciled, err = tenant.CheckReconciliation()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the tenant is created", func() {
+			BeforeEach(func() {
+				tenant.Status.Created = true
+				tenant.Status.TenantName = "sample-tenant"
+				tenant.Status.Generations.NeedsCreation = 1
+			})
+
+			It("should mark the tenant as reconciled", func() {
+				Expect(reconciled).To(BeTrue())
+				Expect(tenant.Status.Generations).To(Equal(TenantGenerationStatus{Reconciled: 2}))
+			})
+		})
+
+		When("the tenant is not created", func() {
+			It("should need a creation", func() {
+				Expect(reconciled).To(BeFalse())
+				Expect(tenant.Status.Generations).To(Equal(TenantGenerationStatus{NeedsCreation: 2}))
+			})
+		})
+	})
+})
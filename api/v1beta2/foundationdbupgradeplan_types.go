@@ -0,0 +1,226 @@
+/*
+Copyright 2023 FoundationDB project authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=fdbupgradeplan
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="Target version",priority=0
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Phase of the upgrade plan",priority=0
+// +kubebuilder:printcolumn:name="Wave",type="string",JSONPath=".status.currentWave",description="Wave that is currently upgraded",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+
+// FoundationDBUpgradePlan is the Schema for the foundationdbupgradeplans API
+type FoundationDBUpgradePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FoundationDBUpgradePlanSpec   `json:"spec,omitempty"`
+	Status FoundationDBUpgradePlanStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FoundationDBUpgradePlanList contains a list of FoundationDBUpgradePlan objects
+type FoundationDBUpgradePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FoundationDBUpgradePlan `json:"items"`
+}
+
+// FoundationDBUpgradePlanSpec describes the desired upgrade of a set of clusters.
+type FoundationDBUpgradePlanSpec struct {
+	// Version defines the version of FoundationDB the selected clusters should
+	// be upgraded to.
+	// +kubebuilder:validation:Pattern:=(\d+)\.(\d+)\.(\d+)
+	Version string `json:"version"`
+
+	// ClusterSelector selects the clusters in the namespace of the upgrade
+	// plan that should be upgraded.
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector"`
+
+	// Waves defines the ordered waves in which the selected clusters will be
+	// upgraded. A wave is only started once all clusters of the previous waves
+	// are upgraded. Each cluster is assigned to the first wave that selects it,
+	// clusters that are not selected by any wave will not be upgraded. If no
+	// waves are defined all selected clusters will be upgraded in a single
+	// wave, one cluster at a time.
+	// +kubebuilder:validation:MaxItems=100
+	Waves []UpgradeWave `json:"waves,omitempty"`
+}
+
+// UpgradeWave defines a set of clusters that will be upgraded together.
+type UpgradeWave struct {
+	// Name defines the name of the wave.
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// ClusterSelector selects the clusters of this wave. If no selector is
+	// defined, all remaining clusters will be part of this wave.
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// MaxConcurrency defines how many clusters of this wave will be upgraded
+	// at the same time.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+}
+
+// FoundationDBUpgradePlanStatus describes the current status of the upgrade plan.
+type FoundationDBUpgradePlanStatus struct {
+	// Phase describes the current phase of the upgrade plan.
+	Phase UpgradePlanPhase `json:"phase,omitempty"`
+
+	// CurrentWave provides the name of the wave that is currently upgraded.
+	CurrentWave string `json:"currentWave,omitempty"`
+
+	// Clusters provides the upgrade state of the selected clusters.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Clusters []UpgradePlanClusterStatus `json:"clusters,omitempty"`
+
+	// Conditions represents the latest available observations of the
+	// upgrade plan's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// UpgradePlanClusterStatus describes the upgrade state of a single cluster.
+type UpgradePlanClusterStatus struct {
+	// Name provides the name of the cluster.
+	Name string `json:"name"`
+
+	// Wave provides the name of the wave the cluster is assigned to.
+	Wave string `json:"wave,omitempty"`
+
+	// State describes the upgrade state of the cluster.
+	State UpgradePlanClusterState `json:"state,omitempty"`
+
+	// PreviousVersion provides the version the cluster was running before
+	// the upgrade was started.
+	PreviousVersion string `json:"previousVersion,omitempty"`
+
+	// StartTime provides the timestamp when the upgrade of the cluster was
+	// started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Message provides additional information about the state, e.g. the
+	// reason why the preflight checks failed.
+	Message string `json:"message,omitempty"`
+}
+
+// UpgradePlanPhase describes the phase of an upgrade plan.
+// +kubebuilder:validation:MaxLength=64
+type UpgradePlanPhase string
+
+const (
+	// UpgradePlanPhasePending indicates that no cluster upgrade was started yet.
+	UpgradePlanPhasePending UpgradePlanPhase = "Pending"
+	// UpgradePlanPhaseInProgress indicates that the clusters are being upgraded.
+	UpgradePlanPhaseInProgress UpgradePlanPhase = "InProgress"
+	// UpgradePlanPhaseCompleted indicates that all selected clusters are upgraded.
+	UpgradePlanPhaseCompleted UpgradePlanPhase = "Completed"
+	// UpgradePlanPhaseFailed indicates that the upgrade of at least one cluster
+	// failed. No further upgrades will be started until the failure is resolved.
+	UpgradePlanPhaseFailed UpgradePlanPhase = "Failed"
+)
+
+// UpgradePlanClusterState describes the upgrade state of a cluster in an upgrade plan.
+// +kubebuilder:validation:MaxLength=64
+type UpgradePlanClusterState string
+
+const (
+	// UpgradePlanClusterStatePending indicates that the upgrade of the cluster was not started yet.
+	UpgradePlanClusterStatePending UpgradePlanClusterState = "Pending"
+	// UpgradePlanClusterStatePreflightFailed indicates that the preflight checks for the cluster failed. The preflight
+	// checks will be retried.
+	UpgradePlanClusterStatePreflightFailed UpgradePlanClusterState = "PreflightFailed"
+	// UpgradePlanClusterStateUpgrading indicates that the version of the cluster was changed and the operator is
+	// upgrading the cluster.
+	UpgradePlanClusterStateUpgrading UpgradePlanClusterState = "Upgrading"
+	// UpgradePlanClusterStateUpgraded indicates that the cluster is running the target version.
+	UpgradePlanClusterStateUpgraded UpgradePlanClusterState = "Upgraded"
+	// UpgradePlanClusterStateFailed indicates that the upgrade of the cluster was rolled back.
+	UpgradePlanClusterStateFailed UpgradePlanClusterState = "Failed"
+)
+
+const (
+	// UpgradePlanConditionReconciled indicates that the operator has reconciled
+	// the latest generation of the upgrade plan.
+	UpgradePlanConditionReconciled = "Reconciled"
+)
+
+// DefaultUpgradeWaveName is the name of the wave that is used if no waves are defined.
+const DefaultUpgradeWaveName = "default"
+
+// GetWaves returns the waves of the upgrade plan. If no waves are defined a single wave selecting all clusters will
+// be returned.
+func (plan *FoundationDBUpgradePlan) GetWaves() []UpgradeWave {
+	if len(plan.Spec.Waves) == 0 {
+		return []UpgradeWave{{Name: DefaultUpgradeWaveName}}
+	}
+
+	return plan.Spec.Waves
+}
+
+// GetMaxConcurrency returns the number of clusters of this wave that will be upgraded at the same time.
+func (wave UpgradeWave) GetMaxConcurrency() int {
+	if wave.MaxConcurrency == nil {
+		return 1
+	}
+
+	return *wave.MaxConcurrency
+}
+
+// Matches returns true if the wave selects a cluster with the provided labels.
+func (wave UpgradeWave) Matches(clusterLabels map[string]string) (bool, error) {
+	if wave.ClusterSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(wave.ClusterSelector)
+	if err != nil {
+		return false, err
+	}
+
+	return selector.Matches(labels.Set(clusterLabels)), nil
+}
+
+// GetClusterStatus returns the status of the cluster with the provided name or nil if the cluster is not part of
+// the upgrade plan.
+func (plan *FoundationDBUpgradePlan) GetClusterStatus(name string) *UpgradePlanClusterStatus {
+	for idx := range plan.Status.Clusters {
+		if plan.Status.Clusters[idx].Name == name {
+			return &plan.Status.Clusters[idx]
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	SchemeBuilder.Register(&FoundationDBUpgradePlan{}, &FoundationDBUpgradePlanList{})
+}
@@ -0,0 +1,70 @@
+/*
+ * foundationdbupgradeplan_types_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("[api] FoundationDBUpgradePlan", func() {
+	When("getting the waves", func() {
+		It("should return a default wave if no waves are defined", func() {
+			plan := FoundationDBUpgradePlan{}
+			Expect(plan.GetWaves()).To(Equal([]UpgradeWave{{Name: DefaultUpgradeWaveName}}))
+			Expect(plan.GetWaves()[0].GetMaxConcurrency()).To(Equal(1))
+		})
+
+		It("should return the defined waves", func() {
+			plan := FoundationDBUpgradePlan{
+				Spec: FoundationDBUpgradePlanSpec{
+					Waves: []UpgradeWave{{Name: "first", MaxConcurrency: pointer.Int(3)}},
+				},
+			}
+			Expect(plan.GetWaves()).To(HaveLen(1))
+			Expect(plan.GetWaves()[0].GetMaxConcurrency()).To(Equal(3))
+		})
+	})
+
+	When("checking if a wave selects a cluster", func() {
+		DescribeTable("should return the expected result",
+			func(wave UpgradeWave, clusterLabels map[string]string, expected bool) {
+				matches, err := wave.Matches(clusterLabels)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matches).To(Equal(expected))
+			},
+			Entry("wave without a selector",
+				UpgradeWave{Name: "all"},
+				map[string]string{"team": "storage"},
+				true),
+			Entry("wave with a matching selector",
+				UpgradeWave{Name: "canary", ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+				map[string]string{"canary": "true", "team": "storage"},
+				true),
+			Entry("wave with a selector that doesn't match",
+				UpgradeWave{Name: "canary", ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+				map[string]string{"team": "storage"},
+				false),
+		)
+	})
+})
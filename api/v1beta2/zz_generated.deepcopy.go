@@ -75,6 +75,113 @@ func (in *AutomaticReplacementOptions) DeepCopy() *AutomaticReplacementOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupAgentAutoscalingOptions) DeepCopyInto(out *BackupAgentAutoscalingOptions) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinimumAgentCount != nil {
+		in, out := &in.MinimumAgentCount, &out.MinimumAgentCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaximumAgentCount != nil {
+		in, out := &in.MaximumAgentCount, &out.MaximumAgentCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleUpLagSeconds != nil {
+		in, out := &in.ScaleUpLagSeconds, &out.ScaleUpLagSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleDownLagSeconds != nil {
+		in, out := &in.ScaleDownLagSeconds, &out.ScaleDownLagSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.BytesPerSecondPerAgent != nil {
+		in, out := &in.BytesPerSecondPerAgent, &out.BytesPerSecondPerAgent
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleUpCooldownSeconds != nil {
+		in, out := &in.ScaleUpCooldownSeconds, &out.ScaleUpCooldownSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleDownCooldownSeconds != nil {
+		in, out := &in.ScaleDownCooldownSeconds, &out.ScaleDownCooldownSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupAgentAutoscalingOptions.
+func (in *BackupAgentAutoscalingOptions) DeepCopy() *BackupAgentAutoscalingOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupAgentAutoscalingOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupAgentAutoscalingStatus) DeepCopyInto(out *BackupAgentAutoscalingStatus) {
+	*out = *in
+	if in.LastSampleTime != nil {
+		in, out := &in.LastSampleTime, &out.LastSampleTime
+		*out = new(v1.Time)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = new(v1.Time)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupAgentAutoscalingStatus.
+func (in *BackupAgentAutoscalingStatus) DeepCopy() *BackupAgentAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupAgentAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExpiryStatus) DeepCopyInto(out *BackupExpiryStatus) {
+	*out = *in
+	if in.LastExpiryTime != nil {
+		in, out := &in.LastExpiryTime, &out.LastExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MinRestorableTime != nil {
+		in, out := &in.MinRestorableTime, &out.MinRestorableTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxRestorableTime != nil {
+		in, out := &in.MaxRestorableTime, &out.MaxRestorableTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExpiryStatus.
+func (in *BackupExpiryStatus) DeepCopy() *BackupExpiryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExpiryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupGenerationStatus) DeepCopyInto(out *BackupGenerationStatus) {
 	*out = *in
@@ -90,6 +197,36 @@ func (in *BackupGenerationStatus) DeepCopy() *BackupGenerationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	if in.MinRestorableDays != nil {
+		in, out := &in.MinRestorableDays, &out.MinRestorableDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeleteBeforeDays != nil {
+		in, out := &in.DeleteBeforeDays, &out.DeleteBeforeDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.ExpiryIntervalSeconds != nil {
+		in, out := &in.ExpiryIntervalSeconds, &out.ExpiryIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlobStoreConfiguration) DeepCopyInto(out *BlobStoreConfiguration) {
 	*out = *in
@@ -110,6 +247,25 @@ func (in *BlobStoreConfiguration) DeepCopy() *BlobStoreConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockingReconcilerInfo) DeepCopyInto(out *BlockingReconcilerInfo) {
+	*out = *in
+	if in.FirstSeen != nil {
+		in, out := &in.FirstSeen, &out.FirstSeen
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockingReconcilerInfo.
+func (in *BlockingReconcilerInfo) DeepCopy() *BlockingReconcilerInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockingReconcilerInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuggifyConfig) DeepCopyInto(out *BuggifyConfig) {
 	*out = *in
@@ -152,6 +308,65 @@ func (in *BuggifyConfig) DeepCopy() *BuggifyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryOptions) DeepCopyInto(out *CanaryOptions) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProcessGroupCount != nil {
+		in, out := &in.ProcessGroupCount, &out.ProcessGroupCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.SoakTimeSeconds != nil {
+		in, out := &in.SoakTimeSeconds, &out.SoakTimeSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.ManualPromotion != nil {
+		in, out := &in.ManualPromotion, &out.ManualPromotion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryOptions.
+func (in *CanaryOptions) DeepCopy() *CanaryOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	if in.ProcessGroups != nil {
+		in, out := &in.ProcessGroups, &out.ProcessGroups
+		*out = make([]ProcessGroupID, len(*in))
+		copy(*out, *in)
+	}
+	if in.SoakStartTime != nil {
+		in, out := &in.SoakStartTime, &out.SoakStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterGenerationStatus) DeepCopyInto(out *ClusterGenerationStatus) {
 	*out = *in
@@ -297,6 +512,31 @@ func (in *DatabaseConfiguration) DeepCopyInto(out *DatabaseConfiguration) {
 		*out = make([]ExcludedServers, len(*in))
 		copy(*out, *in)
 	}
+	if in.PerpetualStorageWiggle != nil {
+		in, out := &in.PerpetualStorageWiggle, &out.PerpetualStorageWiggle
+		*out = new(int)
+		**out = **in
+	}
+	if in.PerpetualStorageWiggleLocality != nil {
+		in, out := &in.PerpetualStorageWiggleLocality, &out.PerpetualStorageWiggleLocality
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageMigrationType != nil {
+		in, out := &in.StorageMigrationType, &out.StorageMigrationType
+		*out = new(StorageMigrationType)
+		**out = **in
+	}
+	if in.PerpetualStorageWiggleEngine != nil {
+		in, out := &in.PerpetualStorageWiggleEngine, &out.PerpetualStorageWiggleEngine
+		*out = new(StorageEngine)
+		**out = **in
+	}
+	if in.TenantMode != nil {
+		in, out := &in.TenantMode, &out.TenantMode
+		*out = new(TenantMode)
+		**out = **in
+	}
 	out.RoleCounts = in.RoleCounts
 	out.VersionFlags = in.VersionFlags
 }
@@ -311,6 +551,36 @@ func (in *DatabaseConfiguration) DeepCopy() *DatabaseConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisasterRecoveryClusterReference) DeepCopyInto(out *DisasterRecoveryClusterReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryClusterReference.
+func (in *DisasterRecoveryClusterReference) DeepCopy() *DisasterRecoveryClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DisasterRecoveryClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisasterRecoveryGenerationStatus) DeepCopyInto(out *DisasterRecoveryGenerationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisasterRecoveryGenerationStatus.
+func (in *DisasterRecoveryGenerationStatus) DeepCopy() *DisasterRecoveryGenerationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DisasterRecoveryGenerationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExcludedServers) DeepCopyInto(out *ExcludedServers) {
 	*out = *in
@@ -368,6 +638,31 @@ func (in *FoundationDBBackup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBBackupDescription) DeepCopyInto(out *FoundationDBBackupDescription) {
+	*out = *in
+	if in.MinRestorablePoint != nil {
+		in, out := &in.MinRestorablePoint, &out.MinRestorablePoint
+		*out = new(FoundationDBBackupRestorablePoint)
+		**out = **in
+	}
+	if in.MaxRestorablePoint != nil {
+		in, out := &in.MaxRestorablePoint, &out.MaxRestorablePoint
+		*out = new(FoundationDBBackupRestorablePoint)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBBackupDescription.
+func (in *FoundationDBBackupDescription) DeepCopy() *FoundationDBBackupDescription {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBBackupDescription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FoundationDBBackupList) DeepCopyInto(out *FoundationDBBackupList) {
 	*out = *in
@@ -400,6 +695,21 @@ func (in *FoundationDBBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBBackupRestorablePoint) DeepCopyInto(out *FoundationDBBackupRestorablePoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBBackupRestorablePoint.
+func (in *FoundationDBBackupRestorablePoint) DeepCopy() *FoundationDBBackupRestorablePoint {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBBackupRestorablePoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FoundationDBBackupSpec) DeepCopyInto(out *FoundationDBBackupSpec) {
 	*out = *in
@@ -440,6 +750,12 @@ func (in *FoundationDBBackupSpec) DeepCopyInto(out *FoundationDBBackupSpec) {
 	}
 	in.MainContainer.DeepCopyInto(&out.MainContainer)
 	in.SidecarContainer.DeepCopyInto(&out.SidecarContainer)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	in.AgentAutoscaling.DeepCopyInto(&out.AgentAutoscaling)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBBackupSpec.
@@ -461,6 +777,23 @@ func (in *FoundationDBBackupStatus) DeepCopyInto(out *FoundationDBBackupStatus)
 		**out = **in
 	}
 	out.Generations = in.Generations
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = new(BackupExpiryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentAutoscaling != nil {
+		in, out := &in.AgentAutoscaling, &out.AgentAutoscaling
+		*out = new(BackupAgentAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBBackupStatus.
@@ -600,6 +933,18 @@ func (in *FoundationDBClusterAutomationOptions) DeepCopyInto(out *FoundationDBCl
 		*out = make([]LogGroup, len(*in))
 		copy(*out, *in)
 	}
+	if in.SuspendedSubReconcilers != nil {
+		in, out := &in.SuspendedSubReconcilers, &out.SuspendedSubReconcilers
+		*out = make([]SubReconcilerName, len(*in))
+		copy(*out, *in)
+	}
+	in.MaintenanceWindowOptions.DeepCopyInto(&out.MaintenanceWindowOptions)
+	in.CanaryOptions.DeepCopyInto(&out.CanaryOptions)
+	in.UpgradeRollbackOptions.DeepCopyInto(&out.UpgradeRollbackOptions)
+	in.RegionFailoverOptions.DeepCopyInto(&out.RegionFailoverOptions)
+	in.StorageEngineMigrationOptions.DeepCopyInto(&out.StorageEngineMigrationOptions)
+	in.StorageAutoscalingOptions.DeepCopyInto(&out.StorageAutoscalingOptions)
+	in.StatelessAutoscalingOptions.DeepCopyInto(&out.StatelessAutoscalingOptions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBClusterAutomationOptions.
@@ -777,6 +1122,75 @@ func (in *FoundationDBClusterStatus) DeepCopyInto(out *FoundationDBClusterStatus
 	}
 	in.Locks.DeepCopyInto(&out.Locks)
 	in.MaintenanceModeInfo.DeepCopyInto(&out.MaintenanceModeInfo)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlockingReconciler != nil {
+		in, out := &in.BlockingReconciler, &out.BlockingReconciler
+		*out = new(BlockingReconcilerInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuspendedSubReconcilers != nil {
+		in, out := &in.SuspendedSubReconcilers, &out.SuspendedSubReconcilers
+		*out = make([]SubReconcilerName, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeRollback != nil {
+		in, out := &in.UpgradeRollback, &out.UpgradeRollback
+		*out = new(UpgradeRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegionFailover != nil {
+		in, out := &in.RegionFailover, &out.RegionFailover
+		*out = new(RegionFailoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageWiggle != nil {
+		in, out := &in.StorageWiggle, &out.StorageWiggle
+		*out = new(StorageWiggleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageEngineMigration != nil {
+		in, out := &in.StorageEngineMigration, &out.StorageEngineMigration
+		*out = new(StorageEngineMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageAutoscaling != nil {
+		in, out := &in.StorageAutoscaling, &out.StorageAutoscaling
+		*out = new(StorageAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatelessAutoscaling != nil {
+		in, out := &in.StatelessAutoscaling, &out.StatelessAutoscaling
+		*out = new(StatelessAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Knobs != nil {
+		in, out := &in.Knobs, &out.Knobs
+		*out = make([]ProcessClassKnobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBClusterStatus.
@@ -809,72 +1223,26 @@ func (in FoundationDBCustomParameters) DeepCopy() FoundationDBCustomParameters {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBKeyRange) DeepCopyInto(out *FoundationDBKeyRange) {
+func (in *FoundationDBDisasterRecovery) DeepCopyInto(out *FoundationDBDisasterRecovery) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBKeyRange.
-func (in *FoundationDBKeyRange) DeepCopy() *FoundationDBKeyRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBDisasterRecovery.
+func (in *FoundationDBDisasterRecovery) DeepCopy() *FoundationDBDisasterRecovery {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBKeyRange)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBLiveBackupStatus) DeepCopyInto(out *FoundationDBLiveBackupStatus) {
-	*out = *in
-	out.Status = in.Status
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveBackupStatus.
-func (in *FoundationDBLiveBackupStatus) DeepCopy() *FoundationDBLiveBackupStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(FoundationDBLiveBackupStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBLiveBackupStatusState) DeepCopyInto(out *FoundationDBLiveBackupStatusState) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveBackupStatusState.
-func (in *FoundationDBLiveBackupStatusState) DeepCopy() *FoundationDBLiveBackupStatusState {
-	if in == nil {
-		return nil
-	}
-	out := new(FoundationDBLiveBackupStatusState)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBRestore) DeepCopyInto(out *FoundationDBRestore) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestore.
-func (in *FoundationDBRestore) DeepCopy() *FoundationDBRestore {
-	if in == nil {
-		return nil
-	}
-	out := new(FoundationDBRestore)
+	out := new(FoundationDBDisasterRecovery)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FoundationDBRestore) DeepCopyObject() runtime.Object {
+func (in *FoundationDBDisasterRecovery) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -882,31 +1250,31 @@ func (in *FoundationDBRestore) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBRestoreList) DeepCopyInto(out *FoundationDBRestoreList) {
+func (in *FoundationDBDisasterRecoveryList) DeepCopyInto(out *FoundationDBDisasterRecoveryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]FoundationDBRestore, len(*in))
+		*out = make([]FoundationDBDisasterRecovery, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestoreList.
-func (in *FoundationDBRestoreList) DeepCopy() *FoundationDBRestoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBDisasterRecoveryList.
+func (in *FoundationDBDisasterRecoveryList) DeepCopy() *FoundationDBDisasterRecoveryList {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBRestoreList)
+	out := new(FoundationDBDisasterRecoveryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FoundationDBRestoreList) DeepCopyObject() runtime.Object {
+func (in *FoundationDBDisasterRecoveryList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -914,16 +1282,23 @@ func (in *FoundationDBRestoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBRestoreSpec) DeepCopyInto(out *FoundationDBRestoreSpec) {
+func (in *FoundationDBDisasterRecoverySpec) DeepCopyInto(out *FoundationDBDisasterRecoverySpec) {
 	*out = *in
-	if in.KeyRanges != nil {
-		in, out := &in.KeyRanges, &out.KeyRanges
-		*out = make([]FoundationDBKeyRange, len(*in))
-		copy(*out, *in)
+	out.SourceCluster = in.SourceCluster
+	out.DestinationCluster = in.DestinationCluster
+	if in.AgentCount != nil {
+		in, out := &in.AgentCount, &out.AgentCount
+		*out = new(int)
+		**out = **in
 	}
-	if in.BlobStoreConfiguration != nil {
-		in, out := &in.BlobStoreConfiguration, &out.BlobStoreConfiguration
-		*out = new(BlobStoreConfiguration)
+	if in.AgentDeploymentMetadata != nil {
+		in, out := &in.AgentDeploymentMetadata, &out.AgentDeploymentMetadata
+		*out = new(v1.ObjectMeta)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodTemplateSpec != nil {
+		in, out := &in.PodTemplateSpec, &out.PodTemplateSpec
+		*out = new(corev1.PodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CustomParameters != nil {
@@ -931,948 +1306,2424 @@ func (in *FoundationDBRestoreSpec) DeepCopyInto(out *FoundationDBRestoreSpec) {
 		*out = make(FoundationDBCustomParameters, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowTagOverride != nil {
+		in, out := &in.AllowTagOverride, &out.AllowTagOverride
+		*out = new(bool)
+		**out = **in
+	}
+	in.MainContainer.DeepCopyInto(&out.MainContainer)
+	in.SidecarContainer.DeepCopyInto(&out.SidecarContainer)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestoreSpec.
-func (in *FoundationDBRestoreSpec) DeepCopy() *FoundationDBRestoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBDisasterRecoverySpec.
+func (in *FoundationDBDisasterRecoverySpec) DeepCopy() *FoundationDBDisasterRecoverySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBRestoreSpec)
+	out := new(FoundationDBDisasterRecoverySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBRestoreStatus) DeepCopyInto(out *FoundationDBRestoreStatus) {
+func (in *FoundationDBDisasterRecoveryStatus) DeepCopyInto(out *FoundationDBDisasterRecoveryStatus) {
 	*out = *in
+	if in.DRDetails != nil {
+		in, out := &in.DRDetails, &out.DRDetails
+		*out = new(FoundationDBDisasterRecoveryStatusDetails)
+		**out = **in
+	}
+	out.Generations = in.Generations
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestoreStatus.
-func (in *FoundationDBRestoreStatus) DeepCopy() *FoundationDBRestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBDisasterRecoveryStatus.
+func (in *FoundationDBDisasterRecoveryStatus) DeepCopy() *FoundationDBDisasterRecoveryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBRestoreStatus)
+	out := new(FoundationDBDisasterRecoveryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatus) DeepCopyInto(out *FoundationDBStatus) {
+func (in *FoundationDBDisasterRecoveryStatusDetails) DeepCopyInto(out *FoundationDBDisasterRecoveryStatusDetails) {
 	*out = *in
-	in.Client.DeepCopyInto(&out.Client)
-	in.Cluster.DeepCopyInto(&out.Cluster)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatus.
-func (in *FoundationDBStatus) DeepCopy() *FoundationDBStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBDisasterRecoveryStatusDetails.
+func (in *FoundationDBDisasterRecoveryStatusDetails) DeepCopy() *FoundationDBDisasterRecoveryStatusDetails {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatus)
+	out := new(FoundationDBDisasterRecoveryStatusDetails)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusBackupInfo) DeepCopyInto(out *FoundationDBStatusBackupInfo) {
+func (in *FoundationDBKeyRange) DeepCopyInto(out *FoundationDBKeyRange) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]FoundationDBStatusBackupTag, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusBackupInfo.
-func (in *FoundationDBStatusBackupInfo) DeepCopy() *FoundationDBStatusBackupInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBKeyRange.
+func (in *FoundationDBKeyRange) DeepCopy() *FoundationDBKeyRange {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusBackupInfo)
+	out := new(FoundationDBKeyRange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusBackupTag) DeepCopyInto(out *FoundationDBStatusBackupTag) {
+func (in *FoundationDBKnob) DeepCopyInto(out *FoundationDBKnob) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusBackupTag.
-func (in *FoundationDBStatusBackupTag) DeepCopy() *FoundationDBStatusBackupTag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBKnob.
+func (in *FoundationDBKnob) DeepCopy() *FoundationDBKnob {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusBackupTag)
+	out := new(FoundationDBKnob)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusClientDBStatus) DeepCopyInto(out *FoundationDBStatusClientDBStatus) {
+func (in *FoundationDBKnobStatus) DeepCopyInto(out *FoundationDBKnobStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusClientDBStatus.
-func (in *FoundationDBStatusClientDBStatus) DeepCopy() *FoundationDBStatusClientDBStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBKnobStatus.
+func (in *FoundationDBKnobStatus) DeepCopy() *FoundationDBKnobStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusClientDBStatus)
+	out := new(FoundationDBKnobStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusClusterClientInfo) DeepCopyInto(out *FoundationDBStatusClusterClientInfo) {
+func (in *FoundationDBLiveBackupRestorablePoint) DeepCopyInto(out *FoundationDBLiveBackupRestorablePoint) {
 	*out = *in
-	if in.SupportedVersions != nil {
-		in, out := &in.SupportedVersions, &out.SupportedVersions
-		*out = make([]FoundationDBStatusSupportedVersion, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusClusterClientInfo.
-func (in *FoundationDBStatusClusterClientInfo) DeepCopy() *FoundationDBStatusClusterClientInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveBackupRestorablePoint.
+func (in *FoundationDBLiveBackupRestorablePoint) DeepCopy() *FoundationDBLiveBackupRestorablePoint {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusClusterClientInfo)
+	out := new(FoundationDBLiveBackupRestorablePoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusClusterInfo) DeepCopyInto(out *FoundationDBStatusClusterInfo) {
+func (in *FoundationDBLiveBackupStatus) DeepCopyInto(out *FoundationDBLiveBackupStatus) {
 	*out = *in
-	in.DatabaseConfiguration.DeepCopyInto(&out.DatabaseConfiguration)
-	if in.Processes != nil {
-		in, out := &in.Processes, &out.Processes
-		*out = make(map[ProcessGroupID]FoundationDBStatusProcessInfo, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
-	in.Data.DeepCopyInto(&out.Data)
-	in.Clients.DeepCopyInto(&out.Clients)
-	in.Layers.DeepCopyInto(&out.Layers)
-	if in.Logs != nil {
-		in, out := &in.Logs, &out.Logs
-		*out = make([]FoundationDBStatusLogInfo, len(*in))
-		copy(*out, *in)
-	}
-	out.Qos = in.Qos
-	out.FaultTolerance = in.FaultTolerance
-	if in.IncompatibleConnections != nil {
-		in, out := &in.IncompatibleConnections, &out.IncompatibleConnections
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	out.RecoveryState = in.RecoveryState
-	if in.Messages != nil {
-		in, out := &in.Messages, &out.Messages
-		*out = make([]FoundationDBStatusMessage, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.Status = in.Status
+	if in.LatestRestorablePoint != nil {
+		in, out := &in.LatestRestorablePoint, &out.LatestRestorablePoint
+		*out = new(FoundationDBLiveBackupRestorablePoint)
+		**out = **in
 	}
-	in.BounceImpact.DeepCopyInto(&out.BounceImpact)
+	out.LogBytes = in.LogBytes
+	out.RangeBytes = in.RangeBytes
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusClusterInfo.
-func (in *FoundationDBStatusClusterInfo) DeepCopy() *FoundationDBStatusClusterInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveBackupStatus.
+func (in *FoundationDBLiveBackupStatus) DeepCopy() *FoundationDBLiveBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusClusterInfo)
+	out := new(FoundationDBLiveBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusConnectedClient) DeepCopyInto(out *FoundationDBStatusConnectedClient) {
+func (in *FoundationDBLiveBackupStatusBytes) DeepCopyInto(out *FoundationDBLiveBackupStatusBytes) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusConnectedClient.
-func (in *FoundationDBStatusConnectedClient) DeepCopy() *FoundationDBStatusConnectedClient {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveBackupStatusBytes.
+func (in *FoundationDBLiveBackupStatusBytes) DeepCopy() *FoundationDBLiveBackupStatusBytes {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusConnectedClient)
+	out := new(FoundationDBLiveBackupStatusBytes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusCoordinator) DeepCopyInto(out *FoundationDBStatusCoordinator) {
+func (in *FoundationDBLiveBackupStatusState) DeepCopyInto(out *FoundationDBLiveBackupStatusState) {
 	*out = *in
-	in.Address.DeepCopyInto(&out.Address)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusCoordinator.
-func (in *FoundationDBStatusCoordinator) DeepCopy() *FoundationDBStatusCoordinator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveBackupStatusState.
+func (in *FoundationDBLiveBackupStatusState) DeepCopy() *FoundationDBLiveBackupStatusState {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusCoordinator)
+	out := new(FoundationDBLiveBackupStatusState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusCoordinatorInfo) DeepCopyInto(out *FoundationDBStatusCoordinatorInfo) {
+func (in *FoundationDBLiveDisasterRecoveryStatus) DeepCopyInto(out *FoundationDBLiveDisasterRecoveryStatus) {
 	*out = *in
-	if in.Coordinators != nil {
-		in, out := &in.Coordinators, &out.Coordinators
-		*out = make([]FoundationDBStatusCoordinator, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusCoordinatorInfo.
-func (in *FoundationDBStatusCoordinatorInfo) DeepCopy() *FoundationDBStatusCoordinatorInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveDisasterRecoveryStatus.
+func (in *FoundationDBLiveDisasterRecoveryStatus) DeepCopy() *FoundationDBLiveDisasterRecoveryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusCoordinatorInfo)
+	out := new(FoundationDBLiveDisasterRecoveryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusDataState) DeepCopyInto(out *FoundationDBStatusDataState) {
+func (in *FoundationDBLiveRestoreStatus) DeepCopyInto(out *FoundationDBLiveRestoreStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusDataState.
-func (in *FoundationDBStatusDataState) DeepCopy() *FoundationDBStatusDataState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveRestoreStatus.
+func (in *FoundationDBLiveRestoreStatus) DeepCopy() *FoundationDBLiveRestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusDataState)
+	out := new(FoundationDBLiveRestoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusDataStatistics) DeepCopyInto(out *FoundationDBStatusDataStatistics) {
+func (in *FoundationDBLiveTenantStatus) DeepCopyInto(out *FoundationDBLiveTenantStatus) {
 	*out = *in
-	out.MovingData = in.MovingData
-	out.State = in.State
-	if in.TeamTrackers != nil {
-		in, out := &in.TeamTrackers, &out.TeamTrackers
-		*out = make([]FoundationDBStatusTeamTracker, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusDataStatistics.
-func (in *FoundationDBStatusDataStatistics) DeepCopy() *FoundationDBStatusDataStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBLiveTenantStatus.
+func (in *FoundationDBLiveTenantStatus) DeepCopy() *FoundationDBLiveTenantStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusDataStatistics)
+	out := new(FoundationDBLiveTenantStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusLagInfo) DeepCopyInto(out *FoundationDBStatusLagInfo) {
+func (in *FoundationDBMultiRegionCluster) DeepCopyInto(out *FoundationDBMultiRegionCluster) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLagInfo.
-func (in *FoundationDBStatusLagInfo) DeepCopy() *FoundationDBStatusLagInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBMultiRegionCluster.
+func (in *FoundationDBMultiRegionCluster) DeepCopy() *FoundationDBMultiRegionCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusLagInfo)
+	out := new(FoundationDBMultiRegionCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusLayerInfo) DeepCopyInto(out *FoundationDBStatusLayerInfo) {
-	*out = *in
-	in.Backup.DeepCopyInto(&out.Backup)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLayerInfo.
-func (in *FoundationDBStatusLayerInfo) DeepCopy() *FoundationDBStatusLayerInfo {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBMultiRegionCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(FoundationDBStatusLayerInfo)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusLocalClientInfo) DeepCopyInto(out *FoundationDBStatusLocalClientInfo) {
+func (in *FoundationDBMultiRegionClusterList) DeepCopyInto(out *FoundationDBMultiRegionClusterList) {
 	*out = *in
-	in.Coordinators.DeepCopyInto(&out.Coordinators)
-	out.DatabaseStatus = in.DatabaseStatus
-	if in.Messages != nil {
-		in, out := &in.Messages, &out.Messages
-		*out = make([]FoundationDBStatusMessage, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FoundationDBMultiRegionCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLocalClientInfo.
-func (in *FoundationDBStatusLocalClientInfo) DeepCopy() *FoundationDBStatusLocalClientInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBMultiRegionClusterList.
+func (in *FoundationDBMultiRegionClusterList) DeepCopy() *FoundationDBMultiRegionClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusLocalClientInfo)
+	out := new(FoundationDBMultiRegionClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusLogInfo) DeepCopyInto(out *FoundationDBStatusLogInfo) {
-	*out = *in
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBMultiRegionClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBMultiRegionClusterSpec) DeepCopyInto(out *FoundationDBMultiRegionClusterSpec) {
+	*out = *in
+	in.ClusterSpec.DeepCopyInto(&out.ClusterSpec)
+	if in.DataCenters != nil {
+		in, out := &in.DataCenters, &out.DataCenters
+		*out = make([]MultiRegionDataCenter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBMultiRegionClusterSpec.
+func (in *FoundationDBMultiRegionClusterSpec) DeepCopy() *FoundationDBMultiRegionClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBMultiRegionClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBMultiRegionClusterStatus) DeepCopyInto(out *FoundationDBMultiRegionClusterStatus) {
+	*out = *in
+	if in.DataCenters != nil {
+		in, out := &in.DataCenters, &out.DataCenters
+		*out = make([]MultiRegionDataCenterStatus, len(*in))
+		copy(*out, *in)
+	}
+	out.Generations = in.Generations
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBMultiRegionClusterStatus.
+func (in *FoundationDBMultiRegionClusterStatus) DeepCopy() *FoundationDBMultiRegionClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBMultiRegionClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBRestore) DeepCopyInto(out *FoundationDBRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestore.
+func (in *FoundationDBRestore) DeepCopy() *FoundationDBRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBRestoreList) DeepCopyInto(out *FoundationDBRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FoundationDBRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestoreList.
+func (in *FoundationDBRestoreList) DeepCopy() *FoundationDBRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBRestoreSpec) DeepCopyInto(out *FoundationDBRestoreSpec) {
+	*out = *in
+	if in.KeyRanges != nil {
+		in, out := &in.KeyRanges, &out.KeyRanges
+		*out = make([]FoundationDBKeyRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlobStoreConfiguration != nil {
+		in, out := &in.BlobStoreConfiguration, &out.BlobStoreConfiguration
+		*out = new(BlobStoreConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomParameters != nil {
+		in, out := &in.CustomParameters, &out.CustomParameters
+		*out = make(FoundationDBCustomParameters, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetTimestamp != nil {
+		in, out := &in.TargetTimestamp, &out.TargetTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestoreSpec.
+func (in *FoundationDBRestoreSpec) DeepCopy() *FoundationDBRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBRestoreStatus) DeepCopyInto(out *FoundationDBRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBRestoreStatus.
+func (in *FoundationDBRestoreStatus) DeepCopy() *FoundationDBRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatus) DeepCopyInto(out *FoundationDBStatus) {
+	*out = *in
+	in.Client.DeepCopyInto(&out.Client)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatus.
+func (in *FoundationDBStatus) DeepCopy() *FoundationDBStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusBackupInfo) DeepCopyInto(out *FoundationDBStatusBackupInfo) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]FoundationDBStatusBackupTag, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusBackupInfo.
+func (in *FoundationDBStatusBackupInfo) DeepCopy() *FoundationDBStatusBackupInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusBackupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusBackupTag) DeepCopyInto(out *FoundationDBStatusBackupTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusBackupTag.
+func (in *FoundationDBStatusBackupTag) DeepCopy() *FoundationDBStatusBackupTag {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusBackupTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusCPUStatistics) DeepCopyInto(out *FoundationDBStatusCPUStatistics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusCPUStatistics.
+func (in *FoundationDBStatusCPUStatistics) DeepCopy() *FoundationDBStatusCPUStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusCPUStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusClientDBStatus) DeepCopyInto(out *FoundationDBStatusClientDBStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusClientDBStatus.
+func (in *FoundationDBStatusClientDBStatus) DeepCopy() *FoundationDBStatusClientDBStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusClientDBStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusClusterClientInfo) DeepCopyInto(out *FoundationDBStatusClusterClientInfo) {
+	*out = *in
+	if in.SupportedVersions != nil {
+		in, out := &in.SupportedVersions, &out.SupportedVersions
+		*out = make([]FoundationDBStatusSupportedVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusClusterClientInfo.
+func (in *FoundationDBStatusClusterClientInfo) DeepCopy() *FoundationDBStatusClusterClientInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusClusterClientInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusClusterInfo) DeepCopyInto(out *FoundationDBStatusClusterInfo) {
+	*out = *in
+	in.DatabaseConfiguration.DeepCopyInto(&out.DatabaseConfiguration)
+	if in.Processes != nil {
+		in, out := &in.Processes, &out.Processes
+		*out = make(map[ProcessGroupID]FoundationDBStatusProcessInfo, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	in.Data.DeepCopyInto(&out.Data)
+	in.Clients.DeepCopyInto(&out.Clients)
+	in.Layers.DeepCopyInto(&out.Layers)
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]FoundationDBStatusLogInfo, len(*in))
+		copy(*out, *in)
+	}
+	out.Qos = in.Qos
+	out.FaultTolerance = in.FaultTolerance
+	if in.IncompatibleConnections != nil {
+		in, out := &in.IncompatibleConnections, &out.IncompatibleConnections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.RecoveryState = in.RecoveryState
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]FoundationDBStatusMessage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.BounceImpact.DeepCopyInto(&out.BounceImpact)
+	in.StorageWiggler.DeepCopyInto(&out.StorageWiggler)
+	out.Workload = in.Workload
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusClusterInfo.
+func (in *FoundationDBStatusClusterInfo) DeepCopy() *FoundationDBStatusClusterInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusClusterInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusConnectedClient) DeepCopyInto(out *FoundationDBStatusConnectedClient) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusConnectedClient.
+func (in *FoundationDBStatusConnectedClient) DeepCopy() *FoundationDBStatusConnectedClient {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusConnectedClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusCoordinator) DeepCopyInto(out *FoundationDBStatusCoordinator) {
+	*out = *in
+	in.Address.DeepCopyInto(&out.Address)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusCoordinator.
+func (in *FoundationDBStatusCoordinator) DeepCopy() *FoundationDBStatusCoordinator {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusCoordinator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusCoordinatorInfo) DeepCopyInto(out *FoundationDBStatusCoordinatorInfo) {
+	*out = *in
+	if in.Coordinators != nil {
+		in, out := &in.Coordinators, &out.Coordinators
+		*out = make([]FoundationDBStatusCoordinator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusCoordinatorInfo.
+func (in *FoundationDBStatusCoordinatorInfo) DeepCopy() *FoundationDBStatusCoordinatorInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusCoordinatorInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusDataState) DeepCopyInto(out *FoundationDBStatusDataState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusDataState.
+func (in *FoundationDBStatusDataState) DeepCopy() *FoundationDBStatusDataState {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusDataState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusDataStatistics) DeepCopyInto(out *FoundationDBStatusDataStatistics) {
+	*out = *in
+	out.MovingData = in.MovingData
+	out.State = in.State
+	if in.TeamTrackers != nil {
+		in, out := &in.TeamTrackers, &out.TeamTrackers
+		*out = make([]FoundationDBStatusTeamTracker, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusDataStatistics.
+func (in *FoundationDBStatusDataStatistics) DeepCopy() *FoundationDBStatusDataStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusDataStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusLagInfo) DeepCopyInto(out *FoundationDBStatusLagInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLagInfo.
+func (in *FoundationDBStatusLagInfo) DeepCopy() *FoundationDBStatusLagInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusLagInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusLayerInfo) DeepCopyInto(out *FoundationDBStatusLayerInfo) {
+	*out = *in
+	in.Backup.DeepCopyInto(&out.Backup)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLayerInfo.
+func (in *FoundationDBStatusLayerInfo) DeepCopy() *FoundationDBStatusLayerInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusLayerInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusLocalClientInfo) DeepCopyInto(out *FoundationDBStatusLocalClientInfo) {
+	*out = *in
+	in.Coordinators.DeepCopyInto(&out.Coordinators)
+	out.DatabaseStatus = in.DatabaseStatus
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]FoundationDBStatusMessage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLocalClientInfo.
+func (in *FoundationDBStatusLocalClientInfo) DeepCopy() *FoundationDBStatusLocalClientInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusLocalClientInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusLogInfo) DeepCopyInto(out *FoundationDBStatusLogInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLogInfo.
+func (in *FoundationDBStatusLogInfo) DeepCopy() *FoundationDBStatusLogInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusLogInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusMessage) DeepCopyInto(out *FoundationDBStatusMessage) {
+	*out = *in
+	if in.UnreachableProcesses != nil {
+		in, out := &in.UnreachableProcesses, &out.UnreachableProcesses
+		*out = make([]FoundationDBUnreachableProcess, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusMessage.
+func (in *FoundationDBStatusMessage) DeepCopy() *FoundationDBStatusMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusMovingData) DeepCopyInto(out *FoundationDBStatusMovingData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusMovingData.
+func (in *FoundationDBStatusMovingData) DeepCopy() *FoundationDBStatusMovingData {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusMovingData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusProcessInfo) DeepCopyInto(out *FoundationDBStatusProcessInfo) {
+	*out = *in
+	in.Address.DeepCopyInto(&out.Address)
+	if in.Locality != nil {
+		in, out := &in.Locality, &out.Locality
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.CPU = in.CPU
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]FoundationDBStatusProcessRoleInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]FoundationDBStatusProcessMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusProcessInfo.
+func (in *FoundationDBStatusProcessInfo) DeepCopy() *FoundationDBStatusProcessInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusProcessInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusProcessMessage) DeepCopyInto(out *FoundationDBStatusProcessMessage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusProcessMessage.
+func (in *FoundationDBStatusProcessMessage) DeepCopy() *FoundationDBStatusProcessMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusProcessMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusProcessRoleInfo) DeepCopyInto(out *FoundationDBStatusProcessRoleInfo) {
+	*out = *in
+	if in.StorageMetadata != nil {
+		in, out := &in.StorageMetadata, &out.StorageMetadata
+		*out = new(FoundationDBStatusStorageMetadata)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusProcessRoleInfo.
+func (in *FoundationDBStatusProcessRoleInfo) DeepCopy() *FoundationDBStatusProcessRoleInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusProcessRoleInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusQosInfo) DeepCopyInto(out *FoundationDBStatusQosInfo) {
+	*out = *in
+	out.LimitingDurabilityLagStorageServer = in.LimitingDurabilityLagStorageServer
+	out.WorstDataLagStorageServer = in.WorstDataLagStorageServer
+	out.WorstDurabilityLagStorageServer = in.WorstDurabilityLagStorageServer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusQosInfo.
+func (in *FoundationDBStatusQosInfo) DeepCopy() *FoundationDBStatusQosInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusQosInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusRate) DeepCopyInto(out *FoundationDBStatusRate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusRate.
+func (in *FoundationDBStatusRate) DeepCopy() *FoundationDBStatusRate {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusStorageMetadata) DeepCopyInto(out *FoundationDBStatusStorageMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusStorageMetadata.
+func (in *FoundationDBStatusStorageMetadata) DeepCopy() *FoundationDBStatusStorageMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusStorageMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusStorageWiggler) DeepCopyInto(out *FoundationDBStatusStorageWiggler) {
+	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(FoundationDBStatusStorageWigglerStats)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(FoundationDBStatusStorageWigglerStats)
+		**out = **in
+	}
+	if in.WiggleServerIDs != nil {
+		in, out := &in.WiggleServerIDs, &out.WiggleServerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WiggleServerAddresses != nil {
+		in, out := &in.WiggleServerAddresses, &out.WiggleServerAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusStorageWiggler.
+func (in *FoundationDBStatusStorageWiggler) DeepCopy() *FoundationDBStatusStorageWiggler {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusStorageWiggler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusStorageWigglerStats) DeepCopyInto(out *FoundationDBStatusStorageWigglerStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusStorageWigglerStats.
+func (in *FoundationDBStatusStorageWigglerStats) DeepCopy() *FoundationDBStatusStorageWigglerStats {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusStorageWigglerStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusSupportedVersion) DeepCopyInto(out *FoundationDBStatusSupportedVersion) {
+	*out = *in
+	if in.ConnectedClients != nil {
+		in, out := &in.ConnectedClients, &out.ConnectedClients
+		*out = make([]FoundationDBStatusConnectedClient, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxProtocolClients != nil {
+		in, out := &in.MaxProtocolClients, &out.MaxProtocolClients
+		*out = make([]FoundationDBStatusConnectedClient, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusSupportedVersion.
+func (in *FoundationDBStatusSupportedVersion) DeepCopy() *FoundationDBStatusSupportedVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusSupportedVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusTeamTracker) DeepCopyInto(out *FoundationDBStatusTeamTracker) {
+	*out = *in
+	out.State = in.State
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusTeamTracker.
+func (in *FoundationDBStatusTeamTracker) DeepCopy() *FoundationDBStatusTeamTracker {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusTeamTracker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusTransactions) DeepCopyInto(out *FoundationDBStatusTransactions) {
+	*out = *in
+	out.Started = in.Started
+	out.Committed = in.Committed
+	out.Conflicted = in.Conflicted
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusTransactions.
+func (in *FoundationDBStatusTransactions) DeepCopy() *FoundationDBStatusTransactions {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusTransactions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBStatusWorkload) DeepCopyInto(out *FoundationDBStatusWorkload) {
+	*out = *in
+	out.Transactions = in.Transactions
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusWorkload.
+func (in *FoundationDBStatusWorkload) DeepCopy() *FoundationDBStatusWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBStatusWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBTenant) DeepCopyInto(out *FoundationDBTenant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBTenant.
+func (in *FoundationDBTenant) DeepCopy() *FoundationDBTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBTenant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBTenantList) DeepCopyInto(out *FoundationDBTenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FoundationDBTenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBTenantList.
+func (in *FoundationDBTenantList) DeepCopy() *FoundationDBTenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBTenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBTenantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBTenantSpec) DeepCopyInto(out *FoundationDBTenantSpec) {
+	*out = *in
+	if in.ForceDeletion != nil {
+		in, out := &in.ForceDeletion, &out.ForceDeletion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBTenantSpec.
+func (in *FoundationDBTenantSpec) DeepCopy() *FoundationDBTenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBTenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBTenantStatus) DeepCopyInto(out *FoundationDBTenantStatus) {
+	*out = *in
+	out.Generations = in.Generations
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBTenantStatus.
+func (in *FoundationDBTenantStatus) DeepCopy() *FoundationDBTenantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBTenantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBUnreachableProcess) DeepCopyInto(out *FoundationDBUnreachableProcess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBUnreachableProcess.
+func (in *FoundationDBUnreachableProcess) DeepCopy() *FoundationDBUnreachableProcess {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBUnreachableProcess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBUpgradePlan) DeepCopyInto(out *FoundationDBUpgradePlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBUpgradePlan.
+func (in *FoundationDBUpgradePlan) DeepCopy() *FoundationDBUpgradePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBUpgradePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBUpgradePlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBUpgradePlanList) DeepCopyInto(out *FoundationDBUpgradePlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FoundationDBUpgradePlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBUpgradePlanList.
+func (in *FoundationDBUpgradePlanList) DeepCopy() *FoundationDBUpgradePlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBUpgradePlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationDBUpgradePlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBUpgradePlanSpec) DeepCopyInto(out *FoundationDBUpgradePlanSpec) {
+	*out = *in
+	in.ClusterSelector.DeepCopyInto(&out.ClusterSelector)
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]UpgradeWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBUpgradePlanSpec.
+func (in *FoundationDBUpgradePlanSpec) DeepCopy() *FoundationDBUpgradePlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBUpgradePlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationDBUpgradePlanStatus) DeepCopyInto(out *FoundationDBUpgradePlanStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]UpgradePlanClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBUpgradePlanStatus.
+func (in *FoundationDBUpgradePlanStatus) DeepCopy() *FoundationDBUpgradePlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationDBUpgradePlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageConfig) DeepCopyInto(out *ImageConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageConfig.
+func (in *ImageConfig) DeepCopy() *ImageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnobDefinition) DeepCopyInto(out *KnobDefinition) {
+	*out = *in
+	out.MinimumVersion = in.MinimumVersion
+	if in.RemovedVersion != nil {
+		in, out := &in.RemovedVersion, &out.RemovedVersion
+		*out = new(Version)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnobDefinition.
+func (in *KnobDefinition) DeepCopy() *KnobDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(KnobDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelConfig) DeepCopyInto(out *LabelConfig) {
+	*out = *in
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceLabels != nil {
+		in, out := &in.ResourceLabels, &out.ResourceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ProcessGroupIDLabels != nil {
+		in, out := &in.ProcessGroupIDLabels, &out.ProcessGroupIDLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProcessClassLabels != nil {
+		in, out := &in.ProcessClassLabels, &out.ProcessClassLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterOnOwnerReferences != nil {
+		in, out := &in.FilterOnOwnerReferences, &out.FilterOnOwnerReferences
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelConfig.
+func (in *LabelConfig) DeepCopy() *LabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockDenyListEntry) DeepCopyInto(out *LockDenyListEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockDenyListEntry.
+func (in *LockDenyListEntry) DeepCopy() *LockDenyListEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(LockDenyListEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockOptions) DeepCopyInto(out *LockOptions) {
+	*out = *in
+	if in.DisableLocks != nil {
+		in, out := &in.DisableLocks, &out.DisableLocks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockDurationMinutes != nil {
+		in, out := &in.LockDurationMinutes, &out.LockDurationMinutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.DenyList != nil {
+		in, out := &in.DenyList, &out.DenyList
+		*out = make([]LockDenyListEntry, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusLogInfo.
-func (in *FoundationDBStatusLogInfo) DeepCopy() *FoundationDBStatusLogInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockOptions.
+func (in *LockOptions) DeepCopy() *LockOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LockOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockSystemStatus) DeepCopyInto(out *LockSystemStatus) {
+	*out = *in
+	if in.DenyList != nil {
+		in, out := &in.DenyList, &out.DenyList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockSystemStatus.
+func (in *LockSystemStatus) DeepCopy() *LockSystemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LockSystemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceModeInfo) DeepCopyInto(out *MaintenanceModeInfo) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ProcessGroups != nil {
+		in, out := &in.ProcessGroups, &out.ProcessGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceModeInfo.
+func (in *MaintenanceModeInfo) DeepCopy() *MaintenanceModeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceModeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceModeOptions) DeepCopyInto(out *MaintenanceModeOptions) {
+	*out = *in
+	if in.UseMaintenanceModeChecker != nil {
+		in, out := &in.UseMaintenanceModeChecker, &out.UseMaintenanceModeChecker
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaintenanceModeTimeSeconds != nil {
+		in, out := &in.MaintenanceModeTimeSeconds, &out.MaintenanceModeTimeSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceModeOptions.
+func (in *MaintenanceModeOptions) DeepCopy() *MaintenanceModeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceModeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowOptions) DeepCopyInto(out *MaintenanceWindowOptions) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.DenyWindows != nil {
+		in, out := &in.DenyWindows, &out.DenyWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowFailedReplacementsOutsideWindow != nil {
+		in, out := &in.AllowFailedReplacementsOutsideWindow, &out.AllowFailedReplacementsOutsideWindow
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowOptions.
+func (in *MaintenanceWindowOptions) DeepCopy() *MaintenanceWindowOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.NextWindowStart != nil {
+		in, out := &in.NextWindowStart, &out.NextWindowStart
+		*out = (*in).DeepCopy()
+	}
+	if in.DeferredSubReconcilers != nil {
+		in, out := &in.DeferredSubReconcilers, &out.DeferredSubReconcilers
+		*out = make([]SubReconcilerName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionClusterGenerationStatus) DeepCopyInto(out *MultiRegionClusterGenerationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionClusterGenerationStatus.
+func (in *MultiRegionClusterGenerationStatus) DeepCopy() *MultiRegionClusterGenerationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionClusterGenerationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionDataCenter) DeepCopyInto(out *MultiRegionDataCenter) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ProcessCounts != nil {
+		in, out := &in.ProcessCounts, &out.ProcessCounts
+		*out = new(ProcessCounts)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionDataCenter.
+func (in *MultiRegionDataCenter) DeepCopy() *MultiRegionDataCenter {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionDataCenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionDataCenterStatus) DeepCopyInto(out *MultiRegionDataCenterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionDataCenterStatus.
+func (in *MultiRegionDataCenterStatus) DeepCopy() *MultiRegionDataCenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionDataCenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *None) DeepCopyInto(out *None) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new None.
+func (in *None) DeepCopy() *None {
+	if in == nil {
+		return nil
+	}
+	out := new(None)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedAction) DeepCopyInto(out *PlannedAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedAction.
+func (in *PlannedAction) DeepCopy() *PlannedAction {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessAddress) DeepCopyInto(out *ProcessAddress) {
+	*out = *in
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = make(netx.IP, len(*in))
+		copy(*out, *in)
+	}
+	if in.Flags != nil {
+		in, out := &in.Flags, &out.Flags
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessAddress.
+func (in *ProcessAddress) DeepCopy() *ProcessAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessClassKnobStatus) DeepCopyInto(out *ProcessClassKnobStatus) {
+	*out = *in
+	if in.Knobs != nil {
+		in, out := &in.Knobs, &out.Knobs
+		*out = make([]FoundationDBKnobStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingRestart != nil {
+		in, out := &in.PendingRestart, &out.PendingRestart
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessClassKnobStatus.
+func (in *ProcessClassKnobStatus) DeepCopy() *ProcessClassKnobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessClassKnobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessCounts) DeepCopyInto(out *ProcessCounts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessCounts.
+func (in *ProcessCounts) DeepCopy() *ProcessCounts {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusLogInfo)
+	out := new(ProcessCounts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusMessage) DeepCopyInto(out *FoundationDBStatusMessage) {
+func (in *ProcessGroupCondition) DeepCopyInto(out *ProcessGroupCondition) {
 	*out = *in
-	if in.UnreachableProcesses != nil {
-		in, out := &in.UnreachableProcesses, &out.UnreachableProcesses
-		*out = make([]FoundationDBUnreachableProcess, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusMessage.
-func (in *FoundationDBStatusMessage) DeepCopy() *FoundationDBStatusMessage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessGroupCondition.
+func (in *ProcessGroupCondition) DeepCopy() *ProcessGroupCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusMessage)
+	out := new(ProcessGroupCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusMovingData) DeepCopyInto(out *FoundationDBStatusMovingData) {
+func (in *ProcessGroupStatus) DeepCopyInto(out *ProcessGroupStatus) {
 	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovalTimestamp != nil {
+		in, out := &in.RemovalTimestamp, &out.RemovalTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ExclusionTimestamp != nil {
+		in, out := &in.ExclusionTimestamp, &out.ExclusionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ProcessGroupConditions != nil {
+		in, out := &in.ProcessGroupConditions, &out.ProcessGroupConditions
+		*out = make([]*ProcessGroupCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProcessGroupCondition)
+				**out = **in
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusMovingData.
-func (in *FoundationDBStatusMovingData) DeepCopy() *FoundationDBStatusMovingData {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessGroupStatus.
+func (in *ProcessGroupStatus) DeepCopy() *ProcessGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusMovingData)
+	out := new(ProcessGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusProcessInfo) DeepCopyInto(out *FoundationDBStatusProcessInfo) {
+func (in *ProcessSettings) DeepCopyInto(out *ProcessSettings) {
 	*out = *in
-	in.Address.DeepCopyInto(&out.Address)
-	if in.Locality != nil {
-		in, out := &in.Locality, &out.Locality
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(corev1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Roles != nil {
-		in, out := &in.Roles, &out.Roles
-		*out = make([]FoundationDBStatusProcessRoleInfo, len(*in))
+	if in.VolumeClaimTemplate != nil {
+		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
+		*out = new(corev1.PersistentVolumeClaim)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomParameters != nil {
+		in, out := &in.CustomParameters, &out.CustomParameters
+		*out = make(FoundationDBCustomParameters, len(*in))
 		copy(*out, *in)
 	}
-	if in.Messages != nil {
-		in, out := &in.Messages, &out.Messages
-		*out = make([]FoundationDBStatusProcessMessage, len(*in))
+	if in.Knobs != nil {
+		in, out := &in.Knobs, &out.Knobs
+		*out = make([]FoundationDBKnob, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusProcessInfo.
-func (in *FoundationDBStatusProcessInfo) DeepCopy() *FoundationDBStatusProcessInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessSettings.
+func (in *ProcessSettings) DeepCopy() *ProcessSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusProcessInfo)
+	out := new(ProcessSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusProcessMessage) DeepCopyInto(out *FoundationDBStatusProcessMessage) {
+func (in *ReconciliationPlan) DeepCopyInto(out *ReconciliationPlan) {
 	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]PlannedAction, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusProcessMessage.
-func (in *FoundationDBStatusProcessMessage) DeepCopy() *FoundationDBStatusProcessMessage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconciliationPlan.
+func (in *ReconciliationPlan) DeepCopy() *ReconciliationPlan {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusProcessMessage)
+	out := new(ReconciliationPlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusProcessRoleInfo) DeepCopyInto(out *FoundationDBStatusProcessRoleInfo) {
+func (in *RecoveryState) DeepCopyInto(out *RecoveryState) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusProcessRoleInfo.
-func (in *FoundationDBStatusProcessRoleInfo) DeepCopy() *FoundationDBStatusProcessRoleInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoveryState.
+func (in *RecoveryState) DeepCopy() *RecoveryState {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusProcessRoleInfo)
+	out := new(RecoveryState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusQosInfo) DeepCopyInto(out *FoundationDBStatusQosInfo) {
+func (in *Region) DeepCopyInto(out *Region) {
 	*out = *in
-	out.LimitingDurabilityLagStorageServer = in.LimitingDurabilityLagStorageServer
-	out.WorstDataLagStorageServer = in.WorstDataLagStorageServer
-	out.WorstDurabilityLagStorageServer = in.WorstDurabilityLagStorageServer
+	if in.DataCenters != nil {
+		in, out := &in.DataCenters, &out.DataCenters
+		*out = make([]DataCenter, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusQosInfo.
-func (in *FoundationDBStatusQosInfo) DeepCopy() *FoundationDBStatusQosInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
+func (in *Region) DeepCopy() *Region {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusQosInfo)
+	out := new(Region)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusSupportedVersion) DeepCopyInto(out *FoundationDBStatusSupportedVersion) {
+func (in *RegionFailoverOptions) DeepCopyInto(out *RegionFailoverOptions) {
 	*out = *in
-	if in.ConnectedClients != nil {
-		in, out := &in.ConnectedClients, &out.ConnectedClients
-		*out = make([]FoundationDBStatusConnectedClient, len(*in))
-		copy(*out, *in)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
 	}
-	if in.MaxProtocolClients != nil {
-		in, out := &in.MaxProtocolClients, &out.MaxProtocolClients
-		*out = make([]FoundationDBStatusConnectedClient, len(*in))
-		copy(*out, *in)
+	if in.FailoverDelaySeconds != nil {
+		in, out := &in.FailoverDelaySeconds, &out.FailoverDelaySeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaximumDataCenterLagSeconds != nil {
+		in, out := &in.MaximumDataCenterLagSeconds, &out.MaximumDataCenterLagSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.Failback != nil {
+		in, out := &in.Failback, &out.Failback
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusSupportedVersion.
-func (in *FoundationDBStatusSupportedVersion) DeepCopy() *FoundationDBStatusSupportedVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionFailoverOptions.
+func (in *RegionFailoverOptions) DeepCopy() *RegionFailoverOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusSupportedVersion)
+	out := new(RegionFailoverOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBStatusTeamTracker) DeepCopyInto(out *FoundationDBStatusTeamTracker) {
+func (in *RegionFailoverStatus) DeepCopyInto(out *RegionFailoverStatus) {
 	*out = *in
-	out.State = in.State
+	if in.PrimaryUnhealthySince != nil {
+		in, out := &in.PrimaryUnhealthySince, &out.PrimaryUnhealthySince
+		*out = (*in).DeepCopy()
+	}
+	if in.FailoverTime != nil {
+		in, out := &in.FailoverTime, &out.FailoverTime
+		*out = (*in).DeepCopy()
+	}
+	if in.OriginalPrimaryHealthySince != nil {
+		in, out := &in.OriginalPrimaryHealthySince, &out.OriginalPrimaryHealthySince
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBStatusTeamTracker.
-func (in *FoundationDBStatusTeamTracker) DeepCopy() *FoundationDBStatusTeamTracker {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionFailoverStatus.
+func (in *RegionFailoverStatus) DeepCopy() *RegionFailoverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBStatusTeamTracker)
+	out := new(RegionFailoverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FoundationDBUnreachableProcess) DeepCopyInto(out *FoundationDBUnreachableProcess) {
+func (in *RequiredAddressSet) DeepCopyInto(out *RequiredAddressSet) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationDBUnreachableProcess.
-func (in *FoundationDBUnreachableProcess) DeepCopy() *FoundationDBUnreachableProcess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredAddressSet.
+func (in *RequiredAddressSet) DeepCopy() *RequiredAddressSet {
 	if in == nil {
 		return nil
 	}
-	out := new(FoundationDBUnreachableProcess)
+	out := new(RequiredAddressSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageConfig) DeepCopyInto(out *ImageConfig) {
+func (in *RoleCountLimits) DeepCopyInto(out *RoleCountLimits) {
 	*out = *in
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(int)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageConfig.
-func (in *ImageConfig) DeepCopy() *ImageConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleCountLimits.
+func (in *RoleCountLimits) DeepCopy() *RoleCountLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageConfig)
+	out := new(RoleCountLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LabelConfig) DeepCopyInto(out *LabelConfig) {
+func (in *RoleCounts) DeepCopyInto(out *RoleCounts) {
 	*out = *in
-	if in.MatchLabels != nil {
-		in, out := &in.MatchLabels, &out.MatchLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ResourceLabels != nil {
-		in, out := &in.ResourceLabels, &out.ResourceLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ProcessGroupIDLabels != nil {
-		in, out := &in.ProcessGroupIDLabels, &out.ProcessGroupIDLabels
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ProcessClassLabels != nil {
-		in, out := &in.ProcessClassLabels, &out.ProcessClassLabels
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.FilterOnOwnerReferences != nil {
-		in, out := &in.FilterOnOwnerReferences, &out.FilterOnOwnerReferences
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelConfig.
-func (in *LabelConfig) DeepCopy() *LabelConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleCounts.
+func (in *RoleCounts) DeepCopy() *RoleCounts {
 	if in == nil {
 		return nil
 	}
-	out := new(LabelConfig)
+	out := new(RoleCounts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LockDenyListEntry) DeepCopyInto(out *LockDenyListEntry) {
+func (in *RoutingConfig) DeepCopyInto(out *RoutingConfig) {
 	*out = *in
+	if in.HeadlessService != nil {
+		in, out := &in.HeadlessService, &out.HeadlessService
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicIPSource != nil {
+		in, out := &in.PublicIPSource, &out.PublicIPSource
+		*out = new(PublicIPSource)
+		**out = **in
+	}
+	if in.PodIPFamily != nil {
+		in, out := &in.PodIPFamily, &out.PodIPFamily
+		*out = new(int)
+		**out = **in
+	}
+	if in.UseDNSInClusterFile != nil {
+		in, out := &in.UseDNSInClusterFile, &out.UseDNSInClusterFile
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefineDNSLocalityFields != nil {
+		in, out := &in.DefineDNSLocalityFields, &out.DefineDNSLocalityFields
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSDomain != nil {
+		in, out := &in.DNSDomain, &out.DNSDomain
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockDenyListEntry.
-func (in *LockDenyListEntry) DeepCopy() *LockDenyListEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingConfig.
+func (in *RoutingConfig) DeepCopy() *RoutingConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LockDenyListEntry)
+	out := new(RoutingConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LockOptions) DeepCopyInto(out *LockOptions) {
+func (in *StatelessAutoscalingOptions) DeepCopyInto(out *StatelessAutoscalingOptions) {
 	*out = *in
-	if in.DisableLocks != nil {
-		in, out := &in.DisableLocks, &out.DisableLocks
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LockDurationMinutes != nil {
-		in, out := &in.LockDurationMinutes, &out.LockDurationMinutes
+	in.CommitProxies.DeepCopyInto(&out.CommitProxies)
+	in.GrvProxies.DeepCopyInto(&out.GrvProxies)
+	in.Resolvers.DeepCopyInto(&out.Resolvers)
+	if in.CommittedTransactionsPerCommitProxy != nil {
+		in, out := &in.CommittedTransactionsPerCommitProxy, &out.CommittedTransactionsPerCommitProxy
+		*out = new(int)
+		**out = **in
+	}
+	if in.StartedTransactionsPerGrvProxy != nil {
+		in, out := &in.StartedTransactionsPerGrvProxy, &out.StartedTransactionsPerGrvProxy
+		*out = new(int)
+		**out = **in
+	}
+	if in.ResolvedTransactionsPerResolver != nil {
+		in, out := &in.ResolvedTransactionsPerResolver, &out.ResolvedTransactionsPerResolver
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaximumProxyCPUUtilization != nil {
+		in, out := &in.MaximumProxyCPUUtilization, &out.MaximumProxyCPUUtilization
 		*out = new(int)
 		**out = **in
 	}
-	if in.DenyList != nil {
-		in, out := &in.DenyList, &out.DenyList
-		*out = make([]LockDenyListEntry, len(*in))
-		copy(*out, *in)
+	if in.ScaleDownHysteresis != nil {
+		in, out := &in.ScaleDownHysteresis, &out.ScaleDownHysteresis
+		*out = new(int)
+		**out = **in
+	}
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(int)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockOptions.
-func (in *LockOptions) DeepCopy() *LockOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatelessAutoscalingOptions.
+func (in *StatelessAutoscalingOptions) DeepCopy() *StatelessAutoscalingOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(LockOptions)
+	out := new(StatelessAutoscalingOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LockSystemStatus) DeepCopyInto(out *LockSystemStatus) {
+func (in *StatelessAutoscalingStatus) DeepCopyInto(out *StatelessAutoscalingStatus) {
 	*out = *in
-	if in.DenyList != nil {
-		in, out := &in.DenyList, &out.DenyList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockSystemStatus.
-func (in *LockSystemStatus) DeepCopy() *LockSystemStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatelessAutoscalingStatus.
+func (in *StatelessAutoscalingStatus) DeepCopy() *StatelessAutoscalingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LockSystemStatus)
+	out := new(StatelessAutoscalingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceModeInfo) DeepCopyInto(out *MaintenanceModeInfo) {
+func (in *StorageAutoscalingDecision) DeepCopyInto(out *StorageAutoscalingDecision) {
 	*out = *in
-	if in.StartTimestamp != nil {
-		in, out := &in.StartTimestamp, &out.StartTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.ProcessGroups != nil {
-		in, out := &in.ProcessGroups, &out.ProcessGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceModeInfo.
-func (in *MaintenanceModeInfo) DeepCopy() *MaintenanceModeInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAutoscalingDecision.
+func (in *StorageAutoscalingDecision) DeepCopy() *StorageAutoscalingDecision {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceModeInfo)
+	out := new(StorageAutoscalingDecision)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceModeOptions) DeepCopyInto(out *MaintenanceModeOptions) {
+func (in *StorageAutoscalingOptions) DeepCopyInto(out *StorageAutoscalingOptions) {
 	*out = *in
-	if in.UseMaintenanceModeChecker != nil {
-		in, out := &in.UseMaintenanceModeChecker, &out.UseMaintenanceModeChecker
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MaintenanceModeTimeSeconds != nil {
-		in, out := &in.MaintenanceModeTimeSeconds, &out.MaintenanceModeTimeSeconds
+	if in.MinimumStorageProcessGroups != nil {
+		in, out := &in.MinimumStorageProcessGroups, &out.MinimumStorageProcessGroups
 		*out = new(int)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceModeOptions.
-func (in *MaintenanceModeOptions) DeepCopy() *MaintenanceModeOptions {
-	if in == nil {
-		return nil
+	if in.MaximumStorageProcessGroups != nil {
+		in, out := &in.MaximumStorageProcessGroups, &out.MaximumStorageProcessGroups
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleUpUtilization != nil {
+		in, out := &in.ScaleUpUtilization, &out.ScaleUpUtilization
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleDownUtilization != nil {
+		in, out := &in.ScaleDownUtilization, &out.ScaleDownUtilization
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleUpDelaySeconds != nil {
+		in, out := &in.ScaleUpDelaySeconds, &out.ScaleUpDelaySeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScaleDownDelaySeconds != nil {
+		in, out := &in.ScaleDownDelaySeconds, &out.ScaleDownDelaySeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(int)
+		**out = **in
 	}
-	out := new(MaintenanceModeOptions)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *None) DeepCopyInto(out *None) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new None.
-func (in *None) DeepCopy() *None {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAutoscalingOptions.
+func (in *StorageAutoscalingOptions) DeepCopy() *StorageAutoscalingOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(None)
+	out := new(StorageAutoscalingOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessAddress) DeepCopyInto(out *ProcessAddress) {
+func (in *StorageAutoscalingStatus) DeepCopyInto(out *StorageAutoscalingStatus) {
 	*out = *in
-	if in.IPAddress != nil {
-		in, out := &in.IPAddress, &out.IPAddress
-		*out = make(netx.IP, len(*in))
-		copy(*out, *in)
+	if in.HighUtilizationSince != nil {
+		in, out := &in.HighUtilizationSince, &out.HighUtilizationSince
+		*out = (*in).DeepCopy()
 	}
-	if in.Flags != nil {
-		in, out := &in.Flags, &out.Flags
-		*out = make(map[string]bool, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.LowUtilizationSince != nil {
+		in, out := &in.LowUtilizationSince, &out.LowUtilizationSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]StorageAutoscalingDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessAddress.
-func (in *ProcessAddress) DeepCopy() *ProcessAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAutoscalingStatus.
+func (in *StorageAutoscalingStatus) DeepCopy() *StorageAutoscalingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessAddress)
+	out := new(StorageAutoscalingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessCounts) DeepCopyInto(out *ProcessCounts) {
+func (in *StorageEngineMigrationOptions) DeepCopyInto(out *StorageEngineMigrationOptions) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaximumInFlightBytes != nil {
+		in, out := &in.MaximumInFlightBytes, &out.MaximumInFlightBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaximumInQueueBytes != nil {
+		in, out := &in.MaximumInQueueBytes, &out.MaximumInQueueBytes
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessCounts.
-func (in *ProcessCounts) DeepCopy() *ProcessCounts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageEngineMigrationOptions.
+func (in *StorageEngineMigrationOptions) DeepCopy() *StorageEngineMigrationOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessCounts)
+	out := new(StorageEngineMigrationOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessGroupCondition) DeepCopyInto(out *ProcessGroupCondition) {
+func (in *StorageEngineMigrationStatus) DeepCopyInto(out *StorageEngineMigrationStatus) {
 	*out = *in
+	if in.StorageServers != nil {
+		in, out := &in.StorageServers, &out.StorageServers
+		*out = make(map[StorageEngine]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ProcessGroups != nil {
+		in, out := &in.ProcessGroups, &out.ProcessGroups
+		*out = make([]ProcessGroupID, len(*in))
+		copy(*out, *in)
+	}
+	if in.BatchStartTime != nil {
+		in, out := &in.BatchStartTime, &out.BatchStartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessGroupCondition.
-func (in *ProcessGroupCondition) DeepCopy() *ProcessGroupCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageEngineMigrationStatus.
+func (in *StorageEngineMigrationStatus) DeepCopy() *StorageEngineMigrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessGroupCondition)
+	out := new(StorageEngineMigrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessGroupStatus) DeepCopyInto(out *ProcessGroupStatus) {
+func (in *StorageWiggleStatus) DeepCopyInto(out *StorageWiggleStatus) {
 	*out = *in
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
+	if in.WigglingServerIDs != nil {
+		in, out := &in.WigglingServerIDs, &out.WigglingServerIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.RemovalTimestamp != nil {
-		in, out := &in.RemovalTimestamp, &out.RemovalTimestamp
-		*out = (*in).DeepCopy()
+	if in.WigglingServerAddresses != nil {
+		in, out := &in.WigglingServerAddresses, &out.WigglingServerAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ExclusionTimestamp != nil {
-		in, out := &in.ExclusionTimestamp, &out.ExclusionTimestamp
+	if in.LastRoundFinishTime != nil {
+		in, out := &in.LastRoundFinishTime, &out.LastRoundFinishTime
 		*out = (*in).DeepCopy()
 	}
-	if in.ProcessGroupConditions != nil {
-		in, out := &in.ProcessGroupConditions, &out.ProcessGroupConditions
-		*out = make([]*ProcessGroupCondition, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProcessGroupCondition)
-				**out = **in
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessGroupStatus.
-func (in *ProcessGroupStatus) DeepCopy() *ProcessGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageWiggleStatus.
+func (in *StorageWiggleStatus) DeepCopy() *StorageWiggleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessGroupStatus)
+	out := new(StorageWiggleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProcessSettings) DeepCopyInto(out *ProcessSettings) {
+func (in *TaintReplacementOption) DeepCopyInto(out *TaintReplacementOption) {
 	*out = *in
-	if in.PodTemplate != nil {
-		in, out := &in.PodTemplate, &out.PodTemplate
-		*out = new(corev1.PodTemplateSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.VolumeClaimTemplate != nil {
-		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
-		*out = new(corev1.PersistentVolumeClaim)
-		(*in).DeepCopyInto(*out)
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
 	}
-	if in.CustomParameters != nil {
-		in, out := &in.CustomParameters, &out.CustomParameters
-		*out = make(FoundationDBCustomParameters, len(*in))
-		copy(*out, *in)
+	if in.DurationInSeconds != nil {
+		in, out := &in.DurationInSeconds, &out.DurationInSeconds
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessSettings.
-func (in *ProcessSettings) DeepCopy() *ProcessSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaintReplacementOption.
+func (in *TaintReplacementOption) DeepCopy() *TaintReplacementOption {
 	if in == nil {
 		return nil
 	}
-	out := new(ProcessSettings)
+	out := new(TaintReplacementOption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecoveryState) DeepCopyInto(out *RecoveryState) {
+func (in *TenantGenerationStatus) DeepCopyInto(out *TenantGenerationStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoveryState.
-func (in *RecoveryState) DeepCopy() *RecoveryState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantGenerationStatus.
+func (in *TenantGenerationStatus) DeepCopy() *TenantGenerationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RecoveryState)
+	out := new(TenantGenerationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Region) DeepCopyInto(out *Region) {
+func (in *UpgradePlanClusterStatus) DeepCopyInto(out *UpgradePlanClusterStatus) {
 	*out = *in
-	if in.DataCenters != nil {
-		in, out := &in.DataCenters, &out.DataCenters
-		*out = make([]DataCenter, len(*in))
-		copy(*out, *in)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
-func (in *Region) DeepCopy() *Region {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePlanClusterStatus.
+func (in *UpgradePlanClusterStatus) DeepCopy() *UpgradePlanClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Region)
+	out := new(UpgradePlanClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RequiredAddressSet) DeepCopyInto(out *RequiredAddressSet) {
+func (in *UpgradeRollbackOptions) DeepCopyInto(out *UpgradeRollbackOptions) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StallTimeoutSeconds != nil {
+		in, out := &in.StallTimeoutSeconds, &out.StallTimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredAddressSet.
-func (in *RequiredAddressSet) DeepCopy() *RequiredAddressSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackOptions.
+func (in *UpgradeRollbackOptions) DeepCopy() *UpgradeRollbackOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(RequiredAddressSet)
+	out := new(UpgradeRollbackOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleCounts) DeepCopyInto(out *RoleCounts) {
+func (in *UpgradeRollbackStatus) DeepCopyInto(out *UpgradeRollbackStatus) {
 	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleCounts.
-func (in *RoleCounts) DeepCopy() *RoleCounts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackStatus.
+func (in *UpgradeRollbackStatus) DeepCopy() *UpgradeRollbackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleCounts)
+	out := new(UpgradeRollbackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutingConfig) DeepCopyInto(out *RoutingConfig) {
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
 	*out = *in
-	if in.HeadlessService != nil {
-		in, out := &in.HeadlessService, &out.HeadlessService
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PublicIPSource != nil {
-		in, out := &in.PublicIPSource, &out.PublicIPSource
-		*out = new(PublicIPSource)
-		**out = **in
-	}
-	if in.PodIPFamily != nil {
-		in, out := &in.PodIPFamily, &out.PodIPFamily
-		*out = new(int)
-		**out = **in
-	}
-	if in.UseDNSInClusterFile != nil {
-		in, out := &in.UseDNSInClusterFile, &out.UseDNSInClusterFile
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DefineDNSLocalityFields != nil {
-		in, out := &in.DefineDNSLocalityFields, &out.DefineDNSLocalityFields
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DNSDomain != nil {
-		in, out := &in.DNSDomain, &out.DNSDomain
-		*out = new(string)
-		**out = **in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingConfig.
-func (in *RoutingConfig) DeepCopy() *RoutingConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutingConfig)
+	out := new(UpgradeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaintReplacementOption) DeepCopyInto(out *TaintReplacementOption) {
+func (in *UpgradeWave) DeepCopyInto(out *UpgradeWave) {
 	*out = *in
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
-		*out = new(string)
-		**out = **in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.DurationInSeconds != nil {
-		in, out := &in.DurationInSeconds, &out.DurationInSeconds
-		*out = new(int64)
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaintReplacementOption.
-func (in *TaintReplacementOption) DeepCopy() *TaintReplacementOption {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeWave.
+func (in *UpgradeWave) DeepCopy() *UpgradeWave {
 	if in == nil {
 		return nil
 	}
-	out := new(TaintReplacementOption)
+	out := new(UpgradeWave)
 	in.DeepCopyInto(out)
 	return out
 }
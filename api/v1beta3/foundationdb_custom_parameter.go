@@ -0,0 +1,29 @@
+/*
+ * foundationdb_custom_parameter.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta3
+
+// FoundationDBCustomParameter defines a single custom knob
+// +kubebuilder:validation:MaxLength=100
+type FoundationDBCustomParameter string
+
+// FoundationDBCustomParameters defines a slice of custom knobs
+// +kubebuilder:validation:MaxItems=100
+type FoundationDBCustomParameters []FoundationDBCustomParameter
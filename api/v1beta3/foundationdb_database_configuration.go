@@ -0,0 +1,258 @@
+/*
+ * foundationdb_database_configuration.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta3
+
+// DatabaseConfiguration represents the configuration of the database
+type DatabaseConfiguration struct {
+	// RedundancyMode defines the core replication factor for the database.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=single;double;triple;three_data_hall
+	// +kubebuilder:default:double
+	RedundancyMode RedundancyMode `json:"redundancy_mode,omitempty"`
+
+	// StorageEngine defines the storage engine the database uses.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ssd;ssd-1;ssd-2;memory;memory-1;memory-2;ssd-redwood-1-experimental;ssd-redwood-1;ssd-rocksdb-experimental;ssd-rocksdb-v1;ssd-sharded-rocksdb;memory-radixtree-beta;custom
+	// +kubebuilder:default:=ssd-2
+	StorageEngine StorageEngine `json:"storage_engine,omitempty"`
+
+	// UsableRegions defines how many regions the database should store data in.
+	UsableRegions int `json:"usable_regions,omitempty"`
+
+	// Regions defines the regions that the database can replicate in.
+	Regions []Region `json:"regions,omitempty"`
+
+	// ExcludedServers defines the list  of excluded servers form the database.
+	// +kubebuilder:validation:MaxItems=1024
+	ExcludedServers []ExcludedServers `json:"excluded_servers,omitempty"`
+
+	// PerpetualStorageWiggle defines if the perpetual storage wiggle should be enabled. A value of 1 enables the
+	// perpetual storage wiggle and a value of 0 disables it. If unset the operator will not change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	PerpetualStorageWiggle *int `json:"perpetual_storage_wiggle,omitempty"`
+
+	// PerpetualStorageWiggleLocality restricts the perpetual storage wiggle to the storage servers matching the
+	// locality in the format <key>:<value>. A value of "0" removes the restriction. If unset the operator will not
+	// change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=200
+	PerpetualStorageWiggleLocality *string `json:"perpetual_storage_wiggle_locality,omitempty"`
+
+	// StorageMigrationType defines how storage servers with a different storage engine than the configured storage
+	// engine will be migrated. If unset the operator will not change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=disabled;aggressive;gradual
+	StorageMigrationType *StorageMigrationType `json:"storage_migration_type,omitempty"`
+
+	// PerpetualStorageWiggleEngine defines the storage engine that storage servers will use after they have been
+	// wiggled. A value of none will use the configured storage engine. If unset the operator will not change the
+	// current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=none;ssd;ssd-1;ssd-2;memory;memory-1;memory-2;ssd-redwood-1-experimental;ssd-redwood-1;ssd-rocksdb-experimental;ssd-rocksdb-v1;ssd-sharded-rocksdb;memory-radixtree-beta;custom
+	PerpetualStorageWiggleEngine *StorageEngine `json:"perpetual_storage_wiggle_engine,omitempty"`
+
+	// TenantMode defines if tenants are disabled, optional or required for all transactions. If unset the operator
+	// will not change the current value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=disabled;optional_experimental;required_experimental
+	TenantMode *TenantMode `json:"tenant_mode,omitempty"`
+
+	// RoleCounts defines how many processes the database should recruit for
+	// each role.
+	RoleCounts `json:""`
+
+	// VersionFlags defines internal flags for testing new features in the
+	// database.
+	VersionFlags `json:""`
+}
+
+// Region represents a region in the database configuration
+type Region struct {
+	// The data centers in this region.
+	DataCenters []DataCenter `json:"datacenters,omitempty"`
+
+	// The number of satellite logs that we should recruit.
+	SatelliteLogs int `json:"satellite_logs,omitempty"`
+
+	// The replication strategy for satellite logs.
+	SatelliteRedundancyMode RedundancyMode `json:"satellite_redundancy_mode,omitempty"`
+}
+
+// ExcludedServers represents the excluded servers in the database configuration
+type ExcludedServers struct {
+	// The Address of the excluded server.
+	// +kubebuilder:validation:MaxLength=48
+	Address string `json:"address,omitempty"`
+
+	// The Locality of the excluded server.
+	// +kubebuilder:validation:MaxLength=200
+	Locality string `json:"locality,omitempty"`
+}
+
+// DataCenter represents a data center in the region configuration
+type DataCenter struct {
+	// The ID of the data center. This must match the dcid locality field.
+	ID string `json:"id,omitempty"`
+
+	// The priority of this data center when we have to choose a location.
+	// Higher priorities are preferred over lower priorities.
+	Priority int `json:"priority,omitempty"`
+
+	// Satellite indicates whether the data center is serving as a satellite for
+	// the region. A value of 1 indicates that it is a satellite, and a value of
+	// 0 indicates that it is not a satellite.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	Satellite int `json:"satellite,omitempty"`
+}
+
+// RedundancyMode defines the core replication factor for the database
+// +kubebuilder:validation:MaxLength=100
+type RedundancyMode string
+
+const (
+	// RedundancyModeSingle defines the replication factor 1.
+	RedundancyModeSingle RedundancyMode = "single"
+	// RedundancyModeDouble defines the replication factor 2.
+	RedundancyModeDouble RedundancyMode = "double"
+	// RedundancyModeTriple defines the replication factor 3.
+	RedundancyModeTriple RedundancyMode = "triple"
+	// RedundancyModeThreeDataHall defines the replication factor three_data_hall.
+	RedundancyModeThreeDataHall RedundancyMode = "three_data_hall"
+	// RedundancyModeOneSatelliteSingle defines the replication factor one_satellite_single.
+	RedundancyModeOneSatelliteSingle RedundancyMode = "one_satellite_single"
+	// RedundancyModeOneSatelliteDouble  defines the replication factor one_satellite_double.
+	RedundancyModeOneSatelliteDouble RedundancyMode = "one_satellite_double"
+	// RedundancyModeUnset defines the replication factor unset.
+	RedundancyModeUnset RedundancyMode = ""
+)
+
+// StorageEngine defines the storage engine for the database
+// +kubebuilder:validation:MaxLength=100
+type StorageEngine string
+
+const (
+	// StorageEngineSSD defines the storage engine ssd.
+	StorageEngineSSD StorageEngine = "ssd"
+	// StorageEngineSSD2 defines the storage engine ssd-2.
+	StorageEngineSSD2 StorageEngine = "ssd-2"
+	// StorageEngineMemory defines the storage engine memory.
+	StorageEngineMemory StorageEngine = "memory"
+	// StorageEngineMemory2 defines the storage engine memory-2.
+	StorageEngineMemory2 StorageEngine = "memory-2"
+	// StorageEngineRocksDbExperimental defines the storage engine ssd-rocksdb-experimental.
+	StorageEngineRocksDbExperimental StorageEngine = "ssd-rocksdb-experimental"
+	// StorageEngineRocksDbV1 defines the storage engine ssd-rocksdb-v1.
+	StorageEngineRocksDbV1 StorageEngine = "ssd-rocksdb-v1"
+	// StorageEngineShardedRocksDB defines the storage engine ssd-sharded-rocksdb.
+	StorageEngineShardedRocksDB StorageEngine = "ssd-sharded-rocksdb"
+	// StorageEngineRedwood1Experimental defines the storage engine ssd-redwood-1-experimental.
+	StorageEngineRedwood1Experimental StorageEngine = "ssd-redwood-1-experimental"
+	// StorageEngineRedwood1 defines the storage engine ssd-redwood-1.
+	StorageEngineRedwood1 StorageEngine = "ssd-redwood-1"
+	// StorageEngineNone defines that no storage engine is set, this is only valid for the perpetual storage wiggle
+	// engine.
+	StorageEngineNone StorageEngine = "none"
+)
+
+// StorageMigrationType defines how storage servers with a different storage engine will be migrated.
+// +kubebuilder:validation:MaxLength=100
+type StorageMigrationType string
+
+const (
+	// StorageMigrationTypeDisabled defines that storage servers will not be migrated.
+	StorageMigrationTypeDisabled StorageMigrationType = "disabled"
+	// StorageMigrationTypeAggressive defines that all storage servers with a different storage engine will be migrated
+	// at once.
+	StorageMigrationTypeAggressive StorageMigrationType = "aggressive"
+	// StorageMigrationTypeGradual defines that storage servers with a different storage engine will be migrated by the
+	// perpetual storage wiggle.
+	StorageMigrationTypeGradual StorageMigrationType = "gradual"
+)
+
+// TenantMode defines if tenants are disabled, optional or required for all transactions.
+// +kubebuilder:validation:MaxLength=100
+type TenantMode string
+
+const (
+	// TenantModeDisabled defines that tenants can't be used.
+	TenantModeDisabled TenantMode = "disabled"
+	// TenantModeOptionalExperimental defines that transactions can use tenants but are not required to use them.
+	TenantModeOptionalExperimental TenantMode = "optional_experimental"
+	// TenantModeRequiredExperimental defines that all normal transactions must use a tenant.
+	TenantModeRequiredExperimental TenantMode = "required_experimental"
+)
+
+// RoleCounts represents the roles whose counts can be customized.
+//
+// In contrast to the v1beta2 API, the v1beta3 API only supports the separated
+// commit and grv proxies. The proxies count of a v1beta2 cluster will be
+// preserved during the conversion in the ConversionDataAnnotation.
+type RoleCounts struct {
+	Storage       int `json:"storage,omitempty"`
+	Logs          int `json:"logs,omitempty"`
+	CommitProxies int `json:"commit_proxies,omitempty"`
+	GrvProxies    int `json:"grv_proxies,omitempty"`
+	Resolvers     int `json:"resolvers,omitempty"`
+	LogRouters    int `json:"log_routers,omitempty"`
+	RemoteLogs    int `json:"remote_logs,omitempty"`
+}
+
+// VersionFlags defines internal flags for new features in the database.
+type VersionFlags struct {
+	LogSpill   int `json:"log_spill,omitempty"`
+	LogVersion int `json:"log_version,omitempty"`
+}
+
+// ProcessCounts represents the number of processes we have for each valid
+// process class.
+//
+// If one of the counts in the spec is set to 0, we will infer the process count
+// for that class from the role counts. If one of the counts in the spec is set
+// to -1, we will not create any processes for that class. See
+// GetProcessCountsWithDefaults for more information on the rules for inferring
+// process counts.
+type ProcessCounts struct {
+	Unset       int `json:"unset,omitempty"`
+	Storage     int `json:"storage,omitempty"`
+	Transaction int `json:"transaction,omitempty"`
+	Resolution  int `json:"resolution,omitempty"`
+	// Deprecated: This setting will be removed in the next major release.
+	// use Test
+	Tester            int `json:"tester,omitempty"`
+	Test              int `json:"test,omitempty"`
+	Proxy             int `json:"proxy,omitempty"`
+	CommitProxy       int `json:"commit_proxy,omitempty"`
+	GrvProxy          int `json:"grv_proxy,omitempty"`
+	Master            int `json:"master,omitempty"`
+	Stateless         int `json:"stateless,omitempty"`
+	Log               int `json:"log,omitempty"`
+	ClusterController int `json:"cluster_controller,omitempty"`
+	LogRouter         int `json:"router,omitempty"`
+	FastRestore       int `json:"fast_restore,omitempty"`
+	DataDistributor   int `json:"data_distributor,omitempty"`
+	Coordinator       int `json:"coordinator,omitempty"`
+	Ratekeeper        int `json:"ratekeeper,omitempty"`
+	StorageCache      int `json:"storage_cache,omitempty"`
+	BackupWorker      int `json:"backup,omitempty"`
+}
@@ -0,0 +1,61 @@
+/*
+ * foundationdb_knob.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta3
+
+// FoundationDBKnob defines a knob that should be set for the fdbserver processes.
+type FoundationDBKnob struct {
+	// Name defines the name of the knob without the knob_ prefix, e.g. max_trace_lines.
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:Pattern=^[a-z0-9_]+$
+	Name string `json:"name"`
+
+	// Value defines the value of the knob. The value must match the type of the knob in the knob catalog.
+	// +kubebuilder:validation:MaxLength=1000
+	Value string `json:"value"`
+}
+
+// ProcessClassKnobStatus describes the effective knobs of the processes of a process class.
+type ProcessClassKnobStatus struct {
+	// ProcessClass defines the process class of the processes.
+	ProcessClass ProcessClass `json:"processClass"`
+
+	// Knobs contains the knobs that are in effect for all processes of the process class.
+	// +kubebuilder:validation:MaxItems=200
+	Knobs []FoundationDBKnobStatus `json:"knobs,omitempty"`
+
+	// PendingRestart contains the names of the knobs that require a restart of at least one process of the process
+	// class before they are in effect.
+	// +kubebuilder:validation:MaxItems=200
+	PendingRestart []string `json:"pendingRestart,omitempty"`
+}
+
+// FoundationDBKnobStatus describes a knob that is in effect.
+type FoundationDBKnobStatus struct {
+	// Name defines the name of the knob without the knob_ prefix.
+	Name string `json:"name"`
+
+	// Value defines the value of the knob.
+	Value string `json:"value"`
+
+	// LiveUpdated is true if the knob was set through the configuration database instead of the command line of the
+	// processes.
+	LiveUpdated bool `json:"liveUpdated,omitempty"`
+}
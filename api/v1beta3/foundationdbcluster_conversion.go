@@ -0,0 +1,136 @@
+/*
+ * foundationdbcluster_conversion.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta3
+
+import (
+	"encoding/json"
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConversionDataAnnotation is the annotation that preserves the fields of a v1beta2 FoundationDBCluster that are not
+// part of the v1beta3 API, so that a conversion from v1beta3 back to v1beta2 is lossless.
+const ConversionDataAnnotation = "foundationdb.org/v1beta2-conversion-data"
+
+// v1beta2ConversionData contains the fields of a v1beta2 FoundationDBCluster that have no equivalent in the v1beta3 API.
+type v1beta2ConversionData struct {
+	// SpecProxies contains the proxies role count from the database configuration in the spec.
+	SpecProxies int `json:"specProxies,omitempty"`
+
+	// StatusProxies contains the proxies role count from the database configuration in the status.
+	StatusProxies int `json:"statusProxies,omitempty"`
+}
+
+var _ conversion.Convertible = &FoundationDBCluster{}
+
+// ConvertTo converts this FoundationDBCluster to the hub version (v1beta2).
+func (cluster *FoundationDBCluster) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*fdbv1beta2.FoundationDBCluster)
+	if !ok {
+		return fmt.Errorf("expected a v1beta2 FoundationDBCluster but got %T", dstRaw)
+	}
+
+	// All fields that have the same name and type in both versions are converted by their JSON representation. The
+	// type information of the destination must be kept, otherwise the converted object would have the source version.
+	typeMeta := dst.TypeMeta
+	err := convertByJSON(cluster, dst)
+	dst.TypeMeta = typeMeta
+	if err != nil {
+		return err
+	}
+
+	dst.Spec.AutomationOptions.MaintenanceModeOptions.UseMaintenanceModeChecker = cluster.Spec.AutomationOptions.MaintenanceModeOptions.UseMaintenanceModeChecker
+	dst.Spec.AutomationOptions.MaxConcurrentReplacements = cluster.Spec.AutomationOptions.MaxConcurrentMisconfiguredReplacements
+
+	rawData, ok := dst.Annotations[ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+
+	data := &v1beta2ConversionData{}
+	err = json.Unmarshal([]byte(rawData), data)
+	if err != nil {
+		return fmt.Errorf("could not parse annotation %s: %w", ConversionDataAnnotation, err)
+	}
+
+	dst.Spec.DatabaseConfiguration.Proxies = data.SpecProxies
+	dst.Status.DatabaseConfiguration.Proxies = data.StatusProxies
+
+	delete(dst.Annotations, ConversionDataAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta2) to this version.
+func (cluster *FoundationDBCluster) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*fdbv1beta2.FoundationDBCluster)
+	if !ok {
+		return fmt.Errorf("expected a v1beta2 FoundationDBCluster but got %T", srcRaw)
+	}
+
+	// All fields that have the same name and type in both versions are converted by their JSON representation. The
+	// type information of the destination must be kept, otherwise the converted object would have the source version.
+	typeMeta := cluster.TypeMeta
+	err := convertByJSON(src, cluster)
+	cluster.TypeMeta = typeMeta
+	if err != nil {
+		return err
+	}
+
+	cluster.Spec.AutomationOptions.MaintenanceModeOptions.UseMaintenanceModeChecker = src.Spec.AutomationOptions.MaintenanceModeOptions.UseMaintenanceModeChecker
+	cluster.Spec.AutomationOptions.MaxConcurrentMisconfiguredReplacements = src.Spec.AutomationOptions.MaxConcurrentReplacements
+
+	data := v1beta2ConversionData{
+		SpecProxies:   src.Spec.DatabaseConfiguration.Proxies,
+		StatusProxies: src.Status.DatabaseConfiguration.Proxies,
+	}
+
+	if data == (v1beta2ConversionData{}) {
+		return nil
+	}
+
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[ConversionDataAnnotation] = string(rawData)
+
+	return nil
+}
+
+// convertByJSON converts the source object into the destination object by using the JSON representation of the source.
+func convertByJSON(src interface{}, dst interface{}) error {
+	rawData, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(rawData, dst)
+}
@@ -0,0 +1,192 @@
+/*
+ * foundationdbcluster_conversion_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta3
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadDatabaseConfiguration reads the database configuration from the status fixtures of the v1beta2 package.
+func loadDatabaseConfiguration(fileName string) fdbv1beta2.DatabaseConfiguration {
+	statusFile, err := os.OpenFile(filepath.Join("..", "v1beta2", "testdata", fileName), os.O_RDONLY, os.ModePerm)
+	Expect(err).NotTo(HaveOccurred())
+	defer func() {
+		Expect(statusFile.Close()).NotTo(HaveOccurred())
+	}()
+
+	status := &fdbv1beta2.FoundationDBStatus{}
+	Expect(json.NewDecoder(statusFile).Decode(status)).NotTo(HaveOccurred())
+
+	config := status.Cluster.DatabaseConfiguration
+	// An empty list will be omitted in the JSON representation, so it will be nil after the round-trip.
+	if len(config.ExcludedServers) == 0 {
+		config.ExcludedServers = nil
+	}
+
+	return config
+}
+
+// createHubCluster creates a v1beta2 cluster that uses the provided database configuration in spec and status.
+func createHubCluster(version string, config fdbv1beta2.DatabaseConfiguration) *fdbv1beta2.FoundationDBCluster {
+	return &fdbv1beta2.FoundationDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app": "test",
+			},
+		},
+		Spec: fdbv1beta2.FoundationDBClusterSpec{
+			Version:               version,
+			DatabaseConfiguration: *config.DeepCopy(),
+			AutomationOptions: fdbv1beta2.FoundationDBClusterAutomationOptions{
+				MaxConcurrentReplacements: pointer.Int(2),
+				MaintenanceModeOptions: fdbv1beta2.MaintenanceModeOptions{
+					UseMaintenanceModeChecker: pointer.Bool(true),
+				},
+			},
+		},
+		Status: fdbv1beta2.FoundationDBClusterStatus{
+			RunningVersion:        version,
+			DatabaseConfiguration: *config.DeepCopy(),
+			Generations: fdbv1beta2.ClusterGenerationStatus{
+				Reconciled: 1,
+			},
+		},
+	}
+}
+
+var _ = Describe("[api] FoundationDBCluster conversion", func() {
+	DescribeTable("converting a v1beta2 cluster to v1beta3 and back",
+		func(version string, fileName string) {
+			config := loadDatabaseConfiguration(fileName)
+			hub := createHubCluster(version, config)
+			original := hub.DeepCopy()
+
+			spoke := &FoundationDBCluster{}
+			Expect(spoke.ConvertFrom(hub)).NotTo(HaveOccurred())
+			// Make sure the conversion doesn't modify the source.
+			Expect(hub).To(Equal(original))
+
+			Expect(spoke.Spec.Version).To(Equal(version))
+			Expect(spoke.Spec.AutomationOptions.MaxConcurrentMisconfiguredReplacements).To(Equal(pointer.Int(2)))
+			Expect(spoke.Spec.AutomationOptions.MaintenanceModeOptions.UseMaintenanceModeChecker).To(Equal(pointer.Bool(true)))
+			Expect(spoke.Spec.DatabaseConfiguration.CommitProxies).To(Equal(config.CommitProxies))
+			Expect(spoke.Spec.DatabaseConfiguration.GrvProxies).To(Equal(config.GrvProxies))
+
+			converted := &fdbv1beta2.FoundationDBCluster{}
+			Expect(spoke.ConvertTo(converted)).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(original))
+		},
+		Entry("with a 6.2 cluster", "6.2.20", "fdb_status_6_2.json"),
+		Entry("with a 7.1 cluster", "7.1.0-rc1", "fdb_status_7_1_rc1.json"),
+	)
+
+	When("the v1beta2 cluster has a proxies count", func() {
+		var spoke *FoundationDBCluster
+		var hub *fdbv1beta2.FoundationDBCluster
+
+		BeforeEach(func() {
+			hub = createHubCluster("6.2.20", fdbv1beta2.DatabaseConfiguration{
+				RedundancyMode: fdbv1beta2.RedundancyModeDouble,
+				StorageEngine:  fdbv1beta2.StorageEngineSSD2,
+				UsableRegions:  1,
+				RoleCounts: fdbv1beta2.RoleCounts{
+					Logs:    3,
+					Proxies: 5,
+				},
+			})
+
+			spoke = &FoundationDBCluster{}
+			Expect(spoke.ConvertFrom(hub)).NotTo(HaveOccurred())
+		})
+
+		It("should preserve the proxies count in the conversion annotation", func() {
+			Expect(spoke.Annotations).To(HaveKeyWithValue(ConversionDataAnnotation, `{"specProxies":5,"statusProxies":5}`))
+		})
+
+		It("should restore the proxies count and remove the annotation", func() {
+			converted := &fdbv1beta2.FoundationDBCluster{}
+			Expect(spoke.ConvertTo(converted)).NotTo(HaveOccurred())
+			Expect(converted.Spec.DatabaseConfiguration.Proxies).To(Equal(5))
+			Expect(converted.Status.DatabaseConfiguration.Proxies).To(Equal(5))
+			Expect(converted.Annotations).NotTo(HaveKey(ConversionDataAnnotation))
+			Expect(converted).To(Equal(hub))
+		})
+	})
+
+	When("converting a v1beta3 cluster to v1beta2 and back", func() {
+		It("should not change the cluster", func() {
+			spoke := &FoundationDBCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: FoundationDBClusterSpec{
+					Version: "7.1.26",
+					DatabaseConfiguration: DatabaseConfiguration{
+						RedundancyMode: RedundancyModeTriple,
+						RoleCounts: RoleCounts{
+							CommitProxies: 4,
+							GrvProxies:    2,
+						},
+					},
+					AutomationOptions: FoundationDBClusterAutomationOptions{
+						MaxConcurrentMisconfiguredReplacements: pointer.Int(0),
+					},
+				},
+			}
+
+			hub := &fdbv1beta2.FoundationDBCluster{}
+			Expect(spoke.ConvertTo(hub)).NotTo(HaveOccurred())
+			Expect(hub.Spec.AutomationOptions.MaxConcurrentReplacements).To(Equal(pointer.Int(0)))
+			Expect(hub.Spec.DatabaseConfiguration.Proxies).To(BeZero())
+
+			converted := &FoundationDBCluster{}
+			Expect(converted.ConvertFrom(hub)).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(spoke))
+		})
+	})
+
+	When("the objects have type information", func() {
+		It("should keep the type information of the destination", func() {
+			hub := createHubCluster("7.1.26", fdbv1beta2.DatabaseConfiguration{})
+			hub.TypeMeta = metav1.TypeMeta{Kind: "FoundationDBCluster", APIVersion: fdbv1beta2.GroupVersion.String()}
+
+			spoke := &FoundationDBCluster{TypeMeta: metav1.TypeMeta{Kind: "FoundationDBCluster", APIVersion: GroupVersion.String()}}
+			Expect(spoke.ConvertFrom(hub)).NotTo(HaveOccurred())
+			Expect(spoke.APIVersion).To(Equal(GroupVersion.String()))
+
+			converted := &fdbv1beta2.FoundationDBCluster{TypeMeta: metav1.TypeMeta{Kind: "FoundationDBCluster", APIVersion: fdbv1beta2.GroupVersion.String()}}
+			Expect(spoke.ConvertTo(converted)).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(hub))
+		})
+	})
+})
@@ -0,0 +1,1542 @@
+/*
+ * foundationdbcluster_types.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta3
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=fdb
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Generation",type="integer",JSONPath=".metadata.generation",description="Latest generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Reconciled",type="integer",JSONPath=".status.generations.reconciled",description="Last reconciled generation of the spec",priority=0
+// +kubebuilder:printcolumn:name="Available",type="boolean",JSONPath=".status.health.available",description="Database available",priority=0
+// +kubebuilder:printcolumn:name="FullReplication",type="boolean",JSONPath=".status.health.fullReplication",description="Database fully replicated",priority=0
+// +kubebuilder:printcolumn:name="ReconciledProcessGroups",type="integer",JSONPath=".status.reconciledProcessGroups",description="Number of reconciled process groups",priority=1
+// +kubebuilder:printcolumn:name="DesiredProcessGroups",type="integer",JSONPath=".status.desiredProcessGroups",description="Desired number of process groups",priority=1
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.runningVersion",description="Running version",priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// FoundationDBCluster is the Schema for the foundationdbclusters API
+type FoundationDBCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FoundationDBClusterSpec   `json:"spec,omitempty"`
+	Status FoundationDBClusterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FoundationDBClusterList contains a list of FoundationDBCluster objects
+type FoundationDBClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FoundationDBCluster `json:"items"`
+}
+
+// FoundationDBClusterSpec defines the desired state of a cluster.
+type FoundationDBClusterSpec struct {
+	// Version defines the version of FoundationDB the cluster should run.
+	// +kubebuilder:validation:Pattern:=(\d+)\.(\d+)\.(\d+)
+	Version string `json:"version"`
+
+	// DatabaseConfiguration defines the database configuration.
+	DatabaseConfiguration DatabaseConfiguration `json:"databaseConfiguration,omitempty"`
+
+	// Processes defines process-level settings.
+	Processes map[ProcessClass]ProcessSettings `json:"processes,omitempty"`
+
+	// ProcessCounts defines the number of processes to configure for each
+	// process class. You can generally omit this, to allow the operator to
+	// infer the process counts based on the database configuration.
+	ProcessCounts ProcessCounts `json:"processCounts,omitempty"`
+
+	// SeedConnectionString provides a connection string for the initial
+	// reconciliation.
+	//
+	// After the initial reconciliation, this will not be used.
+	SeedConnectionString string `json:"seedConnectionString,omitempty"`
+
+	// PartialConnectionString provides a way to specify part of the
+	// connection string (e.g. the database name and coordinator generation)
+	// without specifying the entire string. This does not allow for setting
+	// the coordinator IPs. If `SeedConnectionString` is set,
+	// `PartialConnectionString` will have no effect. They cannot be used
+	// together.
+	PartialConnectionString ConnectionString `json:"partialConnectionString,omitempty"`
+
+	// FaultDomain defines the rules for what fault domain to replicate across.
+	FaultDomain FoundationDBClusterFaultDomain `json:"faultDomain,omitempty"`
+
+	// ProcessGroupsToRemove defines the process groups that we should remove from the
+	// cluster. This list contains the process group IDs.
+	// +kubebuilder:validation:MinItems=0
+	// +kubebuilder:validation:MaxItems=500
+	ProcessGroupsToRemove []ProcessGroupID `json:"processGroupsToRemove,omitempty"`
+
+	// ProcessGroupsToRemoveWithoutExclusion defines the process groups that we should
+	// remove from the cluster without excluding them. This list contains the
+	// process group IDs.
+	//
+	// This should be used for cases where a pod does not have an IP address and
+	// you want to remove it and destroy its volume without confirming the data
+	// is fully replicated.
+	// +kubebuilder:validation:MinItems=0
+	// +kubebuilder:validation:MaxItems=500
+	ProcessGroupsToRemoveWithoutExclusion []ProcessGroupID `json:"processGroupsToRemoveWithoutExclusion,omitempty"`
+
+	// ConfigMap allows customizing the config map the operator creates.
+	ConfigMap *corev1.ConfigMap `json:"configMap,omitempty"`
+
+	// MainContainer defines customization for the foundationdb container.
+	MainContainer ContainerOverrides `json:"mainContainer,omitempty"`
+
+	// SidecarContainer defines customization for the
+	// foundationdb-kubernetes-sidecar container.
+	SidecarContainer ContainerOverrides `json:"sidecarContainer,omitempty"`
+
+	// TrustedCAs defines a list of root CAs the cluster should trust, in PEM
+	// format.
+	TrustedCAs []string `json:"trustedCAs,omitempty"`
+
+	// SidecarVariables defines Custom variables that the sidecar should make
+	// available for substitution in the monitor conf file.
+	SidecarVariables []string `json:"sidecarVariables,omitempty"`
+
+	// LogGroup defines the log group to use for the trace logs for the cluster.
+	LogGroup string `json:"logGroup,omitempty"`
+
+	// DataCenter defines the data center where these processes are running.
+	DataCenter string `json:"dataCenter,omitempty"`
+
+	// DataHall defines the data hall where these processes are running.
+	DataHall string `json:"dataHall,omitempty"`
+
+	// AutomationOptions defines customization for enabling or disabling certain
+	// operations in the operator.
+	AutomationOptions FoundationDBClusterAutomationOptions `json:"automationOptions,omitempty"`
+
+	// ProcessGroupIDPrefix defines a prefix to append to the process group IDs in the
+	// locality fields.
+	//
+	// This must be a valid Kubernetes label value. See
+	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
+	// for more details on that.
+	// +kubebuilder:validation:MaxLength=43
+	// +kubebuilder:validation:Pattern:=^[a-z0-9A-Z]([\-._a-z0-9A-Z])*[a-z0-9A-Z]$
+	ProcessGroupIDPrefix string `json:"processGroupIDPrefix,omitempty"`
+
+	// LockOptions allows customizing how we manage locks for global operations.
+	LockOptions LockOptions `json:"lockOptions,omitempty"`
+
+	// Routing defines the configuration for routing to our pods.
+	Routing RoutingConfig `json:"routing,omitempty"`
+
+	// IgnoreUpgradabilityChecks determines whether we should skip the check for
+	// client compatibility when performing an upgrade.
+	IgnoreUpgradabilityChecks bool `json:"ignoreUpgradabilityChecks,omitempty"`
+
+	// Buggify defines settings for injecting faults into a cluster for testing.
+	Buggify BuggifyConfig `json:"buggify,omitempty"`
+
+	// StorageServersPerPod defines how many Storage Servers should run in
+	// a single process group (Pod). This number defines the number of processes running
+	// in one Pod whereas the ProcessCounts defines the number of Pods created.
+	// This means that you end up with ProcessCounts["storage"] * StorageServersPerPod
+	// storage processes.
+	StorageServersPerPod int `json:"storageServersPerPod,omitempty"`
+
+	// LogServersPerPod defines how many Log Servers should run in
+	// a single process group (Pod). This number defines the number of processes running
+	// in one Pod whereas the ProcessCounts defines the number of Pods created.
+	// This means that you end up with ProcessCounts["Log"] * LogServersPerPod
+	// log processes. This also affects processes with the transaction class.
+	LogServersPerPod int `json:"logServersPerPod,omitempty"`
+
+	// MinimumUptimeSecondsForBounce defines the minimum time, in seconds, that the
+	// processes in the cluster must have been up for before the operator can
+	// execute a bounce.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=600
+	MinimumUptimeSecondsForBounce int `json:"minimumUptimeSecondsForBounce,omitempty"`
+
+	// ReplaceInstancesWhenResourcesChange defines if an instance should be replaced
+	// when the resource requirements are increased. This can be useful with the combination of
+	// local storage.
+	// +kubebuilder:default:=false
+	ReplaceInstancesWhenResourcesChange *bool `json:"replaceInstancesWhenResourcesChange,omitempty"`
+
+	// Skip defines if the cluster should be skipped for reconciliation. This can be useful for
+	// investigating in issues or if the environment is unstable.
+	// +kubebuilder:default:=false
+	Skip bool `json:"skip,omitempty"`
+
+	// CoordinatorSelection defines which process classes are eligible for coordinator selection.
+	// If empty all stateful processes classes are equally eligible.
+	// A higher priority means that a process class is preferred over another process class.
+	// If the FoundationDB cluster is spans across multiple Kubernetes clusters or DCs the
+	// CoordinatorSelection must match in all FoundationDB cluster resources otherwise
+	// the coordinator selection process could conflict.
+	CoordinatorSelection []CoordinatorSelectionSetting `json:"coordinatorSelection,omitempty"`
+
+	// LabelConfig allows customizing labels used by the operator.
+	LabelConfig LabelConfig `json:"labels,omitempty"`
+
+	// UseExplicitListenAddress determines if we should add a listen address
+	// that is separate from the public address.
+	// Deprecated: This setting will be removed in the next major release.
+	UseExplicitListenAddress *bool `json:"useExplicitListenAddress,omitempty"`
+
+	// UseUnifiedImage determines if we should use the unified image rather than
+	// separate images for the main container and the sidecar container.
+	UseUnifiedImage *bool `json:"useUnifiedImage,omitempty"`
+
+	// MaxZonesWithUnavailablePods defines the maximum number of zones that can have unavailable pods during the update process.
+	// When unset, there is no limit to the  number of zones with unavailable pods.
+	MaxZonesWithUnavailablePods *int `json:"maxZonesWithUnavailablePods,omitempty"`
+}
+
+// ImageType defines a single kind of images used in the cluster.
+// +kubebuilder:validation:MaxLength=1024
+type ImageType string
+
+// FoundationDBClusterStatus defines the observed state of FoundationDBCluster
+type FoundationDBClusterStatus struct {
+	// DatabaseConfiguration provides the running configuration of the database.
+	DatabaseConfiguration DatabaseConfiguration `json:"databaseConfiguration,omitempty"`
+
+	// Generations provides information about the latest generation to be
+	// reconciled, or to reach other stages at which reconciliation can halt.
+	Generations ClusterGenerationStatus `json:"generations,omitempty"`
+
+	// Health provides information about the health of the database.
+	Health ClusterHealth `json:"health,omitempty"`
+
+	// RequiredAddresses define that addresses that we need to enable for the
+	// processes in the cluster.
+	RequiredAddresses RequiredAddressSet `json:"requiredAddresses,omitempty"`
+
+	// HasIncorrectConfigMap indicates whether the latest config map is out
+	// of date with the cluster spec.
+	HasIncorrectConfigMap bool `json:"hasIncorrectConfigMap,omitempty"`
+
+	// HasIncorrectServiceConfig indicates whether the cluster has service
+	// config that is out of date with the cluster spec.
+	HasIncorrectServiceConfig bool `json:"hasIncorrectServiceConfig,omitempty"`
+
+	// NeedsNewCoordinators indicates whether the cluster needs to recruit
+	// new coordinators to fulfill its fault tolerance requirements.
+	NeedsNewCoordinators bool `json:"needsNewCoordinators,omitempty"`
+
+	// RunningVersion defines the version of FoundationDB that the cluster is
+	// currently running.
+	RunningVersion string `json:"runningVersion,omitempty"`
+
+	// ConnectionString defines the contents of the cluster file.
+	ConnectionString string `json:"connectionString,omitempty"`
+
+	// Configured defines whether we have configured the database yet.
+	Configured bool `json:"configured,omitempty"`
+
+	// HasListenIPsForAllPods defines whether every pod has an environment
+	// variable for its listen address.
+	HasListenIPsForAllPods bool `json:"hasListenIPsForAllPods,omitempty"`
+
+	// StorageServersPerDisk defines the storageServersPerPod observed in the cluster.
+	// If there are more than one value in the slice the reconcile phase is not finished.
+	// +kubebuilder:validation:MaxItems=5
+	StorageServersPerDisk []int `json:"storageServersPerDisk,omitempty"`
+
+	// LogServersPerDisk defines the LogServersPerDisk observed in the cluster.
+	// If there are more than one value in the slice the reconcile phase is not finished.
+	// +kubebuilder:validation:MaxItems=5
+	LogServersPerDisk []int `json:"logServersPerDisk,omitempty"`
+
+	// ImageTypes defines the kinds of images that are in use in the cluster.
+	// If there is more than one value in the slice the reconcile phase is not
+	// finished.
+	// +kubebuilder:validation:MaxItems=10
+	ImageTypes []ImageType `json:"imageTypes,omitempty"`
+
+	// ProcessGroups contain information about a process group.
+	// This information is used in multiple places to trigger the according action.
+	ProcessGroups []*ProcessGroupStatus `json:"processGroups,omitempty"`
+
+	// Locks contains information about the locking system.
+	Locks LockSystemStatus `json:"locks,omitempty"`
+
+	// MaintenenanceModeInfo contains information regarding process groups in maintenance mode
+	MaintenanceModeInfo MaintenanceModeInfo `json:"maintenanceModeInfo,omitempty"`
+
+	// DesiredProcessGroups reflects the number of expected running process groups.
+	DesiredProcessGroups int `json:"desiredProcessGroups,omitempty"`
+
+	// ReconciledProcessGroups reflects the number of process groups that have no condition and are not marked for removal.
+	ReconciledProcessGroups int `json:"reconciledProcessGroups,omitempty"`
+
+	// Conditions represents the latest available observations of the cluster's state. The conditions are derived
+	// from the result of the last reconciliation and from the machine-readable status of the database.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// BlockingReconciler contains information about the sub-reconciler that blocked the last reconciliation of the
+	// cluster. This will be reset once a reconciliation is not blocked by any sub-reconciler.
+	BlockingReconciler *BlockingReconcilerInfo `json:"blockingReconciler,omitempty"`
+
+	// SuspendedSubReconcilers contains the sub-reconcilers that are suspended by the automation options and are
+	// skipped during reconciliation.
+	SuspendedSubReconcilers []SubReconcilerName `json:"suspendedSubReconcilers,omitempty"`
+
+	// MaintenanceWindow contains information about the maintenance windows if they are configured.
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+
+	// Canary contains information about the current canary rollout.
+	Canary *CanaryStatus `json:"canary,omitempty"`
+
+	// Upgrade contains information about the current protocol compatible upgrade.
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// UpgradeRollback contains information about the last upgrade that was rolled back by the operator. The failed
+	// version will be locked out until the rollback is acknowledged.
+	UpgradeRollback *UpgradeRollbackStatus `json:"upgradeRollback,omitempty"`
+
+	// RegionFailover contains information about an unhealthy primary data center and the last region failover
+	// performed by the operator.
+	RegionFailover *RegionFailoverStatus `json:"regionFailover,omitempty"`
+
+	// StorageWiggle contains information about the progress of the perpetual storage wiggle. This will only be set
+	// if the perpetual storage wiggle is enabled.
+	StorageWiggle *StorageWiggleStatus `json:"storageWiggle,omitempty"`
+
+	// StorageEngineMigration contains information about the migration of the storage process groups to the configured
+	// storage engine.
+	StorageEngineMigration *StorageEngineMigrationStatus `json:"storageEngineMigration,omitempty"`
+
+	// StorageAutoscaling contains information about the scaling of the storage process groups. This will only be set
+	// if the storage autoscaling is enabled.
+	StorageAutoscaling *StorageAutoscalingStatus `json:"storageAutoscaling,omitempty"`
+
+	// StatelessAutoscaling contains information about the scaling of the commit proxies, grv proxies and resolvers.
+	// This will only be set if the stateless autoscaling is enabled.
+	StatelessAutoscaling *StatelessAutoscalingStatus `json:"statelessAutoscaling,omitempty"`
+
+	// Knobs contains the knobs that are in effect for the processes of each process class.
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=map
+	// +listMapKey=processClass
+	Knobs []ProcessClassKnobStatus `json:"knobs,omitempty"`
+}
+
+// MaintenanceModeInfo contains information regarding the zone and process groups that are put
+// into maintenance mode by the operator
+type MaintenanceModeInfo struct {
+	// StartTimestamp provides the timestamp when this zone is put into maintenance mode
+	// Deprecated: This setting it not used anymore.
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// ZoneID that is placed in maintenance mode
+	ZoneID FaultDomain `json:"zoneID,omitempty"`
+	// ProcessGroups that are placed in maintenance mode
+	// +kubebuilder:validation:MaxItems=200
+	// Deprecated: This setting it not used anymore.
+	ProcessGroups []string `json:"processGroups,omitempty"`
+}
+
+// BlockingReconcilerInfo contains information about the sub-reconciler that blocked the reconciliation of the cluster.
+type BlockingReconcilerInfo struct {
+	// SubReconciler is the type of the sub-reconciler that blocked the reconciliation.
+	SubReconciler string `json:"subReconciler,omitempty"`
+
+	// Message contains the message or the error that was returned by the sub-reconciler.
+	Message string `json:"message,omitempty"`
+
+	// FirstSeen is the time when the sub-reconciler blocked the reconciliation for the first time.
+	FirstSeen *metav1.Time `json:"firstSeen,omitempty"`
+
+	// Count is the number of consecutive reconciliations that were blocked by this sub-reconciler.
+	Count int `json:"count,omitempty"`
+}
+
+// LockSystemStatus provides a summary of the status of the locking system.
+type LockSystemStatus struct {
+	// DenyList contains a list of operator instances that are prevented
+	// from taking locks.
+	DenyList []string `json:"lockDenyList,omitempty"`
+}
+
+// ProcessGroupStatus represents the status of a ProcessGroup.
+type ProcessGroupStatus struct {
+	// ProcessGroupID represents the ID of the process group
+	ProcessGroupID ProcessGroupID `json:"processGroupID,omitempty"`
+	// ProcessClass represents the class the process group has.
+	ProcessClass ProcessClass `json:"processClass,omitempty"`
+	// Addresses represents the list of addresses the process group has been known to have.
+	Addresses []string `json:"addresses,omitempty"`
+	// RemoveTimestamp if not empty defines when the process group was marked for removal.
+	RemovalTimestamp *metav1.Time `json:"removalTimestamp,omitempty"`
+	// ExclusionTimestamp defines when the process group has been fully excluded.
+	// This is only used within the reconciliation process, and should not be considered authoritative.
+	ExclusionTimestamp *metav1.Time `json:"exclusionTimestamp,omitempty"`
+	// ExclusionSkipped determines if exclusion has been skipped for a process, which will allow the process group to be removed without exclusion.
+	ExclusionSkipped bool `json:"exclusionSkipped,omitempty"`
+	// ProcessGroupConditions represents a list of degraded conditions that the process group is in.
+	ProcessGroupConditions []*ProcessGroupCondition `json:"processGroupConditions,omitempty"`
+	// FaultDomain represents the last seen fault domain from the cluster status. This can be used if a Pod or process
+	// is not running and would be missing in the cluster status.
+	FaultDomain FaultDomain `json:"faultDomain,omitempty"`
+}
+
+// FaultDomain represents the FaultDomain of a process group
+// +kubebuilder:validation:MaxLength=512
+type FaultDomain string
+
+// ProcessGroupID represents the ID of the process group
+// +kubebuilder:validation:MaxLength=63
+// +kubebuilder:validation:Pattern:=^(([\w-]+)-(\d+)|\*)$
+type ProcessGroupID string
+
+// ProcessGroupCondition represents a degraded condition that a process group is in.
+type ProcessGroupCondition struct {
+	// Name of the condition
+	ProcessGroupConditionType ProcessGroupConditionType `json:"type,omitempty"`
+	// Timestamp when the Condition was observed
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
+
+// ProcessGroupConditionType represents a concrete ProcessGroupCondition.
+type ProcessGroupConditionType string
+
+const (
+	// IncorrectPodSpec represents a process group that has an incorrect Pod spec.
+	IncorrectPodSpec ProcessGroupConditionType = "IncorrectPodSpec"
+	// IncorrectConfigMap represents a process group that has an incorrect ConfigMap.
+	IncorrectConfigMap ProcessGroupConditionType = "IncorrectConfigMap"
+	// IncorrectCommandLine represents a process group that has an incorrect commandline configuration.
+	IncorrectCommandLine ProcessGroupConditionType = "IncorrectCommandLine"
+	// PodFailing represents a process group which Pod keeps failing.
+	PodFailing ProcessGroupConditionType = "PodFailing"
+	// MissingPod represents a process group that doesn't have a Pod assigned.
+	MissingPod ProcessGroupConditionType = "MissingPod"
+	// MissingPVC represents a process group that doesn't have a PVC assigned.
+	MissingPVC ProcessGroupConditionType = "MissingPVC"
+	// MissingService represents a process group that doesn't have a Service assigned.
+	MissingService ProcessGroupConditionType = "MissingService"
+	// MissingProcesses represents a process group that misses a process.
+	MissingProcesses ProcessGroupConditionType = "MissingProcesses"
+	// ResourcesTerminating represents a process group whose resources are being
+	// terminated.
+	ResourcesTerminating ProcessGroupConditionType = "ResourcesTerminating"
+	// SidecarUnreachable represents a process group where the sidecar is not reachable
+	// because of networking or TLS issues.
+	SidecarUnreachable ProcessGroupConditionType = "SidecarUnreachable"
+	// PodPending represents a process group where the pod is in a pending state.
+	PodPending ProcessGroupConditionType = "PodPending"
+	// ReadyCondition is currently only used in the metrics.
+	ReadyCondition ProcessGroupConditionType = "Ready"
+	// NodeTaintDetected represents a Pod's node is tainted but not long enough for operator to replace it.
+	// If a node is tainted with a taint that shouldn't trigger replacements, NodeTaintDetected won't be added to the pod
+	NodeTaintDetected ProcessGroupConditionType = "NodeTaintDetected"
+	// NodeTaintReplacing represents a Pod whose node has been tainted and the operator should replace the Pod
+	NodeTaintReplacing ProcessGroupConditionType = "NodeTaintReplacing"
+	// ProcessIsMarkedAsExcluded represents a process group where at least one process is excluded.
+	ProcessIsMarkedAsExcluded ProcessGroupConditionType = "ProcessIsMarkedAsExcluded"
+	// ResizingPVC represents a process group whose PVC is expanded in place to the requested storage size.
+	ResizingPVC ProcessGroupConditionType = "ResizingPVC"
+)
+
+// ClusterGenerationStatus stores information on which generations have reached
+// different stages in reconciliation for the cluster.
+type ClusterGenerationStatus struct {
+	// Reconciled provides the last generation that was fully reconciled.
+	Reconciled int64 `json:"reconciled,omitempty"`
+
+	// NeedsConfigurationChange provides the last generation that is pending
+	// a change to configuration.
+	NeedsConfigurationChange int64 `json:"needsConfigurationChange,omitempty"`
+
+	// NeedsCoordinatorChange provides the last generation that is pending
+	// a change to its coordinators.
+	NeedsCoordinatorChange int64 `json:"needsCoordinatorChange,omitempty"`
+
+	// NeedsBounce provides the last generation that is pending a bounce of
+	// fdbserver.
+	NeedsBounce int64 `json:"needsBounce,omitempty"`
+
+	// NeedsPodDeletion provides the last generation that is pending pods being
+	// deleted and recreated.
+	NeedsPodDeletion int64 `json:"needsPodDeletion,omitempty"`
+
+	// NeedsShrink provides the last generation that is pending pods being
+	// excluded and removed.
+	NeedsShrink int64 `json:"needsShrink,omitempty"`
+
+	// NeedsGrow provides the last generation that is pending pods being
+	// added.
+	NeedsGrow int64 `json:"needsGrow,omitempty"`
+
+	// NeedsMonitorConfUpdate provides the last generation that needs an update
+	// through the fdbmonitor conf.
+	NeedsMonitorConfUpdate int64 `json:"needsMonitorConfUpdate,omitempty"`
+
+	// DatabaseUnavailable provides the last generation that could not
+	// complete reconciliation due to the database being unavailable.
+	DatabaseUnavailable int64 `json:"missingDatabaseStatus,omitempty"`
+
+	// HasExtraListeners provides the last generation that could not
+	// complete reconciliation because it has more listeners than it is supposed
+	// to.
+	HasExtraListeners int64 `json:"hasExtraListeners,omitempty"`
+
+	// NeedsServiceUpdate provides the last generation that needs an update
+	// to the service config.
+	NeedsServiceUpdate int64 `json:"needsServiceUpdate,omitempty"`
+
+	// HasPendingRemoval provides the last generation that has pods that have
+	// been excluded but are pending being removed.
+	//
+	// A cluster in this state is considered reconciled, but we track this in
+	// the status to allow users of the operator to track when the removal
+	// is fully complete.
+	HasPendingRemoval int64 `json:"hasPendingRemoval,omitempty"`
+
+	// HasUnhealthyProcess provides the last generation that has at least one
+	// process group with a negative condition.
+	HasUnhealthyProcess int64 `json:"hasUnhealthyProcess,omitempty"`
+
+	// NeedsLockConfigurationChanges provides the last generation that is
+	// pending a change to the configuration of the locking system.
+	NeedsLockConfigurationChanges int64 `json:"needsLockConfigurationChanges,omitempty"`
+}
+
+// ClusterHealth represents different views into health in the cluster status.
+type ClusterHealth struct {
+	// Available reports whether the database is accepting reads and writes.
+	Available bool `json:"available,omitempty"`
+
+	// Healthy reports whether the database is in a fully healthy state.
+	Healthy bool `json:"healthy,omitempty"`
+
+	// FullReplication reports whether all data are fully replicated according
+	// to the current replication policy.
+	FullReplication bool `json:"fullReplication,omitempty"`
+
+	// DataMovementPriority reports the priority of the highest-priority data
+	// movement in the cluster.
+	DataMovementPriority int `json:"dataMovementPriority,omitempty"`
+}
+
+// FoundationDBClusterAutomationOptions provides flags for enabling or disabling
+// operations that can be performed on a cluster.
+type FoundationDBClusterAutomationOptions struct {
+	// ConfigureDatabase defines whether the operator is allowed to reconfigure
+	// the database.
+	ConfigureDatabase *bool `json:"configureDatabase,omitempty"`
+
+	// KillProcesses defines whether the operator is allowed to bounce fdbserver
+	// processes.
+	KillProcesses *bool `json:"killProcesses,omitempty"`
+
+	// CacheDatabaseStatusForReconciliation defines whether the operator is using the same FoundationDB machine-readable
+	// status for all sub-reconcilers or if the machine-readable status should be fetched by ever sub-reconciler if
+	// required. Enabling this setting might improve the operator reconciliation speed for large clusters.
+	CacheDatabaseStatusForReconciliation *bool `json:"cacheDatabaseStatusForReconciliation,omitempty"`
+
+	// Replacements contains options for automatically replacing failed
+	// processes.
+	Replacements AutomaticReplacementOptions `json:"replacements,omitempty"`
+
+	// IgnorePendingPodsDuration defines how long a Pod has to be in the Pending Phase before
+	// ignore it during reconciliation. This prevents Pod that are stuck in Pending to block
+	// further reconciliation.
+	IgnorePendingPodsDuration time.Duration `json:"ignorePendingPodsDuration,omitempty"`
+
+	// UseNonBlockingExcludes defines whether the operator is allowed to use non blocking exclude commands.
+	// The default is false.
+	UseNonBlockingExcludes *bool `json:"useNonBlockingExcludes,omitempty"`
+
+	// UseLocalitiesForExclusion defines whether the exclusions are done using localities instead of IP addresses. This
+	// feature requires at least FDB 7.1.42 or 7.3.26.
+	// The default is false.
+	UseLocalitiesForExclusion *bool `json:"useLocalitiesForExclusion,omitempty"`
+
+	// IgnoreTerminatingPodsSeconds defines how long a Pod has to be in the Terminating Phase before
+	// we ignore it during reconciliation. This prevents Pod that are stuck in Terminating to block
+	// further reconciliation.
+	IgnoreTerminatingPodsSeconds *int `json:"ignoreTerminatingPodsSeconds,omitempty"`
+
+	// IgnoreMissingProcessesSeconds defines how long a process group has to be in the MissingProcess condition until
+	// it will be ignored during reconciliation. This prevents that a process will block reconciliation.
+	IgnoreMissingProcessesSeconds *int `json:"ignoreMissingProcessesSeconds,omitempty"`
+
+	// FailedPodDurationSeconds defines the duration a Pod can stay in the deleted state (deletionTimestamp != 0) before
+	// it gets marked as PodFailed. This is important in cases where a fdbserver process is still reporting but the
+	// Pod resource is marked for deletion. This can happen when the kubelet or a node fails. Setting this condition
+	// will ensure that the operator is replacing affected Pods.
+	FailedPodDurationSeconds *int `json:"failedPodDurationSeconds,omitempty"`
+
+	// MaxConcurrentMisconfiguredReplacements defines how many process groups can be concurrently
+	// replaced if they are misconfigured. If the value will be set to 0 this will block replacements
+	// and these misconfigured Pods must be replaced manually or by another process. For each reconcile
+	// loop the operator calculates the maximum number of possible replacements by taken this value as the
+	// upper limit and removes all ongoing replacements that have not finished. Which means if the value is
+	// set to 5 and we have 4 ongoing replacements (process groups marked with remove but not excluded) the
+	// operator is allowed to replace on further process group. The limit for automatic replacements of
+	// failed process groups is defined in Replacements.MaxConcurrentReplacements.
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentMisconfiguredReplacements *int `json:"maxConcurrentMisconfiguredReplacements,omitempty"`
+
+	// DeletionMode defines the deletion mode for this cluster. This can be
+	// PodUpdateModeNone, PodUpdateModeAll, PodUpdateModeZone or PodUpdateModeProcessGroup. The
+	// DeletionMode defines how Pods are deleted in order to update them or
+	// when they are removed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=All;Zone;ProcessGroup;None
+	// +kubebuilder:default:=Zone
+	DeletionMode PodUpdateMode `json:"deletionMode,omitempty"`
+
+	// RemovalMode defines the removal mode for this cluster. This can be
+	// PodUpdateModeNone, PodUpdateModeAll, PodUpdateModeZone or PodUpdateModeProcessGroup. The
+	// RemovalMode defines how process groups are deleted in order when they
+	// are marked for removal.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=All;Zone;ProcessGroup;None
+	// +kubebuilder:default:=Zone
+	RemovalMode PodUpdateMode `json:"removalMode,omitempty"`
+
+	// WaitBetweenRemovalsSeconds defines how long to wait between the last removal and the next removal. This is only an
+	// upper limit if the process group and the according resources are deleted faster than the provided duration the
+	// operator will move on with the next removal. The idea is to prevent a race condition were the operator deletes
+	// a resource but the Kubernetes API is slower to trigger the actual deletion, and we are running into a situation
+	// where the fault tolerance check still includes the already deleted processes.
+	// Defaults to 60.
+	WaitBetweenRemovalsSeconds *int `json:"waitBetweenRemovalsSeconds,omitempty"`
+
+	// PodUpdateStrategy defines how Pod spec changes are rolled out either by replacing Pods or by deleting Pods.
+	// The default for this is ReplaceTransactionSystem.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Replace;ReplaceTransactionSystem;Delete
+	// +kubebuilder:default:=ReplaceTransactionSystem
+	PodUpdateStrategy PodUpdateStrategy `json:"podUpdateStrategy,omitempty"`
+
+	// UseManagementAPI defines if the operator should make use of the management API instead of
+	// using fdbcli to interact with the FoundationDB cluster.
+	UseManagementAPI *bool `json:"useManagementAPI,omitempty"`
+
+	// MaintenanceModeOptions contains options for maintenance mode related settings.
+	MaintenanceModeOptions MaintenanceModeOptions `json:"maintenanceModeOptions,omitempty"`
+
+	// IgnoreLogGroupsForUpgrade defines the list of LogGroups that should be ignored during fdb version upgrade.
+	// The default is a list that includes "fdb-kubernetes-operator".
+	// +kubebuilder:validation:MaxItems=10
+	IgnoreLogGroupsForUpgrade []LogGroup `json:"ignoreLogGroupsForUpgrade,omitempty"`
+
+	// SuspendedSubReconcilers defines the sub-reconcilers or classes of sub-reconcilers that should not be executed
+	// for this cluster, e.g. to prevent coordinator changes or removals during an incident. The status will still be
+	// refreshed. In contrast to Skip all other sub-reconcilers will continue to run.
+	// +kubebuilder:validation:MaxItems=30
+	SuspendedSubReconcilers []SubReconcilerName `json:"suspendedSubReconcilers,omitempty"`
+
+	// MaintenanceWindowOptions defines the time windows in which the operator is allowed to perform disruptive
+	// operations like bounces, Pod recreations, exclusions and removals.
+	MaintenanceWindowOptions MaintenanceWindowOptions `json:"maintenanceWindowOptions,omitempty"`
+
+	// CanaryOptions defines if changes to the Pod spec, the process configuration or the version should be rolled
+	// out to a subset of process groups first.
+	CanaryOptions CanaryOptions `json:"canaryOptions,omitempty"`
+
+	// UpgradeRollbackOptions defines if protocol compatible upgrades that stall should be rolled back automatically.
+	UpgradeRollbackOptions UpgradeRollbackOptions `json:"upgradeRollbackOptions,omitempty"`
+
+	// RegionFailoverOptions defines if the operator should fail over to another region if the primary data center is
+	// unhealthy.
+	RegionFailoverOptions RegionFailoverOptions `json:"regionFailoverOptions,omitempty"`
+
+	// StorageEngineMigrationOptions defines if the operator should replace storage process groups that use a different
+	// storage engine than the configured storage engine.
+	StorageEngineMigrationOptions StorageEngineMigrationOptions `json:"storageEngineMigrationOptions,omitempty"`
+
+	// StorageAutoscalingOptions defines if and how the operator scales the number of storage process groups based on
+	// the disk utilization of the storage servers.
+	StorageAutoscalingOptions StorageAutoscalingOptions `json:"storageAutoscalingOptions,omitempty"`
+
+	// StatelessAutoscalingOptions defines if and how the operator scales the number of commit proxies, grv proxies
+	// and resolvers based on the workload of the database.
+	StatelessAutoscalingOptions StatelessAutoscalingOptions `json:"statelessAutoscalingOptions,omitempty"`
+}
+
+// LogGroup represents a LogGroup used by a FoundationDB process to log trace events. The LogGroup can be used to filter
+// clients during an upgrade.
+// +kubebuilder:validation:MaxLength=256
+type LogGroup string
+
+// SubReconcilerName represents the name of a sub-reconciler of the cluster reconciler or the name of a class of
+// sub-reconcilers.
+// +kubebuilder:validation:Enum=UpdateLockConfiguration;UpdateConfigMap;CheckClientCompatibility;FailoverRegion;RollbackUpgrade;UpdateCanary;DeletePodsForBuggification;ReplaceMisconfiguredProcessGroups;ReplaceFailedProcessGroups;MigrateStorageEngine;AutoscaleStorage;AutoscaleStateless;AddProcessGroups;AddServices;AddPVCs;ResizePVCs;AddPods;GenerateInitialClusterFile;RemoveIncompatibleProcesses;UpdateSidecarVersions;UpdatePodConfig;UpdateMetadata;UpdateDatabaseConfiguration;UpdateKnobs;ChooseRemovals;ExcludeProcesses;ChangeCoordinators;BounceProcesses;MaintenanceModeChecker;UpdatePods;RemoveProcessGroups;RemoveServices;Replacements;Removals;PodUpdates
+type SubReconcilerName string
+
+// MaintenanceModeOptions controls options for placing zones in maintenance mode.
+type MaintenanceModeOptions struct {
+	// UseMaintenanceModeChecker defines whether the operator is allowed to use maintenance mode before updating pods.
+	// Default is false.
+	UseMaintenanceModeChecker *bool `json:"useMaintenanceModeChecker,omitempty"`
+
+	// MaintenanceModeTimeSeconds provides the duration for the zone to be in maintenance. It will automatically be switched off after the time elapses.
+	// Default is 600.
+	MaintenanceModeTimeSeconds *int `json:"maintenanceModeTimeSeconds,omitempty"`
+}
+
+// MaintenanceWindowOptions controls when the operator is allowed to perform disruptive operations. Outside of the
+// allowed windows the disruptive sub-reconcilers will be deferred, all other sub-reconcilers continue to run.
+type MaintenanceWindowOptions struct {
+	// Windows defines the time windows in which disruptive operations are allowed. If no windows are defined,
+	// disruptive operations are allowed at any time, except during the deny windows.
+	// +kubebuilder:validation:MaxItems=20
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+
+	// DenyWindows defines blackout periods in which no disruptive operations are allowed, even if they overlap with
+	// one of the windows.
+	// +kubebuilder:validation:MaxItems=20
+	DenyWindows []MaintenanceWindow `json:"denyWindows,omitempty"`
+
+	// TimeZone defines the IANA time zone that is used to evaluate the schedules of the windows, e.g. "Europe/Berlin".
+	// Default is UTC.
+	// +kubebuilder:validation:MaxLength=64
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// AllowFailedReplacementsOutsideWindow defines if the exclusion and removal of process groups that were
+	// replaced because they failed is allowed outside of the windows. This only takes effect if all process groups
+	// that are marked for removal have failed.
+	// Default is false.
+	AllowFailedReplacementsOutsideWindow *bool `json:"allowFailedReplacementsOutsideWindow,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window.
+type MaintenanceWindow struct {
+	// Schedule defines the start of the window in the standard cron format, e.g. "0 2 * * 1-5" for 2am on every
+	// weekday.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Schedule string `json:"schedule"`
+
+	// DurationSeconds defines how long the window lasts after its start.
+	// +kubebuilder:validation:Minimum=1
+	DurationSeconds int `json:"durationSeconds"`
+}
+
+// MaintenanceWindowStatus provides information about the maintenance windows of the cluster.
+type MaintenanceWindowStatus struct {
+	// DisruptionAllowed defines if disruptive operations were allowed during the last reconciliation.
+	DisruptionAllowed bool `json:"disruptionAllowed"`
+
+	// NextWindowStart defines when disruptive operations will be allowed next.
+	NextWindowStart *metav1.Time `json:"nextWindowStart,omitempty"`
+
+	// DeferredSubReconcilers contains the sub-reconcilers that were deferred during the last reconciliation because
+	// they are not allowed to run outside of the maintenance windows.
+	DeferredSubReconcilers []SubReconcilerName `json:"deferredSubReconcilers,omitempty"`
+}
+
+// CanaryOptions controls how changes are rolled out to a subset of process groups before they are rolled out to the
+// whole cluster.
+type CanaryOptions struct {
+	// Enabled defines if changes should be rolled out to the canary process groups first.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Mode defines how the canary process groups are selected.
+	// Default is ProcessGroups.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ProcessGroups;FaultDomain
+	Mode CanaryMode `json:"mode,omitempty"`
+
+	// ProcessGroupCount defines the number of process groups per process class that are updated in the canary phase
+	// if the mode is ProcessGroups.
+	// Default is 1.
+	// +kubebuilder:validation:Minimum=1
+	ProcessGroupCount *int `json:"processGroupCount,omitempty"`
+
+	// SoakTimeSeconds defines how long the canary process groups must be healthy before the rollout continues.
+	// Default is 600.
+	// +kubebuilder:validation:Minimum=0
+	SoakTimeSeconds *int `json:"soakTimeSeconds,omitempty"`
+
+	// ManualPromotion defines if the rollout should be halted after the soak time until it is promoted by setting
+	// the foundationdb.org/promote-canary annotation.
+	// Default is false.
+	ManualPromotion *bool `json:"manualPromotion,omitempty"`
+}
+
+// CanaryMode defines how the canary process groups are selected.
+// +kubebuilder:validation:MaxLength=32
+type CanaryMode string
+
+const (
+	// CanaryModeProcessGroups selects the configured number of process groups per process class as canaries.
+	CanaryModeProcessGroups CanaryMode = "ProcessGroups"
+	// CanaryModeFaultDomain selects the process groups of one fault domain per process class as canaries.
+	CanaryModeFaultDomain CanaryMode = "FaultDomain"
+)
+
+// CanaryPhase represents the phase of a canary rollout.
+// +kubebuilder:validation:MaxLength=32
+type CanaryPhase string
+
+const (
+	// CanaryPhaseUpdating represents a canary rollout where the canary process groups are being updated.
+	CanaryPhaseUpdating CanaryPhase = "Updating"
+	// CanaryPhaseSoaking represents a canary rollout where the canary process groups are updated and the operator
+	// waits for the soak time.
+	CanaryPhaseSoaking CanaryPhase = "Soaking"
+	// CanaryPhaseAwaitingPromotion represents a canary rollout that passed the soak time and waits for the manual
+	// promotion.
+	CanaryPhaseAwaitingPromotion CanaryPhase = "AwaitingPromotion"
+	// CanaryPhaseHalted represents a canary rollout that failed the health checks during the soak time.
+	CanaryPhaseHalted CanaryPhase = "Halted"
+	// CanaryPhasePromoted represents a canary rollout that is promoted and will be rolled out to all process groups.
+	CanaryPhasePromoted CanaryPhase = "Promoted"
+)
+
+// CanaryStatus provides information about a canary rollout.
+type CanaryStatus struct {
+	// Generation defines the generation of the cluster spec that is rolled out.
+	Generation int64 `json:"generation"`
+
+	// Phase defines the current phase of the canary rollout.
+	Phase CanaryPhase `json:"phase"`
+
+	// ProcessGroups contains the process groups that are updated in the canary phase.
+	// +kubebuilder:validation:MaxItems=1000
+	ProcessGroups []ProcessGroupID `json:"processGroups,omitempty"`
+
+	// SoakStartTime defines when the canary process groups were updated and the soak time started.
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+
+	// Message provides more details about the current phase.
+	Message string `json:"message,omitempty"`
+}
+
+// UpgradeRollbackOptions controls if the operator rolls back protocol compatible upgrades that stall.
+type UpgradeRollbackOptions struct {
+	// Enabled defines if the operator should roll back a protocol compatible upgrade that didn't finish within the
+	// stall timeout. Version incompatible upgrades will never be rolled back.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// StallTimeoutSeconds defines how long a protocol compatible upgrade can take before it is considered stalled.
+	// Default is 3600.
+	// +kubebuilder:validation:Minimum=60
+	StallTimeoutSeconds *int `json:"stallTimeoutSeconds,omitempty"`
+}
+
+// UpgradeStatus provides information about a protocol compatible upgrade in progress.
+type UpgradeStatus struct {
+	// Version defines the version the cluster is upgraded to.
+	Version string `json:"version"`
+
+	// PreviousVersion defines the version the cluster was running before the upgrade started.
+	PreviousVersion string `json:"previousVersion"`
+
+	// StartTime defines when the operator detected the upgrade.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// UpgradeRollbackStatus provides information about an upgrade that was rolled back.
+type UpgradeRollbackStatus struct {
+	// FailedVersion defines the version of the upgrade that was rolled back. This version is locked out until the
+	// rollback is acknowledged with the foundationdb.org/acknowledge-upgrade-rollback annotation.
+	FailedVersion string `json:"failedVersion"`
+
+	// PreviousVersion defines the version the cluster was rolled back to.
+	PreviousVersion string `json:"previousVersion"`
+
+	// Reason provides details why the upgrade was rolled back.
+	Reason string `json:"reason,omitempty"`
+
+	// Timestamp defines when the upgrade was rolled back.
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
+// RegionFailoverOptions controls if the operator fails over to another region if the primary data center is unhealthy.
+type RegionFailoverOptions struct {
+	// Enabled defines if the operator should fail over to another region if no process of the primary data center is
+	// reporting to the cluster. The failover is only performed for clusters with usable_regions set to 2 and if the
+	// operator is allowed to configure the database.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// FailoverDelaySeconds defines how long the primary data center must be unhealthy before the operator fails over.
+	// The same delay is used for the original primary data center to be healthy before the operator fails back.
+	// Default is 300.
+	// +kubebuilder:validation:Minimum=30
+	FailoverDelaySeconds *int `json:"failoverDelaySeconds,omitempty"`
+
+	// MaximumDataCenterLagSeconds defines the maximum lag of the remote data center. The operator will not fail over or
+	// fail back if the remote data center is further behind.
+	// Default is 10.
+	// +kubebuilder:validation:Minimum=0
+	MaximumDataCenterLagSeconds *int `json:"maximumDataCenterLagSeconds,omitempty"`
+
+	// Failback defines if the operator should fail back to the original primary data center once it is healthy again
+	// and has caught up. If disabled the failover stays active until the region priorities in the spec are updated.
+	// Default is false.
+	Failback *bool `json:"failback,omitempty"`
+}
+
+// RegionFailoverStatus provides information about an unhealthy primary data center and a region failover performed by
+// the operator.
+type RegionFailoverStatus struct {
+	// PrimaryUnhealthySince defines since when the primary data center is unhealthy.
+	PrimaryUnhealthySince *metav1.Time `json:"primaryUnhealthySince,omitempty"`
+
+	// OriginalPrimary defines the primary data center from the spec before the operator failed over.
+	OriginalPrimary string `json:"originalPrimary,omitempty"`
+
+	// ActivePrimary defines the data center the operator failed over to. The active primary will have the highest
+	// priority in the database configuration until the operator fails back or the spec is updated.
+	ActivePrimary string `json:"activePrimary,omitempty"`
+
+	// FailoverTime defines when the operator failed over.
+	FailoverTime *metav1.Time `json:"failoverTime,omitempty"`
+
+	// OriginalPrimaryHealthySince defines since when the original primary data center is healthy again and has caught
+	// up with the active primary.
+	OriginalPrimaryHealthySince *metav1.Time `json:"originalPrimaryHealthySince,omitempty"`
+
+	// Message provides details about the last failover decision of the operator.
+	Message string `json:"message,omitempty"`
+}
+
+// StorageWiggleStatus contains information about the progress of the perpetual storage wiggle in the primary region.
+type StorageWiggleStatus struct {
+	// WigglingServerIDs contains the IDs of the storage servers that are currently wiggled.
+	// +kubebuilder:validation:MaxItems=100
+	WigglingServerIDs []string `json:"wigglingServerIDs,omitempty"`
+
+	// WigglingServerAddresses contains the addresses of the storage servers that are currently wiggled.
+	// +kubebuilder:validation:MaxItems=100
+	WigglingServerAddresses []string `json:"wigglingServerAddresses,omitempty"`
+
+	// FinishedRounds defines how many rounds of the perpetual storage wiggle have been finished.
+	FinishedRounds int `json:"finishedRounds,omitempty"`
+
+	// FinishedWiggles defines how many storage servers have been wiggled.
+	FinishedWiggles int `json:"finishedWiggles,omitempty"`
+
+	// LastRoundFinishTime defines when the last round of the perpetual storage wiggle was finished.
+	LastRoundFinishTime *metav1.Time `json:"lastRoundFinishTime,omitempty"`
+}
+
+// StorageEngineMigrationOptions defines how the operator migrates storage process groups to the configured storage
+// engine.
+type StorageEngineMigrationOptions struct {
+	// Enabled defines if the operator should replace storage process groups that use a different storage engine than
+	// the configured storage engine. The process groups will be replaced in batches, one fault domain at a time.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaximumInFlightBytes defines the maximum number of bytes that can be actively moved by data distribution before
+	// the operator starts the next batch.
+	// Default is 0.
+	// +kubebuilder:validation:Minimum=0
+	MaximumInFlightBytes *int `json:"maximumInFlightBytes,omitempty"`
+
+	// MaximumInQueueBytes defines the maximum number of bytes that can be pending for data movement before the
+	// operator starts the next batch.
+	// Default is 0.
+	// +kubebuilder:validation:Minimum=0
+	MaximumInQueueBytes *int `json:"maximumInQueueBytes,omitempty"`
+}
+
+// StorageEngineMigrationStatus contains information about the migration of the storage process groups to the
+// configured storage engine.
+type StorageEngineMigrationStatus struct {
+	// StorageEngine defines the storage engine the storage process groups are migrated to.
+	StorageEngine StorageEngine `json:"storageEngine,omitempty"`
+
+	// StorageServers contains the number of storage servers for each storage engine.
+	StorageServers map[StorageEngine]int `json:"storageServers,omitempty"`
+
+	// FaultDomain defines the fault domain of the current batch.
+	FaultDomain FaultDomain `json:"faultDomain,omitempty"`
+
+	// ProcessGroups contains the process groups of the current batch.
+	// +kubebuilder:validation:MaxItems=1000
+	ProcessGroups []ProcessGroupID `json:"processGroups,omitempty"`
+
+	// BatchStartTime defines when the current batch was started.
+	BatchStartTime *metav1.Time `json:"batchStartTime,omitempty"`
+
+	// CompletedBatches defines how many batches have been completed.
+	CompletedBatches int `json:"completedBatches,omitempty"`
+
+	// Message provides details about the current state of the migration.
+	Message string `json:"message,omitempty"`
+}
+
+// StorageAutoscalingOptions defines how the operator scales the number of storage process groups based on the disk
+// utilization of the storage servers.
+type StorageAutoscalingOptions struct {
+	// Enabled defines if the operator should scale the number of storage process groups based on the disk
+	// utilization. If enabled the storage process count is managed by the operator within the defined bounds.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinimumStorageProcessGroups defines the lower bound for the number of storage process groups.
+	// Default is the storage process count of the cluster spec.
+	// +kubebuilder:validation:Minimum=1
+	MinimumStorageProcessGroups *int `json:"minimumStorageProcessGroups,omitempty"`
+
+	// MaximumStorageProcessGroups defines the upper bound for the number of storage process groups.
+	// Default is the minimum number of storage process groups.
+	// +kubebuilder:validation:Minimum=1
+	MaximumStorageProcessGroups *int `json:"maximumStorageProcessGroups,omitempty"`
+
+	// ScaleUpUtilization defines the disk utilization in percent above which the operator adds storage process
+	// groups.
+	// Default is 80.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ScaleUpUtilization *int `json:"scaleUpUtilization,omitempty"`
+
+	// ScaleDownUtilization defines the disk utilization in percent below which the operator removes storage process
+	// groups.
+	// Default is 40.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ScaleDownUtilization *int `json:"scaleDownUtilization,omitempty"`
+
+	// ScaleUpDelaySeconds defines how long the disk utilization must stay above the scale up utilization before the
+	// operator adds storage process groups.
+	// Default is 600.
+	// +kubebuilder:validation:Minimum=0
+	ScaleUpDelaySeconds *int `json:"scaleUpDelaySeconds,omitempty"`
+
+	// ScaleDownDelaySeconds defines how long the disk utilization must stay below the scale down utilization before
+	// the operator removes a storage process group.
+	// Default is 3600.
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownDelaySeconds *int `json:"scaleDownDelaySeconds,omitempty"`
+
+	// CooldownSeconds defines the minimum time between two scaling decisions.
+	// Default is 1800.
+	// +kubebuilder:validation:Minimum=0
+	CooldownSeconds *int `json:"cooldownSeconds,omitempty"`
+}
+
+// StorageAutoscalingStatus contains information about the scaling of the storage process groups.
+type StorageAutoscalingStatus struct {
+	// DesiredStorageProcessGroups defines the number of storage process groups chosen by the autoscaler.
+	DesiredStorageProcessGroups int `json:"desiredStorageProcessGroups,omitempty"`
+
+	// Utilization defines the disk utilization in percent of all storage servers of the last check.
+	Utilization int `json:"utilization,omitempty"`
+
+	// KVBytes defines the total key-value size of the database of the last check.
+	KVBytes int `json:"kvBytes,omitempty"`
+
+	// HighUtilizationSince defines since when the disk utilization is above the scale up utilization.
+	HighUtilizationSince *metav1.Time `json:"highUtilizationSince,omitempty"`
+
+	// LowUtilizationSince defines since when the disk utilization is below the scale down utilization.
+	LowUtilizationSince *metav1.Time `json:"lowUtilizationSince,omitempty"`
+
+	// Decisions contains the most recent scaling decisions, the latest decision is the last entry.
+	// +kubebuilder:validation:MaxItems=10
+	Decisions []StorageAutoscalingDecision `json:"decisions,omitempty"`
+}
+
+// StorageAutoscalingDecision describes a single scaling decision of the storage autoscaler.
+type StorageAutoscalingDecision struct {
+	// Timestamp defines when the decision was made.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// From defines the number of storage process groups before the decision.
+	From int `json:"from"`
+
+	// To defines the number of storage process groups after the decision.
+	To int `json:"to"`
+
+	// Utilization defines the disk utilization in percent that led to the decision.
+	Utilization int `json:"utilization"`
+
+	// KVBytes defines the total key-value size of the database when the decision was made.
+	KVBytes int `json:"kvBytes,omitempty"`
+}
+
+// StatelessAutoscalingOptions defines how the operator scales the number of commit proxies, grv proxies and resolvers
+// based on the workload of the database.
+type StatelessAutoscalingOptions struct {
+	// Enabled defines if the operator should scale the number of commit proxies, grv proxies and resolvers based on
+	// the workload of the database. This requires that the commit proxies and grv proxies are configured in the
+	// database configuration.
+	// Default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CommitProxies defines the bounds for the number of commit proxies.
+	CommitProxies RoleCountLimits `json:"commitProxies,omitempty"`
+
+	// GrvProxies defines the bounds for the number of grv proxies.
+	GrvProxies RoleCountLimits `json:"grvProxies,omitempty"`
+
+	// Resolvers defines the bounds for the number of resolvers.
+	Resolvers RoleCountLimits `json:"resolvers,omitempty"`
+
+	// CommittedTransactionsPerCommitProxy defines how many committed transactions per second a single commit proxy
+	// should handle.
+	// Default is 5000.
+	// +kubebuilder:validation:Minimum=1
+	CommittedTransactionsPerCommitProxy *int `json:"committedTransactionsPerCommitProxy,omitempty"`
+
+	// StartedTransactionsPerGrvProxy defines how many started transactions per second a single grv proxy should
+	// handle.
+	// Default is 20000.
+	// +kubebuilder:validation:Minimum=1
+	StartedTransactionsPerGrvProxy *int `json:"startedTransactionsPerGrvProxy,omitempty"`
+
+	// ResolvedTransactionsPerResolver defines how many resolved transactions per second, committed and conflicted,
+	// a single resolver should handle.
+	// Default is 10000.
+	// +kubebuilder:validation:Minimum=1
+	ResolvedTransactionsPerResolver *int `json:"resolvedTransactionsPerResolver,omitempty"`
+
+	// MaximumProxyCPUUtilization defines the average CPU utilization in percent of a core above which the operator
+	// adds commit proxies or grv proxies, independent of the transaction rates.
+	// Default is 70.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MaximumProxyCPUUtilization *int `json:"maximumProxyCPUUtilization,omitempty"`
+
+	// ScaleDownHysteresis defines in percent how far the workload must be below the capacity of the current role
+	// counts before the operator removes roles. This prevents the role counts from flapping.
+	// Default is 20.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90
+	ScaleDownHysteresis *int `json:"scaleDownHysteresis,omitempty"`
+
+	// CooldownSeconds defines the minimum time between two changes of the role counts.
+	// Default is 300.
+	// +kubebuilder:validation:Minimum=0
+	CooldownSeconds *int `json:"cooldownSeconds,omitempty"`
+}
+
+// RoleCountLimits defines the bounds for the number of processes of a role.
+type RoleCountLimits struct {
+	// Minimum defines the lower bound for the role count.
+	// Default is the role count of the database configuration.
+	// +kubebuilder:validation:Minimum=1
+	Minimum *int `json:"minimum,omitempty"`
+
+	// Maximum defines the upper bound for the role count.
+	// Default is the minimum.
+	// +kubebuilder:validation:Minimum=1
+	Maximum *int `json:"maximum,omitempty"`
+}
+
+// StatelessAutoscalingStatus contains information about the scaling of the commit proxies, grv proxies and resolvers.
+type StatelessAutoscalingStatus struct {
+	// CommitProxies defines the number of commit proxies chosen by the autoscaler.
+	CommitProxies int `json:"commitProxies,omitempty"`
+
+	// GrvProxies defines the number of grv proxies chosen by the autoscaler.
+	GrvProxies int `json:"grvProxies,omitempty"`
+
+	// Resolvers defines the number of resolvers chosen by the autoscaler.
+	Resolvers int `json:"resolvers,omitempty"`
+
+	// StartedTransactionsPerSecond defines the rate of started transactions of the last check.
+	StartedTransactionsPerSecond int `json:"startedTransactionsPerSecond,omitempty"`
+
+	// CommittedTransactionsPerSecond defines the rate of committed transactions of the last check.
+	CommittedTransactionsPerSecond int `json:"committedTransactionsPerSecond,omitempty"`
+
+	// ConflictRate defines the percentage of resolved transactions that conflicted in the last check.
+	ConflictRate int `json:"conflictRate,omitempty"`
+
+	// CommitProxyCPUUtilization defines the average CPU utilization of the commit proxies in percent of a core of
+	// the last check.
+	CommitProxyCPUUtilization int `json:"commitProxyCPUUtilization,omitempty"`
+
+	// GrvProxyCPUUtilization defines the average CPU utilization of the grv proxies in percent of a core of the last
+	// check.
+	GrvProxyCPUUtilization int `json:"grvProxyCPUUtilization,omitempty"`
+
+	// LastScaleTime defines when the role counts were changed the last time.
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
+// TaintReplacementOption defines the taint key and taint duration the operator will react to a tainted node
+// Example of TaintReplacementOption
+//   - key: "example.org/maintenance"
+//     durationInSeconds: 7200 # Ensure the taint is present for at least 2 hours before replacing Pods on a node with this taint.
+//   - key: "*" # The wildcard would allow to define a catch all configuration
+//     durationInSeconds: 3600 # Ensure the taint is present for at least 1 hour before replacing Pods on a node with this taint
+//
+// Setting durationInSeconds to the maximum of int64 will practically disable the taint key.
+// When a Node taint key matches both an exact TaintReplacementOption key and a wildcard key, the exact matched key will be used.
+type TaintReplacementOption struct {
+	// Tainted key
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern:=^([\-._\/a-z0-9A-Z\*])*$
+	// +kubebuilder:validation:Required
+	Key *string `json:"key,omitempty"`
+
+	// The tainted key must be present for DurationInSeconds before operator replaces pods on the node with this taint;
+	// DurationInSeconds cannot be a negative number.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	DurationInSeconds *int64 `json:"durationInSeconds,omitempty"`
+}
+
+// AutomaticReplacementOptions controls options for automatically replacing
+// failed processes.
+type AutomaticReplacementOptions struct {
+	// Enabled controls whether automatic replacements are enabled.
+	// The default is false.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// FaultDomainBasedReplacements controls whether automatic replacements are targeting all failed process groups
+	// in a fault domain or only specific Process Groups. If this setting is enabled, the number of different fault
+	// domains that can have all their failed process groups replaced at the same time will be equal to MaxConcurrentReplacements.
+	// e.g. MaxConcurrentReplacements = 2 would mean that at most 2 different fault domains can have
+	// their failed process groups replaced at the same time.
+	// The default is false.
+	FaultDomainBasedReplacements *bool `json:"faultDomainBasedReplacements,omitempty"`
+
+	// FailureDetectionTimeSeconds controls how long a process must be
+	// failed or missing before it is automatically replaced.
+	// The default is 7200 seconds, or 2 hours.
+	FailureDetectionTimeSeconds *int `json:"failureDetectionTimeSeconds,omitempty"`
+
+	// TaintReplacementTimeSeconds controls how long a pod stays in NodeTaintReplacing condition
+	// before it is automatically replaced.
+	// The default is 1800 seconds, i.e., 30min
+	TaintReplacementTimeSeconds *int `json:"taintReplacementTimeSeconds,omitempty"`
+
+	// MaxConcurrentReplacements controls how many automatic replacements are allowed to take part.
+	// This will take the list of current replacements and then calculate the difference between
+	// maxConcurrentReplacements and the size of the list. e.g. if currently 3 replacements are
+	// queued (e.g. in the processGroupsToRemove list) and maxConcurrentReplacements is 5 the operator
+	// is allowed to replace at most 2 process groups. Setting this to 0 will basically disable the automatic
+	// replacements.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentReplacements *int `json:"maxConcurrentReplacements,omitempty"`
+
+	// TaintReplacementOption controls which taint label the operator will react to.
+	// +kubebuilder:validation:MaxItems=32
+	TaintReplacementOptions []TaintReplacementOption `json:"taintReplacementOptions,omitempty"`
+}
+
+// ProcessSettings defines process-level settings.
+type ProcessSettings struct {
+	// PodTemplate allows customizing the pod. If a container image with a tag is specified the operator
+	// will throw an error and stop processing the cluster.
+	PodTemplate *corev1.PodTemplateSpec `json:"podTemplate,omitempty"`
+
+	// VolumeClaimTemplate allows customizing the persistent volume claim for the
+	// pod.
+	VolumeClaimTemplate *corev1.PersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
+
+	// CustomParameters defines additional parameters to pass to the fdbserver
+	// process.
+	CustomParameters FoundationDBCustomParameters `json:"customParameters,omitempty"`
+
+	// Knobs defines the knobs that should be set for the fdbserver processes. The knobs are validated against the
+	// knob catalog of the operator. Knobs that can be updated on running processes will be applied without a restart
+	// if the version supports it, all other knobs will be passed as command line arguments.
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=name
+	Knobs []FoundationDBKnob `json:"knobs,omitempty"`
+}
+
+// ConnectionString models the contents of a cluster file in a structured way
+type ConnectionString struct {
+	// DatabaseName provides an identifier for the database which persists
+	// across coordinator changes.
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// GenerationID provides a unique ID for the current generation of
+	// coordinators.
+	GenerationID string `json:"generationID,omitempty"`
+
+	// Coordinators provides the addresses of the current coordinators.
+	Coordinators []string `json:"coordinators,omitempty"`
+}
+
+// FoundationDBClusterFaultDomain describes the fault domain that a cluster is
+// replicated across.
+type FoundationDBClusterFaultDomain struct {
+	// Key provides a topology key for the fault domain to replicate across.
+	Key string `json:"key,omitempty"`
+
+	// Value provides a harcoded value to use for the zoneid for the pods.
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom provides a field selector to use as the source of the fault
+	// domain.
+	ValueFrom string `json:"valueFrom,omitempty"`
+
+	// ZoneCount provides the number of fault domains in the data center where
+	// these processes are running. This is only used in the
+	// `kubernetes-cluster` fault domain strategy.
+	ZoneCount int `json:"zoneCount,omitempty"`
+
+	// ZoneIndex provides the index of this Kubernetes cluster in the list of
+	// KCs in the data center. This is only used in the `kubernetes-cluster`
+	// fault domain strategy.
+	ZoneIndex int `json:"zoneIndex,omitempty"`
+}
+
+// ContainerOverrides provides options for customizing a container created by
+// the operator.
+type ContainerOverrides struct {
+	// EnableLivenessProbe defines if the sidecar should have a livenessProbe.
+	// This setting will be ignored on the main container.
+	EnableLivenessProbe *bool `json:"enableLivenessProbe,omitempty"`
+
+	// EnableReadinessProbe defines if the sidecar should have a readinessProbe.
+	// This setting will be ignored on the main container.
+	// Deprecated: Will be removed in the next major release.
+	EnableReadinessProbe *bool `json:"enableReadinessProbe,omitempty"`
+
+	// EnableTLS controls whether we should be listening on a TLS connection.
+	EnableTLS bool `json:"enableTls,omitempty"`
+
+	// PeerVerificationRules provides the rules for what client certificates
+	// the process should accept.
+	// +kubebuilder:validation:MaxLength=10000
+	PeerVerificationRules string `json:"peerVerificationRules,omitempty"`
+
+	// ImageConfigs allows customizing the image that we use for
+	// a container.
+	// +kubebuilder:validation:MaxItems=100
+	ImageConfigs []ImageConfig `json:"imageConfigs,omitempty"`
+}
+
+// LockOptions provides customization for locking global operations.
+type LockOptions struct {
+	// DisableLocks determines whether we should disable locking entirely.
+	DisableLocks *bool `json:"disableLocks,omitempty"`
+
+	// LockKeyPrefix provides a custom prefix for the keys in the database we
+	// use to store locks.
+	LockKeyPrefix string `json:"lockKeyPrefix,omitempty"`
+
+	// LockDurationMinutes determines the duration that locks should be valid
+	// for.
+	LockDurationMinutes *int `json:"lockDurationMinutes,omitempty"`
+
+	// DenyList manages configuration for whether an instance of the operator
+	// should be denied from taking locks.
+	DenyList []LockDenyListEntry `json:"denyList,omitempty"`
+}
+
+// LockDenyListEntry models an entry in the deny list for the locking system.
+type LockDenyListEntry struct {
+	// The ID of the operator instance this entry is targeting.
+	ID string `json:"id,omitempty"`
+
+	// Whether the instance is allowed to take locks.
+	Allow bool `json:"allow,omitempty"`
+}
+
+// RoutingConfig allows configuring routing to our pods, and services that sit
+// in front of them.
+type RoutingConfig struct {
+	// Headless determines whether we want to run a headless service for the
+	// cluster.
+	HeadlessService *bool `json:"headlessService,omitempty"`
+
+	// PublicIPSource specifies what source a process should use to get its
+	// public IPs.
+	//
+	// This supports the values `pod` and `service`.
+	PublicIPSource *PublicIPSource `json:"publicIPSource,omitempty"`
+
+	// PodIPFamily tells the pod which family of IP addresses to use.
+	// You can use 4 to represent IPv4, and 6 to represent IPv6.
+	// This feature is only supported in FDB 7.0 or later, and requires
+	// dual-stack support in your Kubernetes environment.
+	PodIPFamily *int `json:"podIPFamily,omitempty"`
+
+	// UseDNSInClusterFile determines whether to use DNS names rather than IP
+	// addresses to identify coordinators in the cluster file. This requires
+	// FoundationDB 7.0+.
+	UseDNSInClusterFile *bool `json:"useDNSInClusterFile,omitempty"`
+
+	// DefineDNSLocalityFields determines whether to define pod DNS names on pod
+	// specs and provide them in the locality arguments to fdbserver.
+	//
+	// This is ignored if UseDNSInCluster is true.
+	DefineDNSLocalityFields *bool `json:"defineDNSLocalityFields,omitempty"`
+
+	// DNSDomain defines the cluster domain used in a DNS name generated for a
+	// service.
+	// The default is `cluster.local`.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	DNSDomain *string `json:"dnsDomain,omitempty"`
+}
+
+// RequiredAddressSet provides settings for which addresses we need to listen
+// on.
+type RequiredAddressSet struct {
+	// TLS defines whether we need to listen on a TLS address.
+	TLS bool `json:"tls,omitempty"`
+
+	// NonTLS defines whether we need to listen on a non-TLS address.
+	NonTLS bool `json:"nonTLS,omitempty"`
+}
+
+// CrashLoopContainerObject specifies crash-loop target for specific container.
+type CrashLoopContainerObject struct {
+	// Name of the target container.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Target processes to kill inside the container.
+	// +kubebuilder:validation:MinItems=0
+	// +kubebuilder:validation:MaxItems=10000
+	Targets []ProcessGroupID `json:"targets,omitempty"`
+}
+
+// BuggifyConfig provides options for injecting faults into a cluster for testing.
+type BuggifyConfig struct {
+	// NoSchedule defines a list of process group IDs that should fail to schedule.
+	NoSchedule []ProcessGroupID `json:"noSchedule,omitempty"`
+
+	// CrashLoops defines a list of process group IDs that should be put into a
+	// crash looping state.
+	// Deprecated: use CrashLoopContainers instead.
+	CrashLoop []ProcessGroupID `json:"crashLoop,omitempty"`
+
+	// CrashLoopContainers defines a list of process group IDs and containers
+	// that should be put into a crash looping state.
+	// +kubebuilder:validation:MinItems=0
+	// +kubebuilder:validation:MaxItems=8
+	CrashLoopContainers []CrashLoopContainerObject `json:"crashLoopContainers,omitempty"`
+
+	// EmptyMonitorConf instructs the operator to update all of the fdbmonitor.conf
+	// files to have zero fdbserver processes configured.
+	EmptyMonitorConf bool `json:"emptyMonitorConf,omitempty"`
+
+	// IgnoreDuringRestart instructs the operator to ignore the provided process groups IDs during the
+	// restart command. This can be useful to simulate cases where the kill command is not restarting all
+	// processes. IgnoreDuringRestart does not support the wildcard option to ignore all of this specific cluster processes.
+	// +kubebuilder:validation:MaxItems=1000
+	IgnoreDuringRestart []ProcessGroupID `json:"ignoreDuringRestart,omitempty"`
+
+	// BlockRemoval defines a list of process group IDs that will not be removed, even if they are marked for removal.
+	// The operator will trigger the exclusion but the removal of the resources will be blocked until they are removed
+	// from this list. This setting can be used to simulate cases where a process group is marked for removal but the
+	// resources are not yet removed.
+	// +kubebuilder:validation:MaxItems=1000
+	BlockRemoval []ProcessGroupID `json:"blockRemoval,omitempty"`
+}
+
+// LabelConfig allows customizing labels used by the operator.
+type LabelConfig struct {
+	// MatchLabels provides the labels that the operator should use to identify
+	// resources owned by the cluster. These will automatically be applied to
+	// all resources the operator creates.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// ResourceLabels provides additional labels that the operator should apply to
+	// resources it creates.
+	ResourceLabels map[string]string `json:"resourceLabels,omitempty"`
+
+	// ProcessGroupIDLabels provides the labels that we use for the process group ID
+	// field. The first label will be used by the operator when filtering
+	// resources.
+	// +kubebuilder:validation:MaxItems=100
+	ProcessGroupIDLabels []string `json:"processGroupIDLabels,omitempty"`
+
+	// ProcessClassLabels provides the labels that we use for the process class
+	// field. The first label will be used by the operator when filtering
+	// resources.
+	// +kubebuilder:validation:MaxItems=100
+	ProcessClassLabels []string `json:"processClassLabels,omitempty"`
+
+	// FilterOnOwnerReferences determines whether we should check that resources
+	// are owned by the cluster object, in addition to the constraints provided
+	// by the match labels.
+	// Deprecated: This setting will be removed in the next major release.
+	FilterOnOwnerReferences *bool `json:"filterOnOwnerReference,omitempty"`
+}
+
+// PublicIPSource models options for how a pod gets its public IP.
+type PublicIPSource string
+
+const (
+	// PublicIPSourcePod specifies that a pod gets its IP from the pod IP.
+	PublicIPSourcePod PublicIPSource = "pod"
+
+	// PublicIPSourceService specifies that a pod gets its IP from a service.
+	PublicIPSourceService PublicIPSource = "service"
+)
+
+// CoordinatorSelectionSetting defines the process class and the priority of it.
+// A higher priority means that the process class is preferred over another.
+type CoordinatorSelectionSetting struct {
+	// ProcessClass defines the process class to associate with priority with.
+	ProcessClass ProcessClass `json:"processClass,omitempty"`
+	// Priority defines the ordering of different process classes.
+	Priority int `json:"priority,omitempty"`
+}
+
+// PodUpdateMode defines the deletion mode for the cluster
+type PodUpdateMode string
+
+const (
+	// PodUpdateModeAll deletes all process groups at once
+	PodUpdateModeAll PodUpdateMode = "All"
+	// PodUpdateModeZone deletes process groups in the same zone at the same time
+	PodUpdateModeZone PodUpdateMode = "Zone"
+	// PodUpdateModeProcessGroup deletes one process group at a time
+	PodUpdateModeProcessGroup PodUpdateMode = "ProcessGroup"
+	// PodUpdateModeNone defines that the operator is not allowed to update/delete any Pods.
+	PodUpdateModeNone PodUpdateMode = "None"
+)
+
+// PodUpdateStrategy defines how Pod spec changes should be applied.
+type PodUpdateStrategy string
+
+const (
+	// PodUpdateStrategyReplacement replace all Pods if there is a spec change.
+	PodUpdateStrategyReplacement PodUpdateStrategy = "Replace"
+	// PodUpdateStrategyTransactionReplacement replace all transaction system Pods if there is a spec change.
+	PodUpdateStrategyTransactionReplacement PodUpdateStrategy = "ReplaceTransactionSystem"
+	// PodUpdateStrategyDelete delete all Pods if there is a spec change.
+	PodUpdateStrategyDelete PodUpdateStrategy = "Delete"
+)
+
+func init() {
+	SchemeBuilder.Register(&FoundationDBCluster{}, &FoundationDBClusterList{})
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
 	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
 
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
@@ -359,7 +360,7 @@ var _ = Describe("admin_client_test", func() {
 	})
 
 	Describe("restore status", func() {
-		var status string
+		var status *fdbv1beta2.FoundationDBLiveRestoreStatus
 
 		Context("with no restore running", func() {
 			BeforeEach(func() {
@@ -368,13 +369,13 @@ var _ = Describe("admin_client_test", func() {
 			})
 
 			It("should be empty", func() {
-				Expect(status).To(Equal("\n"))
+				Expect(status).To(BeNil())
 			})
 		})
 
 		Context("with a restore running", func() {
 			BeforeEach(func() {
-				err = mockAdminClient.StartRestore("blobstore://test@test-service/test-backup", nil)
+				err = mockAdminClient.StartRestore("blobstore://test@test-service/test-backup", nil, fdbadminclient.RestoreOptions{})
 				Expect(err).NotTo(HaveOccurred())
 
 				status, err = mockAdminClient.GetRestoreStatus()
@@ -382,7 +383,21 @@ var _ = Describe("admin_client_test", func() {
 			})
 
 			It("should contain the backup URL", func() {
-				Expect(status).To(Equal("blobstore://test@test-service/test-backup\n"))
+				Expect(status.URL).To(Equal("blobstore://test@test-service/test-backup"))
+				Expect(status.State).To(Equal(fdbv1beta2.RestoreStateRunning))
+			})
+
+			When("the restore is aborted", func() {
+				BeforeEach(func() {
+					Expect(mockAdminClient.AbortRestore()).NotTo(HaveOccurred())
+
+					status, err = mockAdminClient.GetRestoreStatus()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should mark the restore as aborted", func() {
+					Expect(status.State).To(Equal(fdbv1beta2.RestoreStateAborted))
+				})
 			})
 		})
 	})
@@ -0,0 +1,165 @@
+/*
+ * autoscale_backup_agents.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// backupAgentAutoscalingInterval defines how often the operator checks the lag and the throughput of the backup if the
+// agent autoscaling is enabled.
+const backupAgentAutoscalingInterval = 1 * time.Minute
+
+// autoscaleBackupAgents provides a reconciliation step for scaling the number of backup agents based on the lag and the
+// throughput of the backup. The sub-reconciler only updates the desired agent count in the status, the backup agent
+// deployment is updated by the updateBackupAgents sub-reconciler.
+type autoscaleBackupAgents struct{}
+
+// reconcile runs the reconciler's work.
+func (s autoscaleBackupAgents) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, logger logr.Logger) *requeue {
+	if !backup.UseAgentAutoscaling() {
+		return nil
+	}
+
+	// The lag of a backup that is not running or paused is not meaningful and would scale down the agents.
+	if backup.Status.BackupDetails == nil || !backup.Status.BackupDetails.Running || backup.Status.BackupDetails.Paused {
+		return nil
+	}
+
+	adminClient, err := r.adminClientForBackup(ctx, backup)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+	defer adminClient.Close()
+
+	liveStatus, err := adminClient.GetBackupStatus()
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	original := backup.Status.AgentAutoscaling.DeepCopy()
+	message := updateBackupAgentAutoscaling(backup, liveStatus, time.Now())
+	if message != "" {
+		logger.Info("Backup agent autoscaling changed the desired agent count", "message", message)
+		r.Recorder.Event(backup, corev1.EventTypeNormal, "BackupAgentsScaled", message)
+	}
+
+	if !equality.Semantic.DeepEqual(original, backup.Status.AgentAutoscaling) {
+		err = r.updateOrApply(ctx, backup)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	return nil
+}
+
+// updateBackupAgentAutoscaling records the lag and the throughput of the backup in the agent autoscaling status and
+// changes the desired agent count if required and the cooldown has passed. If the desired agent count was changed, a
+// message describing the change will be returned, otherwise the message is empty.
+func updateBackupAgentAutoscaling(backup *fdbv1beta2.FoundationDBBackup, liveStatus *fdbv1beta2.FoundationDBLiveBackupStatus, now time.Time) string {
+	autoscaling := backup.Status.AgentAutoscaling
+	if autoscaling == nil {
+		autoscaling = &fdbv1beta2.BackupAgentAutoscalingStatus{}
+		backup.Status.AgentAutoscaling = autoscaling
+	}
+
+	// The current agent count is within the limits of the autoscaling.
+	current := backup.GetDesiredAgentCount()
+	autoscaling.DesiredAgentCount = current
+
+	var lag time.Duration
+	if liveStatus.Status.Running && liveStatus.LatestRestorablePoint != nil {
+		lag = time.Duration(liveStatus.LatestRestorablePoint.LagSeconds * float64(time.Second))
+	}
+	autoscaling.LagSeconds = int(lag.Seconds())
+
+	bytesWritten := liveStatus.LogBytes.Written + liveStatus.RangeBytes.Written
+	if autoscaling.LastSampleTime == nil || bytesWritten < autoscaling.BytesWritten {
+		// Without a previous sample, or if the backup was restarted, the throughput is unknown.
+		autoscaling.BytesWritten = bytesWritten
+		autoscaling.BytesWrittenPerSecond = 0
+		autoscaling.LastSampleTime = &metav1.Time{Time: now}
+	} else if elapsed := now.Sub(autoscaling.LastSampleTime.Time); elapsed >= backupAgentAutoscalingInterval/2 {
+		// Samples that are too close together would make the throughput fluctuate.
+		autoscaling.BytesWrittenPerSecond = int64(float64(bytesWritten-autoscaling.BytesWritten) / elapsed.Seconds())
+		autoscaling.BytesWritten = bytesWritten
+		autoscaling.LastSampleTime = &metav1.Time{Time: now}
+	}
+
+	desired := getDesiredBackupAgentCount(backup, current, lag, autoscaling.BytesWrittenPerSecond)
+	if desired == current {
+		return ""
+	}
+
+	cooldown := backup.GetAgentAutoscalingScaleUpCooldown()
+	if desired < current {
+		cooldown = backup.GetAgentAutoscalingScaleDownCooldown()
+	}
+
+	if autoscaling.LastScaleTime != nil && now.Sub(autoscaling.LastScaleTime.Time) < cooldown {
+		return ""
+	}
+
+	autoscaling.DesiredAgentCount = desired
+	autoscaling.LastScaleTime = &metav1.Time{Time: now}
+
+	return fmt.Sprintf("changed the backup agent count from %d to %d with a lag of %ds and %d bytes written per second", current, desired, autoscaling.LagSeconds, autoscaling.BytesWrittenPerSecond)
+}
+
+// getDesiredBackupAgentCount returns the number of backup agents required for the provided lag and throughput of the
+// backup. If the backup falls behind, at least one agent is added. Agents are only removed one at a time and only if
+// the backup keeps up and the remaining agents can handle the throughput.
+func getDesiredBackupAgentCount(backup *fdbv1beta2.FoundationDBBackup, current int, lag time.Duration, bytesWrittenPerSecond int64) int {
+	required := int(math.Ceil(float64(bytesWrittenPerSecond) / float64(backup.GetAgentAutoscalingBytesPerSecondPerAgent())))
+
+	desired := current
+	if lag >= backup.GetAgentAutoscalingScaleUpLag() {
+		desired = current + 1
+		if required > desired {
+			desired = required
+		}
+	} else if required > current {
+		desired = required
+	} else if required < current && lag <= backup.GetAgentAutoscalingScaleDownLag() {
+		desired = current - 1
+	}
+
+	minimum, maximum := backup.GetAgentAutoscalingLimits()
+	if desired < minimum {
+		return minimum
+	}
+
+	if desired > maximum {
+		return maximum
+	}
+
+	return desired
+}
@@ -0,0 +1,213 @@
+/*
+ * autoscale_backup_agents_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("autoscale_backup_agents", func() {
+	var backup *fdbv1beta2.FoundationDBBackup
+	var liveStatus *fdbv1beta2.FoundationDBLiveBackupStatus
+	var now time.Time
+	var message string
+
+	BeforeEach(func() {
+		backup = internal.CreateDefaultBackup(internal.CreateDefaultCluster())
+		backup.Spec.AgentCount = pointer.Int(2)
+		backup.Spec.AgentAutoscaling = fdbv1beta2.BackupAgentAutoscalingOptions{
+			Enabled:           pointer.Bool(true),
+			MaximumAgentCount: pointer.Int(8),
+		}
+
+		liveStatus = &fdbv1beta2.FoundationDBLiveBackupStatus{
+			Status: fdbv1beta2.FoundationDBLiveBackupStatusState{Running: true},
+			LatestRestorablePoint: &fdbv1beta2.FoundationDBLiveBackupRestorablePoint{
+				LagSeconds: 5,
+			},
+		}
+		now = time.Now()
+	})
+
+	DescribeTable("getting the desired agent count", func(current int, lag time.Duration, bytesWrittenPerSecond int64, expected int) {
+		Expect(getDesiredBackupAgentCount(backup, current, lag, bytesWrittenPerSecond)).To(Equal(expected))
+	},
+		Entry("the backup keeps up with the current agents", 2, 30*time.Second, int64(10*1024*1024), 2),
+		Entry("the backup falls behind", 2, 2*time.Minute, int64(0), 3),
+		Entry("the backup falls behind with a high throughput", 2, 2*time.Minute, int64(50*1024*1024), 5),
+		Entry("the throughput exceeds the capacity of the current agents", 2, 30*time.Second, int64(35*1024*1024), 4),
+		Entry("the backup keeps up with a low throughput", 4, 5*time.Second, int64(0), 3),
+		Entry("the lag is above the scale down lag", 4, 30*time.Second, int64(0), 4),
+		Entry("the backup falls behind with the maximum agents", 8, 2*time.Minute, int64(0), 8),
+		Entry("the backup keeps up with the minimum agents", 2, 0*time.Second, int64(0), 2),
+	)
+
+	When("the autoscaling status is empty", func() {
+		JustBeforeEach(func() {
+			message = updateBackupAgentAutoscaling(backup, liveStatus, now)
+		})
+
+		It("should record the first sample", func() {
+			Expect(message).To(BeEmpty())
+			Expect(backup.Status.AgentAutoscaling).NotTo(BeNil())
+			Expect(backup.Status.AgentAutoscaling.DesiredAgentCount).To(Equal(2))
+			Expect(backup.Status.AgentAutoscaling.LagSeconds).To(Equal(5))
+			Expect(backup.Status.AgentAutoscaling.BytesWrittenPerSecond).To(BeZero())
+			Expect(backup.Status.AgentAutoscaling.LastSampleTime).NotTo(BeNil())
+			Expect(backup.Status.AgentAutoscaling.LastScaleTime).To(BeNil())
+			Expect(backup.GetDesiredAgentCount()).To(Equal(2))
+		})
+
+		When("the backup falls behind", func() {
+			BeforeEach(func() {
+				liveStatus.LatestRestorablePoint.LagSeconds = 120
+			})
+
+			It("should add a backup agent", func() {
+				Expect(message).To(Equal("changed the backup agent count from 2 to 3 with a lag of 120s and 0 bytes written per second"))
+				Expect(backup.Status.AgentAutoscaling.DesiredAgentCount).To(Equal(3))
+				Expect(backup.Status.AgentAutoscaling.LastScaleTime).NotTo(BeNil())
+				Expect(backup.GetDesiredAgentCount()).To(Equal(3))
+			})
+		})
+	})
+
+	When("a previous sample exists", func() {
+		BeforeEach(func() {
+			backup.Status.AgentAutoscaling = &fdbv1beta2.BackupAgentAutoscalingStatus{
+				DesiredAgentCount: 2,
+				BytesWritten:      10 * 1024 * 1024,
+				LastSampleTime:    &metav1.Time{Time: now.Add(-1 * time.Minute)},
+			}
+			liveStatus.LogBytes.Written = 1210 * 1024 * 1024
+			liveStatus.RangeBytes.Written = 1200 * 1024 * 1024
+		})
+
+		JustBeforeEach(func() {
+			message = updateBackupAgentAutoscaling(backup, liveStatus, now)
+		})
+
+		It("should scale the agents based on the throughput", func() {
+			Expect(message).NotTo(BeEmpty())
+			Expect(backup.Status.AgentAutoscaling.BytesWritten).To(BeNumerically("==", 2410*1024*1024))
+			Expect(backup.Status.AgentAutoscaling.BytesWrittenPerSecond).To(BeNumerically("==", 40*1024*1024))
+			Expect(backup.Status.AgentAutoscaling.LastSampleTime.Unix()).To(Equal(now.Unix()))
+			Expect(backup.GetDesiredAgentCount()).To(Equal(4))
+		})
+
+		When("the previous sample is too recent", func() {
+			BeforeEach(func() {
+				backup.Status.AgentAutoscaling.LastSampleTime = &metav1.Time{Time: now.Add(-10 * time.Second)}
+			})
+
+			It("should keep the previous sample", func() {
+				Expect(message).To(BeEmpty())
+				Expect(backup.Status.AgentAutoscaling.BytesWritten).To(BeNumerically("==", 10*1024*1024))
+				Expect(backup.Status.AgentAutoscaling.BytesWrittenPerSecond).To(BeZero())
+				Expect(backup.GetDesiredAgentCount()).To(Equal(2))
+			})
+		})
+
+		When("the backup was restarted", func() {
+			BeforeEach(func() {
+				liveStatus.LogBytes.Written = 1024
+				liveStatus.RangeBytes.Written = 0
+			})
+
+			It("should reset the sample", func() {
+				Expect(message).To(BeEmpty())
+				Expect(backup.Status.AgentAutoscaling.BytesWritten).To(BeNumerically("==", 1024))
+				Expect(backup.Status.AgentAutoscaling.BytesWrittenPerSecond).To(BeZero())
+				Expect(backup.GetDesiredAgentCount()).To(Equal(2))
+			})
+		})
+	})
+
+	When("the agents were scaled recently", func() {
+		BeforeEach(func() {
+			backup.Status.AgentAutoscaling = &fdbv1beta2.BackupAgentAutoscalingStatus{
+				DesiredAgentCount: 4,
+				LastSampleTime:    &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				LastScaleTime:     &metav1.Time{Time: now.Add(-10 * time.Minute)},
+			}
+		})
+
+		JustBeforeEach(func() {
+			message = updateBackupAgentAutoscaling(backup, liveStatus, now)
+		})
+
+		When("the backup falls behind", func() {
+			BeforeEach(func() {
+				liveStatus.LatestRestorablePoint.LagSeconds = 120
+			})
+
+			It("should add a backup agent after the scale up cooldown", func() {
+				Expect(message).NotTo(BeEmpty())
+				Expect(backup.GetDesiredAgentCount()).To(Equal(5))
+				Expect(backup.Status.AgentAutoscaling.LastScaleTime.Unix()).To(Equal(now.Unix()))
+			})
+		})
+
+		When("the backup keeps up", func() {
+			It("should not remove a backup agent during the scale down cooldown", func() {
+				Expect(message).To(BeEmpty())
+				Expect(backup.GetDesiredAgentCount()).To(Equal(4))
+				Expect(backup.Status.AgentAutoscaling.LastScaleTime.Unix()).To(Equal(now.Add(-10 * time.Minute).Unix()))
+			})
+
+			When("the scale down cooldown has passed", func() {
+				BeforeEach(func() {
+					backup.Status.AgentAutoscaling.LastScaleTime = &metav1.Time{Time: now.Add(-20 * time.Minute)}
+				})
+
+				It("should remove a backup agent", func() {
+					Expect(message).To(Equal("changed the backup agent count from 4 to 3 with a lag of 5s and 0 bytes written per second"))
+					Expect(backup.GetDesiredAgentCount()).To(Equal(3))
+				})
+			})
+		})
+	})
+
+	DescribeTable("reconciling a backup that doesn't make progress", func(details *fdbv1beta2.FoundationDBBackupStatusBackupDetails) {
+		backup.Status.BackupDetails = details
+		backup.Status.AgentAutoscaling = &fdbv1beta2.BackupAgentAutoscalingStatus{
+			DesiredAgentCount: 4,
+			LastScaleTime:     &metav1.Time{Time: now.Add(-1 * time.Hour)},
+		}
+		expected := backup.Status.AgentAutoscaling.DeepCopy()
+
+		Expect(autoscaleBackupAgents{}.reconcile(context.TODO(), backupReconciler, backup, globalControllerLogger)).To(BeNil())
+		Expect(backup.Status.AgentAutoscaling).To(Equal(expected))
+		Expect(backup.GetDesiredAgentCount()).To(Equal(4))
+	},
+		Entry("the backup was not started", nil),
+		Entry("the backup is stopped", &fdbv1beta2.FoundationDBBackupStatusBackupDetails{Running: false}),
+		Entry("the backup is paused", &fdbv1beta2.FoundationDBBackupStatusBackupDetails{Running: true, Paused: true}),
+	)
+})
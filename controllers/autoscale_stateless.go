@@ -0,0 +1,283 @@
+/*
+ * autoscale_stateless.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// statelessAutoscalingDelay defines how long the operator waits before checking the workload again if a change of the
+// role counts is pending.
+const statelessAutoscalingDelay = 1 * time.Minute
+
+// autoscaleStateless provides a reconciliation step for scaling the number of commit proxies, grv proxies and resolvers
+// based on the workload of the database. The sub-reconciler only updates the desired role counts in the status, the
+// role counts are applied by the updateDatabaseConfiguration sub-reconciler and the stateless process groups are added
+// and removed based on the new role counts.
+type autoscaleStateless struct{}
+
+// reconcile runs the reconciler's work.
+func (autoscaleStateless) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	if !cluster.UseStatelessAutoscaling() {
+		if cluster.Status.StatelessAutoscaling == nil {
+			return nil
+		}
+
+		cluster.Status.StatelessAutoscaling = nil
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		return nil
+	}
+
+	if !cluster.Status.Configured {
+		return nil
+	}
+
+	// If the status is not cached, we have to fetch it.
+	if status == nil {
+		adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+		defer adminClient.Close()
+
+		status, err = adminClient.GetStatus()
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+	}
+
+	result := updateStatelessAutoscaling(cluster, status, time.Now())
+	if result.reason != "" {
+		logger.Info("Stateless autoscaling changed the desired role counts", "reason", result.reason, "message", result.message)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, result.reason, result.message)
+	}
+
+	if result.changed {
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if result.wait {
+		return &requeue{message: result.message, delay: statelessAutoscalingDelay, delayedRequeue: true}
+	}
+
+	return nil
+}
+
+// statelessAutoscalingResult describes the outcome of updating the stateless autoscaling.
+type statelessAutoscalingResult struct {
+	// changed is true if the cluster status was changed.
+	changed bool
+
+	// reason defines the reason of the event that should be recorded. If empty no event will be recorded.
+	reason string
+
+	// message provides details about the current state of the autoscaling.
+	message string
+
+	// wait is true if a change of the role counts is pending and the workload must be checked again.
+	wait bool
+}
+
+// updateStatelessAutoscaling updates the stateless autoscaling status with the current workload of the database and
+// changes the desired role counts if the workload exceeds the capacity of the current role counts or if the workload
+// is below the capacity of the current role counts by more than the scale down hysteresis.
+func updateStatelessAutoscaling(cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, now time.Time) statelessAutoscalingResult {
+	original := cluster.Status.StatelessAutoscaling.DeepCopy()
+	autoscaling := cluster.Status.StatelessAutoscaling
+	if autoscaling == nil {
+		autoscaling = &fdbv1beta2.StatelessAutoscalingStatus{}
+	}
+	cluster.Status.StatelessAutoscaling = autoscaling
+
+	// The current role counts are within the limits of the autoscaling.
+	current := cluster.GetRoleCountsWithDefaults()
+	autoscaling.CommitProxies = current.CommitProxies
+	autoscaling.GrvProxies = current.GrvProxies
+	autoscaling.Resolvers = current.Resolvers
+
+	result := scaleStatelessRoles(cluster, autoscaling, status, current, now)
+	result.changed = !equality.Semantic.DeepEqual(original, cluster.Status.StatelessAutoscaling)
+
+	return result
+}
+
+// scaleStatelessRoles records the workload in the provided status and changes the desired role counts if required.
+func scaleStatelessRoles(cluster *fdbv1beta2.FoundationDBCluster, autoscaling *fdbv1beta2.StatelessAutoscalingStatus, status *fdbv1beta2.FoundationDBStatus, current fdbv1beta2.RoleCounts, now time.Time) statelessAutoscalingResult {
+	transactions := status.Cluster.Workload.Transactions
+	resolved := transactions.Committed.Hz + transactions.Conflicted.Hz
+	autoscaling.StartedTransactionsPerSecond = int(math.Round(transactions.Started.Hz))
+	autoscaling.CommittedTransactionsPerSecond = int(math.Round(transactions.Committed.Hz))
+	autoscaling.ConflictRate = 0
+	if resolved > 0 {
+		autoscaling.ConflictRate = int(transactions.Conflicted.Hz * 100 / resolved)
+	}
+
+	commitProxyCPU := getRoleCPUUtilization(status, fdbv1beta2.ProcessRoleCommitProxy)
+	grvProxyCPU := getRoleCPUUtilization(status, fdbv1beta2.ProcessRoleGrvProxy)
+	autoscaling.CommitProxyCPUUtilization = commitProxyCPU
+	autoscaling.GrvProxyCPUUtilization = grvProxyCPU
+
+	minimum, maximum := cluster.GetStatelessAutoscalingLimits()
+	hysteresis := cluster.GetStatelessAutoscalingScaleDownHysteresis()
+	maximumCPU := cluster.GetStatelessAutoscalingMaximumProxyCPUUtilization()
+
+	desired := current
+	desired.CommitProxies = getDesiredRoleCount(current.CommitProxies, transactions.Committed.Hz, cluster.GetStatelessAutoscalingCommittedTransactionsPerCommitProxy(), commitProxyCPU, maximumCPU, hysteresis, minimum.CommitProxies, maximum.CommitProxies)
+	desired.GrvProxies = getDesiredRoleCount(current.GrvProxies, transactions.Started.Hz, cluster.GetStatelessAutoscalingStartedTransactionsPerGrvProxy(), grvProxyCPU, maximumCPU, hysteresis, minimum.GrvProxies, maximum.GrvProxies)
+	desired.Resolvers = getDesiredRoleCount(current.Resolvers, resolved, cluster.GetStatelessAutoscalingResolvedTransactionsPerResolver(), 0, maximumCPU, hysteresis, minimum.Resolvers, maximum.Resolvers)
+
+	var changes []string
+	for _, role := range []struct {
+		name    string
+		current int
+		desired int
+	}{
+		{name: "commit proxies", current: current.CommitProxies, desired: desired.CommitProxies},
+		{name: "grv proxies", current: current.GrvProxies, desired: desired.GrvProxies},
+		{name: "resolvers", current: current.Resolvers, desired: desired.Resolvers},
+	} {
+		if role.current != role.desired {
+			changes = append(changes, fmt.Sprintf("%s from %d to %d", role.name, role.current, role.desired))
+		}
+	}
+
+	if len(changes) == 0 {
+		return statelessAutoscalingResult{}
+	}
+
+	if autoscaling.LastScaleTime != nil {
+		cooldownEnd := autoscaling.LastScaleTime.Add(cluster.GetStatelessAutoscalingCooldown())
+		if now.Before(cooldownEnd) {
+			return statelessAutoscalingResult{
+				wait:    true,
+				message: fmt.Sprintf("waiting for the cooldown of the last scaling until %s", cooldownEnd.Format(time.RFC3339)),
+			}
+		}
+	}
+
+	autoscaling.CommitProxies = desired.CommitProxies
+	autoscaling.GrvProxies = desired.GrvProxies
+	autoscaling.Resolvers = desired.Resolvers
+	autoscaling.LastScaleTime = &metav1.Time{Time: now}
+
+	return statelessAutoscalingResult{
+		reason:  "StatelessAutoscaling",
+		message: fmt.Sprintf("scaling %s at %d started and %d committed transactions per second", strings.Join(changes, ", "), autoscaling.StartedTransactionsPerSecond, autoscaling.CommittedTransactionsPerSecond),
+	}
+}
+
+// getDesiredRoleCount returns the role count that is required to handle the provided rate with the provided capacity
+// per role. If the CPU utilization is above the maximum, the role count will be increased until the CPU utilization is
+// expected to be below the maximum. The role count will only be reduced if the workload would still fit into the
+// reduced role count if the capacity was reduced by the hysteresis. The result is always within the provided bounds.
+func getDesiredRoleCount(current int, rate float64, capacity int, cpuUtilization int, maximumCPUUtilization int, hysteresis int, minimum int, maximum int) int {
+	if current <= 0 {
+		return current
+	}
+
+	scaleUp := int(math.Ceil(rate / float64(capacity)))
+	if cpuScaleUp := divideRoundUp(current*cpuUtilization, maximumCPUUtilization); cpuScaleUp > scaleUp {
+		scaleUp = cpuScaleUp
+	}
+
+	scaleUp = clampRoleCount(scaleUp, minimum, maximum)
+	if scaleUp > current {
+		return scaleUp
+	}
+
+	reducedCapacity := float64(capacity*(100-hysteresis)) / 100
+	scaleDown := int(math.Ceil(rate / reducedCapacity))
+	if reducedCPUUtilization := maximumCPUUtilization * (100 - hysteresis) / 100; reducedCPUUtilization > 0 {
+		if cpuScaleDown := divideRoundUp(current*cpuUtilization, reducedCPUUtilization); cpuScaleDown > scaleDown {
+			scaleDown = cpuScaleDown
+		}
+	}
+
+	scaleDown = clampRoleCount(scaleDown, minimum, maximum)
+	if scaleDown < current {
+		return scaleDown
+	}
+
+	return current
+}
+
+// clampRoleCount returns the provided role count within the provided bounds.
+func clampRoleCount(roleCount int, minimum int, maximum int) int {
+	if roleCount < minimum {
+		return minimum
+	}
+
+	if roleCount > maximum {
+		return maximum
+	}
+
+	return roleCount
+}
+
+// divideRoundUp divides the provided numbers and rounds the result up.
+func divideRoundUp(dividend int, divisor int) int {
+	return (dividend + divisor - 1) / divisor
+}
+
+// getRoleCPUUtilization returns the average CPU utilization in percent of a core of all processes that are not excluded
+// and have the provided role.
+func getRoleCPUUtilization(status *fdbv1beta2.FoundationDBStatus, processRole fdbv1beta2.ProcessRole) int {
+	var usageCores float64
+	var processes int
+	for _, process := range status.Cluster.Processes {
+		if process.Excluded {
+			continue
+		}
+
+		for _, role := range process.Roles {
+			if role.Role != string(processRole) {
+				continue
+			}
+
+			usageCores += process.CPU.UsageCores
+			processes++
+			break
+		}
+	}
+
+	if processes == 0 {
+		return 0
+	}
+
+	return int(math.Round(usageCores * 100 / float64(processes)))
+}
@@ -0,0 +1,215 @@
+/*
+ * autoscale_stateless_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("autoscale_stateless", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var status *fdbv1beta2.FoundationDBStatus
+	var now time.Time
+	var result statelessAutoscalingResult
+
+	setWorkload := func(started float64, committed float64, conflicted float64) {
+		status.Cluster.Workload.Transactions = fdbv1beta2.FoundationDBStatusTransactions{
+			Started:    fdbv1beta2.FoundationDBStatusRate{Hz: started},
+			Committed:  fdbv1beta2.FoundationDBStatusRate{Hz: committed},
+			Conflicted: fdbv1beta2.FoundationDBStatusRate{Hz: conflicted},
+		}
+	}
+
+	setCPU := func(processGroupID fdbv1beta2.ProcessGroupID, role fdbv1beta2.ProcessRole, usageCores float64) {
+		status.Cluster.Processes[processGroupID] = fdbv1beta2.FoundationDBStatusProcessInfo{
+			CPU: fdbv1beta2.FoundationDBStatusCPUStatistics{UsageCores: usageCores},
+			Roles: []fdbv1beta2.FoundationDBStatusProcessRoleInfo{
+				{Role: string(role)},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.Version = fdbv1beta2.Versions.NextMajorVersion.String()
+		cluster.Status.RunningVersion = cluster.Spec.Version
+		cluster.Spec.DatabaseConfiguration.RoleCounts = fdbv1beta2.RoleCounts{
+			CommitProxies: 2,
+			GrvProxies:    1,
+			Resolvers:     1,
+		}
+		cluster.Spec.AutomationOptions.StatelessAutoscalingOptions = fdbv1beta2.StatelessAutoscalingOptions{
+			Enabled:       pointer.Bool(true),
+			CommitProxies: fdbv1beta2.RoleCountLimits{Maximum: pointer.Int(8)},
+			GrvProxies:    fdbv1beta2.RoleCountLimits{Maximum: pointer.Int(4)},
+			Resolvers:     fdbv1beta2.RoleCountLimits{Maximum: pointer.Int(4)},
+		}
+
+		status = &fdbv1beta2.FoundationDBStatus{
+			Cluster: fdbv1beta2.FoundationDBStatusClusterInfo{
+				Processes: map[fdbv1beta2.ProcessGroupID]fdbv1beta2.FoundationDBStatusProcessInfo{},
+			},
+		}
+
+		setWorkload(5000, 1000, 0)
+		setCPU("stateless-1", fdbv1beta2.ProcessRoleCommitProxy, 0.1)
+		setCPU("stateless-2", fdbv1beta2.ProcessRoleCommitProxy, 0.2)
+		setCPU("stateless-3", fdbv1beta2.ProcessRoleGrvProxy, 0.1)
+		now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		result = updateStatelessAutoscaling(cluster, status, now)
+	})
+
+	When("the workload fits into the current role counts", func() {
+		It("should only record the workload", func() {
+			Expect(result.changed).To(BeTrue())
+			Expect(result.reason).To(BeEmpty())
+			Expect(result.wait).To(BeFalse())
+			Expect(cluster.Status.StatelessAutoscaling).To(Equal(&fdbv1beta2.StatelessAutoscalingStatus{
+				CommitProxies:                  2,
+				GrvProxies:                     1,
+				Resolvers:                      1,
+				StartedTransactionsPerSecond:   5000,
+				CommittedTransactionsPerSecond: 1000,
+				CommitProxyCPUUtilization:      15,
+				GrvProxyCPUUtilization:         10,
+			}))
+		})
+	})
+
+	When("the workload exceeds the capacity of the current role counts", func() {
+		BeforeEach(func() {
+			setWorkload(50000, 20000, 2000)
+		})
+
+		It("should scale up the roles", func() {
+			Expect(result.changed).To(BeTrue())
+			Expect(result.reason).To(Equal("StatelessAutoscaling"))
+			Expect(result.message).To(Equal("scaling commit proxies from 2 to 4, grv proxies from 1 to 3, resolvers from 1 to 3 at 50000 started and 20000 committed transactions per second"))
+
+			autoscaling := cluster.Status.StatelessAutoscaling
+			Expect(autoscaling.CommitProxies).To(Equal(4))
+			Expect(autoscaling.GrvProxies).To(Equal(3))
+			Expect(autoscaling.Resolvers).To(Equal(3))
+			Expect(autoscaling.ConflictRate).To(Equal(9))
+			Expect(autoscaling.LastScaleTime.Time).To(Equal(now))
+
+			roleCounts := cluster.DesiredDatabaseConfiguration().RoleCounts
+			Expect(roleCounts.CommitProxies).To(Equal(4))
+			Expect(roleCounts.GrvProxies).To(Equal(3))
+			Expect(roleCounts.Resolvers).To(Equal(3))
+		})
+
+		When("the workload exceeds the maximum", func() {
+			BeforeEach(func() {
+				setWorkload(50000, 100000, 0)
+			})
+
+			It("should not scale above the maximum", func() {
+				Expect(result.reason).To(Equal("StatelessAutoscaling"))
+				Expect(cluster.Status.StatelessAutoscaling.CommitProxies).To(Equal(8))
+				Expect(cluster.Status.StatelessAutoscaling.Resolvers).To(Equal(4))
+			})
+		})
+
+		When("the last change is within the cooldown", func() {
+			BeforeEach(func() {
+				cluster.Status.StatelessAutoscaling = &fdbv1beta2.StatelessAutoscalingStatus{
+					LastScaleTime: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				}
+			})
+
+			It("should wait for the cooldown", func() {
+				Expect(result.reason).To(BeEmpty())
+				Expect(result.wait).To(BeTrue())
+				Expect(result.message).To(Equal("waiting for the cooldown of the last scaling until 2023-10-02T12:04:00Z"))
+				Expect(cluster.Status.StatelessAutoscaling.CommitProxies).To(Equal(2))
+			})
+		})
+	})
+
+	When("the CPU utilization of the commit proxies is above the maximum", func() {
+		BeforeEach(func() {
+			setCPU("stateless-1", fdbv1beta2.ProcessRoleCommitProxy, 0.9)
+			setCPU("stateless-2", fdbv1beta2.ProcessRoleCommitProxy, 0.9)
+		})
+
+		It("should add commit proxies", func() {
+			Expect(result.reason).To(Equal("StatelessAutoscaling"))
+			Expect(result.message).To(Equal("scaling commit proxies from 2 to 3 at 5000 started and 1000 committed transactions per second"))
+			Expect(cluster.Status.StatelessAutoscaling.CommitProxies).To(Equal(3))
+		})
+	})
+
+	When("the workload decreased", func() {
+		BeforeEach(func() {
+			cluster.Status.StatelessAutoscaling = &fdbv1beta2.StatelessAutoscalingStatus{
+				CommitProxies: 4,
+				GrvProxies:    1,
+				Resolvers:     2,
+			}
+		})
+
+		When("the workload is within the hysteresis", func() {
+			BeforeEach(func() {
+				setWorkload(5000, 14000, 0)
+			})
+
+			It("should keep the role counts", func() {
+				Expect(result.reason).To(BeEmpty())
+				Expect(cluster.Status.StatelessAutoscaling.CommitProxies).To(Equal(4))
+			})
+		})
+
+		When("the workload is below the hysteresis", func() {
+			BeforeEach(func() {
+				setWorkload(5000, 11000, 0)
+			})
+
+			It("should scale down the roles", func() {
+				Expect(result.reason).To(Equal("StatelessAutoscaling"))
+				Expect(result.message).To(Equal("scaling commit proxies from 4 to 3 at 5000 started and 11000 committed transactions per second"))
+				Expect(cluster.Status.StatelessAutoscaling.CommitProxies).To(Equal(3))
+			})
+		})
+
+		When("the workload is below the minimum", func() {
+			BeforeEach(func() {
+				setWorkload(0, 0, 0)
+			})
+
+			It("should not scale below the minimum", func() {
+				Expect(result.reason).To(Equal("StatelessAutoscaling"))
+				Expect(cluster.Status.StatelessAutoscaling.CommitProxies).To(Equal(2))
+				Expect(cluster.Status.StatelessAutoscaling.Resolvers).To(Equal(1))
+			})
+		})
+	})
+})
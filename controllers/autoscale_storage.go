@@ -0,0 +1,289 @@
+/*
+ * autoscale_storage.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// storageAutoscalingDelay defines how long the operator waits before checking the disk utilization again if a scaling
+// decision is pending.
+const storageAutoscalingDelay = 1 * time.Minute
+
+// maxStorageAutoscalingDecisions defines how many scaling decisions are kept in the status.
+const maxStorageAutoscalingDecisions = 10
+
+// autoscaleStorage provides a reconciliation step for scaling the number of storage process groups based on the disk
+// utilization of the storage servers. The sub-reconciler only updates the desired number of storage process groups in
+// the status, the process groups are added by the addProcessGroups and removed by the chooseRemovals sub-reconciler.
+type autoscaleStorage struct{}
+
+// reconcile runs the reconciler's work.
+func (autoscaleStorage) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	if !cluster.UseStorageAutoscaling() {
+		if cluster.Status.StorageAutoscaling == nil {
+			return nil
+		}
+
+		cluster.Status.StorageAutoscaling = nil
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		return nil
+	}
+
+	if !cluster.Status.Configured {
+		return nil
+	}
+
+	// If the status is not cached, we have to fetch it.
+	if status == nil {
+		adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+		defer adminClient.Close()
+
+		status, err = adminClient.GetStatus()
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+	}
+
+	result, err := updateStorageAutoscaling(cluster, status, time.Now())
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if result.reason != "" {
+		logger.Info("Storage autoscaling changed the desired storage process groups", "reason", result.reason, "message", result.message)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, result.reason, result.message)
+	}
+
+	if result.changed {
+		err = r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if result.wait {
+		return &requeue{message: result.message, delay: storageAutoscalingDelay, delayedRequeue: true}
+	}
+
+	return nil
+}
+
+// storageAutoscalingResult describes the outcome of updating the storage autoscaling.
+type storageAutoscalingResult struct {
+	// changed is true if the cluster status was changed.
+	changed bool
+
+	// reason defines the reason of the event that should be recorded. If empty no event will be recorded.
+	reason string
+
+	// message provides details about the current state of the autoscaling.
+	message string
+
+	// wait is true if a scaling decision is pending and the disk utilization must be checked again.
+	wait bool
+}
+
+// updateStorageAutoscaling updates the storage autoscaling status with the current disk utilization of the storage
+// servers and changes the desired number of storage process groups if the utilization stayed above the scale up
+// utilization or below the scale down utilization for the configured delay.
+func updateStorageAutoscaling(cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, now time.Time) (storageAutoscalingResult, error) {
+	original := cluster.Status.StorageAutoscaling.DeepCopy()
+	autoscaling := cluster.Status.StorageAutoscaling
+	if autoscaling == nil {
+		autoscaling = &fdbv1beta2.StorageAutoscalingStatus{}
+	}
+	cluster.Status.StorageAutoscaling = autoscaling
+
+	counts, err := cluster.GetProcessCountsWithDefaults()
+	if err != nil {
+		return storageAutoscalingResult{}, err
+	}
+
+	result := scaleStorageProcessGroups(cluster, autoscaling, status, counts.Storage, now)
+	result.changed = !equality.Semantic.DeepEqual(original, cluster.Status.StorageAutoscaling)
+
+	return result, nil
+}
+
+// scaleStorageProcessGroups updates the utilization tracking of the provided status and makes a scaling decision if
+// the utilization stayed above or below the thresholds for long enough.
+func scaleStorageProcessGroups(cluster *fdbv1beta2.FoundationDBCluster, autoscaling *fdbv1beta2.StorageAutoscalingStatus, status *fdbv1beta2.FoundationDBStatus, current int, now time.Time) storageAutoscalingResult {
+	if autoscaling.DesiredStorageProcessGroups == 0 {
+		autoscaling.DesiredStorageProcessGroups = current
+	}
+
+	utilization, ok := getStorageUtilization(status)
+	if !ok {
+		return storageAutoscalingResult{message: "the storage servers are not reporting their disk utilization"}
+	}
+
+	autoscaling.Utilization = utilization
+	autoscaling.KVBytes = status.Cluster.Data.KVBytes
+
+	scaleUpUtilization := cluster.GetStorageAutoscalingScaleUpUtilization()
+	switch {
+	case utilization >= scaleUpUtilization:
+		autoscaling.LowUtilizationSince = nil
+		if autoscaling.HighUtilizationSince == nil {
+			autoscaling.HighUtilizationSince = &metav1.Time{Time: now}
+		}
+	case utilization <= cluster.GetStorageAutoscalingScaleDownUtilization():
+		autoscaling.HighUtilizationSince = nil
+		if autoscaling.LowUtilizationSince == nil {
+			autoscaling.LowUtilizationSince = &metav1.Time{Time: now}
+		}
+	default:
+		autoscaling.HighUtilizationSince = nil
+		autoscaling.LowUtilizationSince = nil
+	}
+
+	minimum, maximum := cluster.GetStorageAutoscalingLimits()
+	scaleUp := autoscaling.HighUtilizationSince != nil && current < maximum
+	scaleDown := autoscaling.LowUtilizationSince != nil && current > minimum
+	if !scaleUp && !scaleDown {
+		return storageAutoscalingResult{}
+	}
+
+	result := storageAutoscalingResult{wait: true}
+
+	// Wait until the previous scaling decision is completed, otherwise the utilization doesn't reflect the new number
+	// of storage process groups.
+	activeStorageProcessGroups := 0
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.ProcessClass != fdbv1beta2.ProcessClassStorage {
+			continue
+		}
+
+		if processGroup.IsMarkedForRemoval() {
+			result.message = "waiting for the removal of storage process groups"
+			return result
+		}
+
+		activeStorageProcessGroups++
+	}
+
+	if activeStorageProcessGroups != current {
+		result.message = fmt.Sprintf("waiting for the storage process groups to be scaled from %d to %d", activeStorageProcessGroups, current)
+		return result
+	}
+
+	if len(autoscaling.Decisions) > 0 {
+		lastDecision := autoscaling.Decisions[len(autoscaling.Decisions)-1]
+		cooldownEnd := lastDecision.Timestamp.Add(cluster.GetStorageAutoscalingCooldown())
+		if now.Before(cooldownEnd) {
+			result.message = fmt.Sprintf("waiting for the cooldown of the last scaling decision until %s", cooldownEnd.Format(time.RFC3339))
+			return result
+		}
+	}
+
+	desired := current
+	if scaleUp {
+		if now.Before(autoscaling.HighUtilizationSince.Add(cluster.GetStorageAutoscalingScaleUpDelay())) {
+			result.message = fmt.Sprintf("disk utilization of %d%% is above %d%%, waiting for the scale up delay", utilization, scaleUpUtilization)
+			return result
+		}
+
+		// Add enough storage process groups to bring the utilization below the scale up utilization, but at least one.
+		desired = (current*utilization + scaleUpUtilization - 1) / scaleUpUtilization
+		if desired <= current {
+			desired = current + 1
+		}
+
+		if desired > maximum {
+			desired = maximum
+		}
+	} else {
+		if now.Before(autoscaling.LowUtilizationSince.Add(cluster.GetStorageAutoscalingScaleDownDelay())) {
+			result.message = fmt.Sprintf("disk utilization of %d%% is below %d%%, waiting for the scale down delay", utilization, cluster.GetStorageAutoscalingScaleDownUtilization())
+			return result
+		}
+
+		// Only remove a storage process group if the remaining storage process groups will not be above the scale up
+		// utilization afterwards.
+		if current*utilization/(current-1) >= scaleUpUtilization {
+			return storageAutoscalingResult{}
+		}
+
+		desired = current - 1
+	}
+
+	autoscaling.DesiredStorageProcessGroups = desired
+	autoscaling.HighUtilizationSince = nil
+	autoscaling.LowUtilizationSince = nil
+	autoscaling.Decisions = append(autoscaling.Decisions, fdbv1beta2.StorageAutoscalingDecision{
+		Timestamp:   metav1.Time{Time: now},
+		From:        current,
+		To:          desired,
+		Utilization: utilization,
+		KVBytes:     autoscaling.KVBytes,
+	})
+
+	if len(autoscaling.Decisions) > maxStorageAutoscalingDecisions {
+		autoscaling.Decisions = autoscaling.Decisions[len(autoscaling.Decisions)-maxStorageAutoscalingDecisions:]
+	}
+
+	return storageAutoscalingResult{
+		reason:  "StorageAutoscaling",
+		message: fmt.Sprintf("scaling storage process groups from %d to %d at a disk utilization of %d%%", current, desired, utilization),
+	}
+}
+
+// getStorageUtilization returns the disk utilization in percent of all storage servers that are not excluded. If no
+// storage server reports its disk usage, false will be returned.
+func getStorageUtilization(status *fdbv1beta2.FoundationDBStatus) (int, bool) {
+	var usedBytes, totalBytes int
+	for _, process := range status.Cluster.Processes {
+		if process.Excluded {
+			continue
+		}
+
+		for _, role := range process.Roles {
+			if role.Role != string(fdbv1beta2.ProcessRoleStorage) {
+				continue
+			}
+
+			usedBytes += role.KVStoreUsedBytes
+			totalBytes += role.KVStoreTotalBytes
+		}
+	}
+
+	if totalBytes == 0 {
+		return 0, false
+	}
+
+	return usedBytes * 100 / totalBytes, true
+}
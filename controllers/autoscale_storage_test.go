@@ -0,0 +1,226 @@
+/*
+ * autoscale_storage_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("autoscale_storage", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var status *fdbv1beta2.FoundationDBStatus
+	var now time.Time
+	var result storageAutoscalingResult
+
+	setUtilization := func(usedBytes int) {
+		for processGroupID, process := range status.Cluster.Processes {
+			process.Roles[0].KVStoreUsedBytes = usedBytes
+			status.Cluster.Processes[processGroupID] = process
+		}
+	}
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.ProcessCounts.Storage = 4
+		cluster.Spec.AutomationOptions.StorageAutoscalingOptions = fdbv1beta2.StorageAutoscalingOptions{
+			Enabled:                     pointer.Bool(true),
+			MaximumStorageProcessGroups: pointer.Int(8),
+		}
+		cluster.Status.ProcessGroups = nil
+
+		status = &fdbv1beta2.FoundationDBStatus{
+			Cluster: fdbv1beta2.FoundationDBStatusClusterInfo{
+				Data: fdbv1beta2.FoundationDBStatusDataStatistics{
+					KVBytes: 1000,
+				},
+				Processes: map[fdbv1beta2.ProcessGroupID]fdbv1beta2.FoundationDBStatusProcessInfo{},
+			},
+		}
+
+		for i := 1; i <= 4; i++ {
+			processGroupID := fdbv1beta2.ProcessGroupID(fmt.Sprintf("storage-%d", i))
+			cluster.Status.ProcessGroups = append(cluster.Status.ProcessGroups, fdbv1beta2.NewProcessGroupStatus(processGroupID, fdbv1beta2.ProcessClassStorage, nil))
+			status.Cluster.Processes[processGroupID] = fdbv1beta2.FoundationDBStatusProcessInfo{
+				Roles: []fdbv1beta2.FoundationDBStatusProcessRoleInfo{
+					{
+						Role:              string(fdbv1beta2.ProcessRoleStorage),
+						KVStoreUsedBytes:  500,
+						KVStoreTotalBytes: 1000,
+					},
+				},
+			}
+		}
+
+		now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		result, err = updateStorageAutoscaling(cluster, status, now)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("the utilization is between the thresholds", func() {
+		It("should only record the utilization", func() {
+			Expect(result.changed).To(BeTrue())
+			Expect(result.reason).To(BeEmpty())
+			Expect(result.wait).To(BeFalse())
+			Expect(cluster.Status.StorageAutoscaling).To(Equal(&fdbv1beta2.StorageAutoscalingStatus{
+				DesiredStorageProcessGroups: 4,
+				Utilization:                 50,
+				KVBytes:                     1000,
+			}))
+		})
+	})
+
+	When("the utilization is above the scale up utilization", func() {
+		BeforeEach(func() {
+			setUtilization(900)
+		})
+
+		It("should wait for the scale up delay", func() {
+			Expect(result.changed).To(BeTrue())
+			Expect(result.reason).To(BeEmpty())
+			Expect(result.wait).To(BeTrue())
+			Expect(result.message).To(Equal("disk utilization of 90% is above 80%, waiting for the scale up delay"))
+			Expect(cluster.Status.StorageAutoscaling.HighUtilizationSince.Time).To(Equal(now))
+			Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(4))
+		})
+
+		When("the utilization stayed high for the scale up delay", func() {
+			BeforeEach(func() {
+				cluster.Status.StorageAutoscaling = &fdbv1beta2.StorageAutoscalingStatus{
+					DesiredStorageProcessGroups: 4,
+					HighUtilizationSince:        &metav1.Time{Time: now.Add(-15 * time.Minute)},
+				}
+			})
+
+			It("should scale up the storage process groups", func() {
+				Expect(result.changed).To(BeTrue())
+				Expect(result.reason).To(Equal("StorageAutoscaling"))
+				Expect(result.message).To(Equal("scaling storage process groups from 4 to 5 at a disk utilization of 90%"))
+				Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(5))
+				Expect(cluster.Status.StorageAutoscaling.HighUtilizationSince).To(BeNil())
+				Expect(cluster.Status.StorageAutoscaling.Decisions).To(Equal([]fdbv1beta2.StorageAutoscalingDecision{
+					{
+						Timestamp:   metav1.Time{Time: now},
+						From:        4,
+						To:          5,
+						Utilization: 90,
+						KVBytes:     1000,
+					},
+				}))
+
+				counts, err := cluster.GetProcessCountsWithDefaults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts.Storage).To(Equal(5))
+			})
+
+			When("the disks are almost full", func() {
+				BeforeEach(func() {
+					setUtilization(1000)
+					cluster.Spec.AutomationOptions.StorageAutoscalingOptions.MaximumStorageProcessGroups = pointer.Int(5)
+				})
+
+				It("should not scale above the maximum", func() {
+					Expect(result.reason).To(Equal("StorageAutoscaling"))
+					Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(5))
+				})
+			})
+
+			When("the last scaling decision is within the cooldown", func() {
+				BeforeEach(func() {
+					cluster.Status.StorageAutoscaling.Decisions = []fdbv1beta2.StorageAutoscalingDecision{
+						{Timestamp: metav1.Time{Time: now.Add(-10 * time.Minute)}, From: 3, To: 4, Utilization: 85},
+					}
+				})
+
+				It("should wait for the cooldown", func() {
+					Expect(result.reason).To(BeEmpty())
+					Expect(result.wait).To(BeTrue())
+					Expect(result.message).To(Equal("waiting for the cooldown of the last scaling decision until 2023-10-02T12:20:00Z"))
+					Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(4))
+				})
+			})
+
+			When("a storage process group is marked for removal", func() {
+				BeforeEach(func() {
+					cluster.Status.ProcessGroups[0].MarkForRemoval()
+				})
+
+				It("should wait for the removal", func() {
+					Expect(result.reason).To(BeEmpty())
+					Expect(result.wait).To(BeTrue())
+					Expect(result.message).To(Equal("waiting for the removal of storage process groups"))
+				})
+			})
+		})
+	})
+
+	When("the utilization stayed below the scale down utilization for the scale down delay", func() {
+		BeforeEach(func() {
+			setUtilization(100)
+			cluster.Spec.AutomationOptions.StorageAutoscalingOptions.MinimumStorageProcessGroups = pointer.Int(2)
+			cluster.Status.StorageAutoscaling = &fdbv1beta2.StorageAutoscalingStatus{
+				DesiredStorageProcessGroups: 4,
+				LowUtilizationSince:         &metav1.Time{Time: now.Add(-2 * time.Hour)},
+			}
+		})
+
+		It("should remove one storage process group", func() {
+			Expect(result.reason).To(Equal("StorageAutoscaling"))
+			Expect(result.message).To(Equal("scaling storage process groups from 4 to 3 at a disk utilization of 10%"))
+			Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(3))
+		})
+
+		When("the storage process groups are at the minimum", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.StorageAutoscalingOptions.MinimumStorageProcessGroups = nil
+			})
+
+			It("should not scale down", func() {
+				Expect(result.reason).To(BeEmpty())
+				Expect(result.wait).To(BeFalse())
+				Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(4))
+			})
+		})
+	})
+
+	When("the storage servers don't report their disk utilization", func() {
+		BeforeEach(func() {
+			status.Cluster.Processes = nil
+		})
+
+		It("should not make a decision", func() {
+			Expect(result.reason).To(BeEmpty())
+			Expect(result.message).To(Equal("the storage servers are not reporting their disk utilization"))
+			Expect(cluster.Status.StorageAutoscaling.DesiredStorageProcessGroups).To(Equal(4))
+		})
+	})
+})
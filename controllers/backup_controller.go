@@ -22,6 +22,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -77,23 +78,28 @@ func (r *FoundationDBBackupReconciler) Reconcile(ctx context.Context, request ct
 
 	subReconcilers := []backupSubReconciler{
 		updateBackupStatus{},
+		autoscaleBackupAgents{},
 		updateBackupAgents{},
 		startBackup{},
 		stopBackup{},
 		toggleBackupPaused{},
 		modifyBackup{},
+		expireBackup{},
 		updateBackupStatus{},
 	}
 
 	for _, subReconciler := range subReconcilers {
-		requeue := subReconciler.reconcile(ctx, r, backup)
+		requeue := subReconciler.reconcile(ctx, r, backup, backupLog)
 		if requeue == nil {
 			continue
 		}
 
+		r.updateReconciledCondition(ctx, backupLog, backup, getReconciledCondition(fdbv1beta2.BackupConditionReconciled, originalGeneration, false, requeue, subReconciler))
 		return processRequeue(requeue, subReconciler, backup, r.Recorder, backupLog)
 	}
 
+	r.updateReconciledCondition(ctx, backupLog, backup, getReconciledCondition(fdbv1beta2.BackupConditionReconciled, originalGeneration, backup.Status.Generations.Reconciled >= originalGeneration, nil, nil))
+
 	if backup.Status.Generations.Reconciled < originalGeneration {
 		backupLog.Info("Backup was not fully reconciled by reconciliation process")
 		return ctrl.Result{Requeue: true}, nil
@@ -101,7 +107,21 @@ func (r *FoundationDBBackupReconciler) Reconcile(ctx context.Context, request ct
 
 	backupLog.Info("Reconciliation complete")
 
-	return ctrl.Result{}, nil
+	var delay time.Duration
+	// The backup data must be expired periodically, so we have to check the backup again once the next expiry is due.
+	if backup.Spec.Retention != nil {
+		delay = backup.GetTimeUntilNextExpiry(time.Now())
+		if delay == 0 {
+			delay = backup.GetExpiryInterval()
+		}
+	}
+
+	// The lag and the throughput of the backup must be checked periodically to scale the backup agents.
+	if backup.UseAgentAutoscaling() && (delay == 0 || delay > backupAgentAutoscalingInterval) {
+		delay = backupAgentAutoscalingInterval
+	}
+
+	return ctrl.Result{RequeueAfter: delay}, nil
 }
 
 // getDatabaseClientProvider gets the client provider for a reconciler.
@@ -176,7 +196,20 @@ type backupSubReconciler interface {
 	If reconciliation cannot proceed, this should return a requeue object with a
 	`Message` field.
 	*/
-	reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup) *requeue
+	reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, logger logr.Logger) *requeue
+}
+
+// updateReconciledCondition sets the Reconciled condition of the backup and updates the status if the condition has
+// changed. Errors during the update are only logged as the condition will be updated in the next reconciliation.
+func (r *FoundationDBBackupReconciler) updateReconciledCondition(ctx context.Context, logger logr.Logger, backup *fdbv1beta2.FoundationDBBackup, condition metav1.Condition) {
+	if !setStatusCondition(&backup.Status.Conditions, condition) {
+		return
+	}
+
+	err := r.updateOrApply(ctx, backup)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciled condition", "reason", condition.Reason)
+	}
 }
 
 // updateOrApply updates the status either with server-side apply or if disabled with the normal update call.
@@ -22,7 +22,10 @@ package controllers
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
+	"k8s.io/utils/pointer"
 
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
 
@@ -33,6 +36,7 @@ import (
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -130,7 +134,10 @@ var _ = Describe("backup_controller", func() {
 			})
 
 			It("should update the status on the resource", func() {
-				Expect(backup.Status).To(Equal(fdbv1beta2.FoundationDBBackupStatus{
+				status := backup.Status.DeepCopy()
+				// The conditions are validated separately as they contain the transition time.
+				status.Conditions = nil
+				Expect(*status).To(Equal(fdbv1beta2.FoundationDBBackupStatus{
 					AgentCount:           3,
 					DeploymentConfigured: true,
 					BackupDetails: &fdbv1beta2.FoundationDBBackupStatusBackupDetails{
@@ -144,6 +151,12 @@ var _ = Describe("backup_controller", func() {
 				}))
 			})
 
+			It("should set the conditions on the resource", func() {
+				Expect(meta.IsStatusConditionTrue(backup.Status.Conditions, fdbv1beta2.BackupConditionReconciled)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(backup.Status.Conditions, fdbv1beta2.BackupConditionRunning)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(backup.Status.Conditions, fdbv1beta2.BackupConditionPaused)).To(BeTrue())
+			})
+
 			It("should start a backup", func() {
 				status, err := adminClient.GetBackupStatus()
 				Expect(err).NotTo(HaveOccurred())
@@ -297,6 +310,89 @@ var _ = Describe("backup_controller", func() {
 			})
 		})
 
+		When("defining a retention", func() {
+			BeforeEach(func() {
+				backup.Spec.Retention = &fdbv1beta2.BackupRetention{
+					MinRestorableDays: pointer.Int(7),
+				}
+				err = k8sClient.Update(context.TODO(), backup)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should expire the backup data", func() {
+				Expect(adminClient.ExpiredBackups).To(HaveKeyWithValue(backup.BackupURL(), *backup.Spec.Retention))
+				Expect(backup.Status.Expiry).NotTo(BeNil())
+				Expect(backup.Status.Expiry.LastExpiryTime).NotTo(BeNil())
+				Expect(backup.Status.Expiry.MinRestorableTime).NotTo(BeNil())
+				Expect(backup.Status.Expiry.MaxRestorableTime).NotTo(BeNil())
+				Expect(backup.Status.Expiry.MaxRestorableTime.Sub(backup.Status.Expiry.MinRestorableTime.Time)).To(BeNumerically("~", 7*24*time.Hour, time.Minute))
+			})
+
+			When("the expiry interval has not passed", func() {
+				var lastExpiryTime *metav1.Time
+
+				JustBeforeEach(func() {
+					lastExpiryTime = backup.Status.Expiry.LastExpiryTime
+					delete(adminClient.ExpiredBackups, backup.BackupURL())
+
+					result, err := reconcileBackup(backup)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.RequeueAfter).To(BeNumerically(">", 23*time.Hour))
+
+					_, err = reloadBackup(backup)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should not expire the backup data again", func() {
+					Expect(adminClient.ExpiredBackups).To(BeEmpty())
+					Expect(backup.Status.Expiry.LastExpiryTime.Unix()).To(Equal(lastExpiryTime.Unix()))
+				})
+			})
+		})
+
+		When("the agent autoscaling is enabled", func() {
+			BeforeEach(func() {
+				backup.Spec.AgentAutoscaling = fdbv1beta2.BackupAgentAutoscalingOptions{
+					Enabled:           pointer.Bool(true),
+					MaximumAgentCount: pointer.Int(5),
+				}
+				err = k8sClient.Update(context.TODO(), backup)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should report the desired agent count in the status", func() {
+				Expect(backup.Status.AgentAutoscaling).NotTo(BeNil())
+				Expect(backup.Status.AgentAutoscaling.DesiredAgentCount).To(Equal(3))
+				Expect(backup.Status.AgentAutoscaling.LastSampleTime).NotTo(BeNil())
+				Expect(backup.Status.AgentCount).To(Equal(3))
+			})
+
+			When("the backup falls behind", func() {
+				JustBeforeEach(func() {
+					adminClient.BackupLagSeconds = 300
+
+					result, err := reconcileBackup(backup)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.RequeueAfter).To(Equal(backupAgentAutoscalingInterval))
+
+					_, err = reloadBackup(backup)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should add a backup agent", func() {
+					Expect(backup.Status.AgentAutoscaling.DesiredAgentCount).To(Equal(4))
+					Expect(backup.Status.AgentAutoscaling.LagSeconds).To(Equal(300))
+					Expect(backup.Status.AgentAutoscaling.LastScaleTime).NotTo(BeNil())
+					Expect(backup.Status.AgentCount).To(Equal(4))
+
+					deployment := &appsv1.Deployment{}
+					err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: fmt.Sprintf("%s-backup-agents", cluster.Name)}, deployment)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(*deployment.Spec.Replicas).To(Equal(int32(4)))
+				})
+			})
+		})
+
 		When("providing custom parameters", func() {
 			BeforeEach(func() {
 				backup.Spec.CustomParameters = fdbv1beta2.FoundationDBCustomParameters{
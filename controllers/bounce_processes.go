@@ -116,7 +116,7 @@ func (bounceProcesses) reconcile(_ context.Context, r *FoundationDBClusterReconc
 			return &requeue{curError: err}
 		}
 	}
-	version, err := fdbv1beta2.ParseFdbVersion(cluster.Spec.Version)
+	version, err := fdbv1beta2.ParseFdbVersion(cluster.GetDesiredVersion())
 	if err != nil {
 		return &requeue{curError: err}
 	}
@@ -247,6 +247,11 @@ func getProcessesReadyForRestart(logger logr.Logger, cluster *fdbv1beta2.Foundat
 			continue
 		}
 
+		if !cluster.IsCanaryUpdateAllowed(processGroup.ProcessGroupID) {
+			logger.V(1).Info("ignore process group that is not part of the canary rollout", "processGroupID", processGroup.ProcessGroupID)
+			continue
+		}
+
 		if addressMap[processGroup.ProcessGroupID] == nil {
 			missingAddress = append(missingAddress, processGroup.ProcessGroupID)
 			continue
@@ -0,0 +1,63 @@
+/*
+ * cancel_restore.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// cancelRestore provides a reconciliation step for aborting a running restore.
+type cancelRestore struct{}
+
+// reconcile runs the reconciler's work.
+func (s cancelRestore) reconcile(ctx context.Context, r *FoundationDBRestoreReconciler, restore *fdbv1beta2.FoundationDBRestore) *requeue {
+	if !restore.Spec.Cancel || restore.GetPhase() != fdbv1beta2.RestorePhaseRunning {
+		return nil
+	}
+
+	adminClient, err := r.adminClientForRestore(ctx, restore)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+	defer adminClient.Close()
+
+	globalControllerLogger.Info("Aborting restore", "namespace", restore.Namespace, "restore", restore.Name)
+	err = adminClient.AbortRestore()
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	r.Recorder.Event(restore, corev1.EventTypeNormal, "RestoreCancelled", fmt.Sprintf("The restore from %s was cancelled", restore.BackupURL()))
+	restore.Status.Error = "The restore was cancelled"
+	setRestoreTerminalPhase(restore, fdbv1beta2.RestorePhaseFailed, fmt.Sprintf("The restore from %s was cancelled", restore.BackupURL()), time.Now())
+
+	err = r.updateOrApply(ctx, restore)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
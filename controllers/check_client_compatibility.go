@@ -31,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
 )
 
 // checkClientCompatibility confirms that all clients are compatible with the
@@ -48,7 +49,7 @@ func (c checkClientCompatibility) reconcile(_ context.Context, r *FoundationDBCl
 		return &requeue{curError: err}
 	}
 
-	version, err := fdbv1beta2.ParseFdbVersion(cluster.Spec.Version)
+	version, err := fdbv1beta2.ParseFdbVersion(cluster.GetDesiredVersion())
 	if err != nil {
 		return &requeue{curError: err}
 	}
@@ -79,21 +80,15 @@ func (c checkClientCompatibility) reconcile(_ context.Context, r *FoundationDBCl
 		}
 	}
 
-	protocolVersion, err := adminClient.GetProtocolVersion(cluster.Spec.Version)
+	unsupportedClients, err := getUnsupportedClientsForVersion(adminClient, cluster, status, cluster.GetDesiredVersion())
 	if err != nil {
 		return &requeue{curError: err}
 	}
 
-	ignoredLogGroups := make(map[fdbv1beta2.LogGroup]fdbv1beta2.None)
-	for _, logGroup := range cluster.GetIgnoreLogGroupsForUpgrade() {
-		ignoredLogGroups[logGroup] = fdbv1beta2.None{}
-	}
-	unsupportedClients := getUnsupportedClients(status.Cluster.Clients.SupportedVersions, protocolVersion, ignoredLogGroups)
-
 	if len(unsupportedClients) > 0 {
 		message := fmt.Sprintf(
 			"%d clients do not support version %s: %s", len(unsupportedClients),
-			cluster.Spec.Version, strings.Join(unsupportedClients, ", "),
+			cluster.GetDesiredVersion(), strings.Join(unsupportedClients, ", "),
 		)
 		r.Recorder.Event(cluster, corev1.EventTypeNormal, "UnsupportedClient", message)
 		logger.Info("Deferring reconciliation due to unsupported clients", "message", message)
@@ -103,6 +98,22 @@ func (c checkClientCompatibility) reconcile(_ context.Context, r *FoundationDBCl
 	return nil
 }
 
+// getUnsupportedClientsForVersion returns the descriptions of all clients that don't support the provided version.
+// Clients in the log groups that should be ignored for upgrades will be skipped.
+func getUnsupportedClientsForVersion(adminClient fdbadminclient.AdminClient, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, version string) ([]string, error) {
+	protocolVersion, err := adminClient.GetProtocolVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	ignoredLogGroups := make(map[fdbv1beta2.LogGroup]fdbv1beta2.None)
+	for _, logGroup := range cluster.GetIgnoreLogGroupsForUpgrade() {
+		ignoredLogGroups[logGroup] = fdbv1beta2.None{}
+	}
+
+	return getUnsupportedClients(status.Cluster.Clients.SupportedVersions, protocolVersion, ignoredLogGroups), nil
+}
+
 func getUnsupportedClients(supportedVersions []fdbv1beta2.FoundationDBStatusSupportedVersion, protocolVersion string, ignoredLogGroups map[fdbv1beta2.LogGroup]fdbv1beta2.None) []string {
 	var unsupportedClients []string
 	for _, versionInfo := range supportedVersions {
@@ -24,7 +24,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
@@ -124,12 +126,12 @@ func (r *FoundationDBClusterReconciler) Reconcile(ctx context.Context, request c
 		return ctrl.Result{}, fmt.Errorf("ClusterSpec is not valid: %w", err)
 	}
 
-	supportedVersion, err := adminClient.VersionSupported(cluster.Spec.Version)
+	supportedVersion, err := adminClient.VersionSupported(cluster.GetDesiredVersion())
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 	if !supportedVersion {
-		return ctrl.Result{}, fmt.Errorf("version %s is not supported", cluster.Spec.Version)
+		return ctrl.Result{}, fmt.Errorf("version %s is not supported", cluster.GetDesiredVersion())
 	}
 
 	var status *fdbv1beta2.FoundationDBStatus
@@ -146,12 +148,19 @@ func (r *FoundationDBClusterReconciler) Reconcile(ctx context.Context, request c
 		updateLockConfiguration{},
 		updateConfigMap{},
 		checkClientCompatibility{},
+		failoverRegion{},
+		rollbackUpgrade{},
+		updateCanary{},
 		deletePodsForBuggification{},
 		replaceMisconfiguredProcessGroups{},
 		replaceFailedProcessGroups{},
+		migrateStorageEngine{},
+		autoscaleStorage{},
+		autoscaleStateless{},
 		addProcessGroups{},
 		addServices{},
 		addPVCs{},
+		resizePVCs{},
 		addPods{},
 		generateInitialClusterFile{},
 		removeIncompatibleProcesses{},
@@ -159,6 +168,7 @@ func (r *FoundationDBClusterReconciler) Reconcile(ctx context.Context, request c
 		updatePodConfig{},
 		updateMetadata{},
 		updateDatabaseConfiguration{},
+		updateKnobs{},
 		chooseRemovals{},
 		excludeProcesses{},
 		changeCoordinators{},
@@ -170,9 +180,20 @@ func (r *FoundationDBClusterReconciler) Reconcile(ctx context.Context, request c
 		updateStatus{},
 	}
 
+	if cluster.IsDryRun() {
+		return r.reconcileDryRun(ctx, clusterLog, cluster, status, subReconcilers)
+	}
+
 	originalGeneration := cluster.ObjectMeta.Generation
 	normalizedSpec := cluster.Spec.DeepCopy()
 	delayedRequeue := false
+	// If all delayed requeues are caused by deferred sub-reconcilers, the reconciliation will be requeued once the
+	// next maintenance window starts.
+	onlyDeferredRequeues := true
+	var deferredRequeueDelay time.Duration
+	var lastDelayedRequeue *requeue
+	var lastDelayedSubReconciler clusterSubReconciler
+	var deferredSubReconcilers []fdbv1beta2.SubReconcilerName
 
 	for _, subReconciler := range subReconcilers {
 		// We have to set the normalized spec here again otherwise any call to Update() for the status of the cluster
@@ -184,23 +205,44 @@ func (r *FoundationDBClusterReconciler) Reconcile(ctx context.Context, request c
 			continue
 		}
 
+		if requeue.deferred {
+			deferredSubReconcilers = append(deferredSubReconcilers, getSubReconcilerName(subReconciler))
+		}
+
 		if requeue.delayedRequeue {
 			clusterLog.Info("Delaying requeue for sub-reconciler",
 				"reconciler", fmt.Sprintf("%T", subReconciler),
 				"message", requeue.message,
 				"error", requeue.curError)
 			delayedRequeue = true
+			lastDelayedRequeue = requeue
+			lastDelayedSubReconciler = subReconciler
+
+			if requeue.deferred && requeue.delay > 0 {
+				if deferredRequeueDelay == 0 || requeue.delay < deferredRequeueDelay {
+					deferredRequeueDelay = requeue.delay
+				}
+			} else {
+				onlyDeferredRequeues = false
+			}
 			continue
 		}
 
+		r.updateReconciliationStatus(ctx, clusterLog, cluster, originalGeneration, false, requeue, subReconciler, deferredSubReconcilers)
 		return processRequeue(requeue, subReconciler, cluster, r.Recorder, clusterLog)
 	}
 
+	r.updateReconciliationStatus(ctx, clusterLog, cluster, originalGeneration, cluster.Status.Generations.Reconciled >= originalGeneration, lastDelayedRequeue, lastDelayedSubReconciler, deferredSubReconcilers)
+
 	if cluster.Status.Generations.Reconciled < originalGeneration || delayedRequeue {
 		clusterLog.Info("Cluster was not fully reconciled by reconciliation process", "status", cluster.Status.Generations,
 			"CurrentGeneration", cluster.Status.Generations.Reconciled,
 			"OriginalGeneration", originalGeneration, "DelayedRequeue", delayedRequeue)
 
+		if delayedRequeue && onlyDeferredRequeues {
+			return ctrl.Result{RequeueAfter: deferredRequeueDelay}, nil
+		}
+
 		return ctrl.Result{Requeue: true}, nil
 	}
 
@@ -213,6 +255,16 @@ func (r *FoundationDBClusterReconciler) Reconcile(ctx context.Context, request c
 // runClusterSubReconciler will start the subReconciler and will log the duration of the subReconciler.
 func runClusterSubReconciler(ctx context.Context, logger logr.Logger, subReconciler clusterSubReconciler, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus) *requeue {
 	subReconcileLogger := logger.WithValues("reconciler", fmt.Sprintf("%T", subReconciler))
+	if cluster.IsSubReconcilerSuspended(getSubReconcilerName(subReconciler)) {
+		subReconcileLogger.Info("Skipping suspended sub-reconciler")
+		return nil
+	}
+
+	allowed, deferral := checkMaintenanceWindow(subReconcileLogger, cluster, getSubReconcilerName(subReconciler), time.Now())
+	if !allowed {
+		return deferral
+	}
+
 	startTime := time.Now()
 	subReconcileLogger.Info("Attempting to run sub-reconciler")
 	defer func() {
@@ -222,6 +274,17 @@ func runClusterSubReconciler(ctx context.Context, logger logr.Logger, subReconci
 	return subReconciler.reconcile(ctx, r, cluster, status, subReconcileLogger)
 }
 
+// getSubReconcilerName returns the name of the sub-reconciler that is used in the suspended sub-reconcilers of the
+// cluster, e.g. ChooseRemovals for the chooseRemovals sub-reconciler.
+func getSubReconcilerName(subReconciler clusterSubReconciler) fdbv1beta2.SubReconcilerName {
+	name := reflect.TypeOf(subReconciler).Name()
+	if name == "" {
+		return ""
+	}
+
+	return fdbv1beta2.SubReconcilerName(strings.ToUpper(name[:1]) + name[1:])
+}
+
 // SetupWithManager prepares a reconciler for use.
 func (r *FoundationDBClusterReconciler) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles int, enableNodeIndex bool, selector metav1.LabelSelector, watchedObjects ...client.Object) error {
 	err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, "metadata.name", func(o client.Object) []string {
@@ -338,7 +401,7 @@ func (r *FoundationDBClusterReconciler) updatePodDynamicConf(logger logr.Logger,
 	}
 
 	if cluster.IsBeingUpgradedWithVersionIncompatibleVersion() {
-		return podClient.IsPresent(fmt.Sprintf("bin/%s/fdbserver", cluster.Spec.Version))
+		return podClient.IsPresent(fmt.Sprintf("bin/%s/fdbserver", cluster.GetDesiredVersion()))
 	}
 
 	return true, nil
@@ -448,6 +511,53 @@ func (r *FoundationDBClusterReconciler) updateOrApply(ctx context.Context, clust
 	return r.Status().Update(ctx, cluster)
 }
 
+// updateReconciliationStatus sets the Reconciled condition, the information about the blocking sub-reconciler and the
+// information about the maintenance windows of the cluster and updates the status if it has changed. Errors during
+// the update are only logged as the status will be updated in the next reconciliation.
+func (r *FoundationDBClusterReconciler) updateReconciliationStatus(ctx context.Context, logger logr.Logger, cluster *fdbv1beta2.FoundationDBCluster, generation int64, reconciled bool, requeue *requeue, subReconciler clusterSubReconciler, deferredSubReconcilers []fdbv1beta2.SubReconcilerName) {
+	conditionChanged := setStatusCondition(&cluster.Status.Conditions, getReconciledCondition(fdbv1beta2.ClusterConditionReconciled, generation, reconciled, requeue, subReconciler))
+	blockingReconcilerChanged := updateBlockingReconciler(&cluster.Status, requeue, subReconciler)
+	maintenanceWindowChanged := updateMaintenanceWindowStatus(cluster, deferredSubReconcilers, time.Now())
+	if !conditionChanged && !blockingReconcilerChanged && !maintenanceWindowChanged {
+		return
+	}
+
+	err := r.updateOrApply(ctx, cluster)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciliation status")
+	}
+}
+
+// updateBlockingReconciler updates the information about the sub-reconciler that blocked the reconciliation. If the
+// same sub-reconciler blocked the previous reconciliation, the count will be increased and the first seen timestamp
+// will be kept. If requeue is nil, the information will be removed. This method returns true if the status was changed.
+func updateBlockingReconciler(status *fdbv1beta2.FoundationDBClusterStatus, requeue *requeue, subReconciler clusterSubReconciler) bool {
+	if requeue == nil {
+		if status.BlockingReconciler == nil {
+			return false
+		}
+
+		status.BlockingReconciler = nil
+		return true
+	}
+
+	subReconcilerName := string(getSubReconcilerName(subReconciler))
+	if status.BlockingReconciler != nil && status.BlockingReconciler.SubReconciler == subReconcilerName {
+		status.BlockingReconciler.Message = requeue.getMessage()
+		status.BlockingReconciler.Count++
+		return true
+	}
+
+	status.BlockingReconciler = &fdbv1beta2.BlockingReconcilerInfo{
+		SubReconciler: subReconcilerName,
+		Message:       requeue.getMessage(),
+		FirstSeen:     &metav1.Time{Time: time.Now()},
+		Count:         1,
+	}
+
+	return true
+}
+
 // getStatusFromClusterOrDummyStatus will fetch the machine-readable status from the FoundationDBCluster if the cluster is configured. If not a default status is returned indicating, that
 // some configuration is missing.
 func (r *FoundationDBClusterReconciler) getStatusFromClusterOrDummyStatus(logger logr.Logger, cluster *fdbv1beta2.FoundationDBCluster) (*fdbv1beta2.FoundationDBStatus, error) {
@@ -23,9 +23,11 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -46,6 +48,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -143,7 +146,7 @@ var _ = Describe("cluster_controller", func() {
 
 			Expect(err).NotTo(HaveOccurred())
 
-			Expect(result.Requeue).To(Equal(!shouldCompleteReconciliation))
+			Expect(result.Requeue || result.RequeueAfter > 0).To(Equal(!shouldCompleteReconciliation))
 
 			if shouldCompleteReconciliation {
 				generation, err := reloadCluster(cluster)
@@ -161,6 +164,18 @@ var _ = Describe("cluster_controller", func() {
 				generationGap = 0
 			})
 
+			It("should not report a blocking sub-reconciler", func() {
+				Expect(cluster.Status.BlockingReconciler).To(BeNil())
+			})
+
+			It("should mark the cluster as reconciled in the conditions", func() {
+				condition := meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(fdbv1beta2.ConditionReasonReconciliationComplete))
+				Expect(condition.ObservedGeneration).To(Equal(cluster.ObjectMeta.Generation))
+			})
+
 			It("should create pods", func() {
 				pods := &corev1.PodList{}
 				err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
@@ -441,6 +456,241 @@ var _ = Describe("cluster_controller", func() {
 			})
 		})
 
+		When("the cluster is in dry-run mode", func() {
+			BeforeEach(func() {
+				cluster.Annotations = map[string]string{
+					fdbv1beta2.DryRunAnnotation: "true",
+				}
+				cluster.Spec.ProcessCounts.Storage = 3
+				err = k8sClient.Update(context.TODO(), cluster)
+				Expect(err).NotTo(HaveOccurred())
+				generationGap = 0
+			})
+
+			It("should not remove any pods", func() {
+				pods := &corev1.PodList{}
+				err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(len(originalPods.Items)))
+
+				for _, processGroup := range cluster.Status.ProcessGroups {
+					Expect(processGroup.IsMarkedForRemoval()).To(BeFalse())
+				}
+
+				adminClient, err := mock.NewMockAdminClientUncast(cluster, k8sClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(adminClient.ExcludedAddresses).To(BeEmpty())
+			})
+
+			It("should write the planned actions to the plan ConfigMap", func() {
+				configMap := &corev1.ConfigMap{}
+				err = k8sClient.Get(context.TODO(), client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.GetPlanConfigMapName()}, configMap)
+				Expect(err).NotTo(HaveOccurred())
+
+				plan := &fdbv1beta2.ReconciliationPlan{}
+				Expect(json.Unmarshal([]byte(configMap.Data[fdbv1beta2.PlanConfigMapKey]), plan)).NotTo(HaveOccurred())
+				Expect(plan.Generation).To(Equal(cluster.ObjectMeta.Generation))
+				Expect(plan.Actions).To(ContainElements(
+					fdbv1beta2.PlannedAction{
+						SubReconciler: "controllers.chooseRemovals",
+						Type:          fdbv1beta2.PlannedActionRemoveProcessGroup,
+						Target:        "storage-4",
+						Message:       "process class storage",
+					},
+					fdbv1beta2.PlannedAction{
+						SubReconciler: "controllers.excludeProcesses",
+						Type:          fdbv1beta2.PlannedActionExclude,
+						Target:        originalPods.Items[16].Status.PodIP,
+					},
+				))
+			})
+
+			It("should set the Reconciled condition to dry-run", func() {
+				condition := meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal(fdbv1beta2.ConditionReasonDryRun))
+			})
+
+			When("the dry-run annotation is removed", func() {
+				JustBeforeEach(func() {
+					cluster.Annotations = nil
+					Expect(k8sClient.Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+					_, err = reconcileCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = reloadCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should remove the pods", func() {
+					pods := &corev1.PodList{}
+					err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pods.Items).To(HaveLen(len(originalPods.Items) - 1))
+					Expect(cluster.Status.Generations.Reconciled).To(Equal(cluster.ObjectMeta.Generation))
+				})
+			})
+		})
+
+		When("the removals are suspended", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.SuspendedSubReconcilers = []fdbv1beta2.SubReconcilerName{fdbv1beta2.SubReconcilerClassRemovals}
+				cluster.Spec.ProcessCounts.Storage = 3
+				err = k8sClient.Update(context.TODO(), cluster)
+				Expect(err).NotTo(HaveOccurred())
+				shouldCompleteReconciliation = false
+			})
+
+			It("should not remove the pods", func() {
+				pods := &corev1.PodList{}
+				err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(len(originalPods.Items)))
+
+				_, err = reloadCluster(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cluster.Status.Generations.Reconciled).To(Equal(originalVersion))
+				for _, processGroup := range cluster.Status.ProcessGroups {
+					Expect(processGroup.IsMarkedForRemoval()).To(BeFalse())
+				}
+			})
+
+			It("should report the suspended sub-reconcilers in the status", func() {
+				_, err = reloadCluster(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cluster.Status.SuspendedSubReconcilers).To(ConsistOf(
+					fdbv1beta2.SubReconcilerChooseRemovals,
+					fdbv1beta2.SubReconcilerExcludeProcesses,
+					fdbv1beta2.SubReconcilerRemoveProcessGroups,
+					fdbv1beta2.SubReconcilerRemoveServices,
+				))
+			})
+
+			When("the removals are resumed", func() {
+				JustBeforeEach(func() {
+					_, err = reloadCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+					cluster.Spec.AutomationOptions.SuspendedSubReconcilers = nil
+					Expect(k8sClient.Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+					_, err = reconcileCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = reloadCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should remove the pods", func() {
+					pods := &corev1.PodList{}
+					err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pods.Items).To(HaveLen(len(originalPods.Items) - 1))
+					Expect(cluster.Status.SuspendedSubReconcilers).To(BeEmpty())
+				})
+			})
+		})
+
+		When("the cluster is outside of the maintenance window", func() {
+			BeforeEach(func() {
+				// The deny window is active at any time.
+				cluster.Spec.AutomationOptions.MaintenanceWindowOptions.DenyWindows = []fdbv1beta2.MaintenanceWindow{
+					{
+						Schedule:        "* * * * *",
+						DurationSeconds: 3600,
+					},
+				}
+				cluster.Spec.ProcessCounts.Storage = 3
+				err = k8sClient.Update(context.TODO(), cluster)
+				Expect(err).NotTo(HaveOccurred())
+				shouldCompleteReconciliation = false
+			})
+
+			It("should not remove the pods", func() {
+				pods := &corev1.PodList{}
+				err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(len(originalPods.Items)))
+
+				_, err = reloadCluster(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cluster.Status.Generations.Reconciled).To(Equal(originalVersion))
+			})
+
+			It("should report the deferred sub-reconcilers in the status", func() {
+				_, err = reloadCluster(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cluster.Status.MaintenanceWindow).NotTo(BeNil())
+				Expect(cluster.Status.MaintenanceWindow.DisruptionAllowed).To(BeFalse())
+				Expect(cluster.Status.MaintenanceWindow.DeferredSubReconcilers).To(ContainElements(
+					fdbv1beta2.SubReconcilerExcludeProcesses,
+					fdbv1beta2.SubReconcilerRemoveProcessGroups,
+				))
+			})
+
+			When("the maintenance windows are removed", func() {
+				JustBeforeEach(func() {
+					_, err = reloadCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+					cluster.Spec.AutomationOptions.MaintenanceWindowOptions.DenyWindows = nil
+					Expect(k8sClient.Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+					_, err = reconcileCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = reloadCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should remove the pods", func() {
+					pods := &corev1.PodList{}
+					err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pods.Items).To(HaveLen(len(originalPods.Items) - 1))
+					Expect(cluster.Status.MaintenanceWindow).To(BeNil())
+				})
+			})
+		})
+
+		When("the process count is decreased before the next maintenance window", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.MaintenanceWindowOptions.Windows = []fdbv1beta2.MaintenanceWindow{
+					{
+						Schedule:        fmt.Sprintf("0 %d * * *", (time.Now().UTC().Hour()+12)%24),
+						DurationSeconds: 3600,
+					},
+				}
+				cluster.Spec.ProcessCounts.Storage = 3
+				err = k8sClient.Update(context.TODO(), cluster)
+				Expect(err).NotTo(HaveOccurred())
+				shouldCompleteReconciliation = false
+			})
+
+			It("should requeue once the next maintenance window starts", func() {
+				result, err := reconcileCluster(cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Requeue).To(BeFalse())
+				Expect(result.RequeueAfter).To(BeNumerically(">", 10*time.Hour))
+				Expect(result.RequeueAfter).To(BeNumerically("<=", 12*time.Hour))
+			})
+		})
+
+		When("the process count is increased outside of the maintenance window", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.MaintenanceWindowOptions.DenyWindows = []fdbv1beta2.MaintenanceWindow{
+					{
+						Schedule:        "* * * * *",
+						DurationSeconds: 3600,
+					},
+				}
+				cluster.Spec.ProcessCounts.Storage = 5
+				err = k8sClient.Update(context.TODO(), cluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should add the pods", func() {
+				pods := &corev1.PodList{}
+				err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(len(originalPods.Items) + 1))
+			})
+		})
+
 		Context("with an increased process count", func() {
 			BeforeEach(func() {
 				cluster.Spec.ProcessCounts.Storage = 5
@@ -1187,6 +1437,45 @@ var _ = Describe("cluster_controller", func() {
 				})
 			})
 
+			Context("with the perpetual storage wiggle enabled", func() {
+				BeforeEach(func() {
+					migrationType := fdbv1beta2.StorageMigrationTypeGradual
+					// The perpetual storage wiggle settings are only supported with 7.1 or newer.
+					cluster.Spec.Version = fdbv1beta2.Versions.SupportsPerpetualStorageWiggle.String()
+					cluster.Spec.DatabaseConfiguration.PerpetualStorageWiggle = pointer.Int(1)
+					cluster.Spec.DatabaseConfiguration.StorageMigrationType = &migrationType
+					generationGap = 1
+					err = k8sClient.Update(context.TODO(), cluster)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should configure the database", func() {
+					Expect(adminClient.DatabaseConfiguration.PerpetualStorageWiggle).To(HaveValue(Equal(1)))
+					Expect(adminClient.DatabaseConfiguration.StorageMigrationType).To(HaveValue(Equal(fdbv1beta2.StorageMigrationTypeGradual)))
+					Expect(adminClient.DatabaseConfiguration.PerpetualStorageWiggleLocality).To(BeNil())
+				})
+			})
+
+			Context("with the perpetual storage wiggle enabled that is not set in the spec", func() {
+				BeforeEach(func() {
+					cluster.Spec.DatabaseConfiguration.RedundancyMode = fdbv1beta2.RedundancyModeDouble
+					cluster.Spec.SeedConnectionString = "touch"
+
+					configuration := cluster.DesiredDatabaseConfiguration()
+					configuration.PerpetualStorageWiggle = pointer.Int(1)
+					err = adminClient.ConfigureDatabase(configuration, false, cluster.Spec.Version)
+					Expect(err).NotTo(HaveOccurred())
+
+					generationGap = 1
+					err = k8sClient.Update(context.TODO(), cluster)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should not reconfigure the database", func() {
+					Expect(adminClient.DatabaseConfiguration.PerpetualStorageWiggle).To(HaveValue(Equal(1)))
+				})
+			})
+
 			Context("with changes disabled", func() {
 				BeforeEach(func() {
 					shouldCompleteReconciliation = false
@@ -1603,6 +1892,76 @@ var _ = Describe("cluster_controller", func() {
 				})
 			})
 
+			Context("with canary rollouts and manual promotion enabled", func() {
+				BeforeEach(func() {
+					cluster.Spec.AutomationOptions.CanaryOptions = fdbv1beta2.CanaryOptions{
+						Enabled:         pointer.Bool(true),
+						SoakTimeSeconds: pointer.Int(0),
+						ManualPromotion: pointer.Bool(true),
+					}
+					shouldCompleteReconciliation = false
+
+					err = k8sClient.Update(context.TODO(), cluster)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should only set the environment variable on the canary pods", func() {
+					pods := &corev1.PodList{}
+					err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+					Expect(err).NotTo(HaveOccurred())
+
+					var updatedPods int
+					for _, pod := range pods.Items {
+						if pod.Spec.Containers[0].Env[0].Name == "TEST_CHANGE" {
+							updatedPods++
+						}
+					}
+
+					// One process group per process class is updated.
+					Expect(updatedPods).To(Equal(4))
+				})
+
+				It("should wait for the promotion", func() {
+					_, err = reloadCluster(cluster)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(cluster.Status.Canary).NotTo(BeNil())
+					Expect(cluster.Status.Canary.Generation).To(Equal(originalVersion + 1))
+					Expect(cluster.Status.Canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseAwaitingPromotion))
+					condition := meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionCanaryHalted)
+					Expect(condition).NotTo(BeNil())
+					Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+					Expect(condition.Reason).To(Equal(fdbv1beta2.ConditionReasonCanaryAwaitingPromotion))
+				})
+
+				When("the canary rollout is promoted", func() {
+					JustBeforeEach(func() {
+						_, err = reloadCluster(cluster)
+						Expect(err).NotTo(HaveOccurred())
+						cluster.Annotations = map[string]string{
+							fdbv1beta2.CanaryPromotionAnnotation: strconv.FormatInt(cluster.Status.Canary.Generation, 10),
+						}
+						Expect(k8sClient.Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+						_, err = reconcileCluster(cluster)
+						Expect(err).NotTo(HaveOccurred())
+						_, err = reloadCluster(cluster)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should set the environment variable on all pods", func() {
+						pods := &corev1.PodList{}
+						err = k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)
+						Expect(err).NotTo(HaveOccurred())
+
+						for _, pod := range pods.Items {
+							Expect(pod.Spec.Containers[0].Env[0].Name).To(Equal("TEST_CHANGE"))
+						}
+
+						Expect(cluster.Status.Canary).To(BeNil())
+						Expect(meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionCanaryHalted)).To(BeNil())
+					})
+				})
+			})
+
 			Context("with deletion disabled", func() {
 				BeforeEach(func() {
 					cluster.Spec.AutomationOptions.DeletionMode = fdbv1beta2.PodUpdateModeNone
@@ -2077,6 +2436,54 @@ var _ = Describe("cluster_controller", func() {
 				})
 			})
 
+			Context("with a version that is locked out after an upgrade rollback", func() {
+				BeforeEach(func() {
+					Expect(k8sClient.Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+					cluster.Status.UpgradeRollback = &fdbv1beta2.UpgradeRollbackStatus{
+						FailedVersion:   fdbv1beta2.Versions.NextPatchVersion.String(),
+						PreviousVersion: fdbv1beta2.Versions.Default.String(),
+					}
+					Expect(k8sClient.Status().Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+				})
+
+				It("should keep the previous image on the pods", func() {
+					pods := &corev1.PodList{}
+					Expect(k8sClient.List(context.TODO(), pods, getListOptions(cluster)...)).NotTo(HaveOccurred())
+
+					for _, pod := range pods.Items {
+						Expect(pod.Spec.Containers[0].Image).To(Equal(fmt.Sprintf("foundationdb/foundationdb:%s", fdbv1beta2.Versions.Default.String())))
+					}
+				})
+
+				It("should keep the previous running version", func() {
+					Expect(cluster.Status.RunningVersion).To(Equal(fdbv1beta2.Versions.Default.String()))
+					Expect(cluster.Status.UpgradeRollback).NotTo(BeNil())
+				})
+
+				It("should not modify the version in the spec", func() {
+					Expect(cluster.Spec.Version).To(Equal(fdbv1beta2.Versions.NextPatchVersion.String()))
+					Expect(cluster.GetDesiredVersion()).To(Equal(fdbv1beta2.Versions.Default.String()))
+				})
+
+				When("the rollback is acknowledged", func() {
+					JustBeforeEach(func() {
+						cluster.Annotations = map[string]string{
+							fdbv1beta2.AcknowledgeUpgradeRollbackAnnotation: fdbv1beta2.Versions.NextPatchVersion.String(),
+						}
+						Expect(k8sClient.Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+						_, err = reconcileCluster(cluster)
+						Expect(err).NotTo(HaveOccurred())
+						_, err = reloadCluster(cluster)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should upgrade the cluster", func() {
+						Expect(cluster.Status.RunningVersion).To(Equal(fdbv1beta2.Versions.NextPatchVersion.String()))
+						Expect(cluster.Status.UpgradeRollback).To(BeNil())
+					})
+				})
+			})
+
 			Context("with the replace transaction strategy", func() {
 				BeforeEach(func() {
 					cluster.Spec.AutomationOptions.PodUpdateStrategy = fdbv1beta2.PodUpdateStrategyTransactionReplacement
@@ -3709,6 +4116,147 @@ var _ = Describe("cluster_controller", func() {
 			})
 		})
 	})
+
+	DescribeTable("getting the sub-reconciler name", func(subReconciler clusterSubReconciler, expected fdbv1beta2.SubReconcilerName) {
+		Expect(getSubReconcilerName(subReconciler)).To(Equal(expected))
+	},
+		Entry("choose removals", chooseRemovals{}, fdbv1beta2.SubReconcilerChooseRemovals),
+		Entry("add PVCs", addPVCs{}, fdbv1beta2.SubReconcilerAddPVCs),
+		Entry("resize PVCs", resizePVCs{}, fdbv1beta2.SubReconcilerResizePVCs),
+		Entry("update pods", updatePods{}, fdbv1beta2.SubReconcilerUpdatePods),
+		Entry("update status", updateStatus{}, fdbv1beta2.SubReconcilerName("UpdateStatus")),
+	)
+
+	DescribeTable("getting the reconciled condition", func(reconciled bool, requeue *requeue, expected metav1.Condition) {
+		Expect(getReconciledCondition(fdbv1beta2.ClusterConditionReconciled, 2, reconciled, requeue, changeCoordinators{})).To(Equal(expected))
+	},
+		Entry("the generation is reconciled",
+			true,
+			nil,
+			metav1.Condition{
+				Type:               fdbv1beta2.ClusterConditionReconciled,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 2,
+				Reason:             fdbv1beta2.ConditionReasonReconciliationComplete,
+				Message:            "Reconciled generation 2",
+			}),
+		Entry("the generation is not reconciled",
+			false,
+			nil,
+			metav1.Condition{
+				Type:               fdbv1beta2.ClusterConditionReconciled,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             fdbv1beta2.ConditionReasonReconciliationIncomplete,
+				Message:            "Generation 2 was not fully reconciled",
+			}),
+		Entry("a sub-reconciler returned an error",
+			false,
+			&requeue{curError: fmt.Errorf("test error")},
+			metav1.Condition{
+				Type:               fdbv1beta2.ClusterConditionReconciled,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             fdbv1beta2.ConditionReasonReconciliationError,
+				Message:            "controllers.changeCoordinators: test error",
+			}),
+		Entry("a sub-reconciler is blocked",
+			false,
+			&requeue{message: "waiting for locks"},
+			metav1.Condition{
+				Type:               fdbv1beta2.ClusterConditionReconciled,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             fdbv1beta2.ConditionReasonReconciliationBlocked,
+				Message:            "controllers.changeCoordinators: waiting for locks",
+			}),
+		Entry("a sub-reconciler delayed the requeue",
+			false,
+			&requeue{message: "waiting for processes", delayedRequeue: true},
+			metav1.Condition{
+				Type:               fdbv1beta2.ClusterConditionReconciled,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             fdbv1beta2.ConditionReasonReconciliationDelayed,
+				Message:            "controllers.changeCoordinators: waiting for processes",
+			}),
+	)
+
+	When("updating the blocking sub-reconciler", func() {
+		var status *fdbv1beta2.FoundationDBClusterStatus
+		var changed bool
+
+		BeforeEach(func() {
+			status = &fdbv1beta2.FoundationDBClusterStatus{}
+		})
+
+		When("no sub-reconciler blocked the reconciliation before", func() {
+			BeforeEach(func() {
+				changed = updateBlockingReconciler(status, &requeue{message: "waiting for locks"}, changeCoordinators{})
+			})
+
+			It("should add the blocking sub-reconciler", func() {
+				Expect(changed).To(BeTrue())
+				Expect(status.BlockingReconciler).NotTo(BeNil())
+				Expect(status.BlockingReconciler.SubReconciler).To(Equal("ChangeCoordinators"))
+				Expect(status.BlockingReconciler.Message).To(Equal("waiting for locks"))
+				Expect(status.BlockingReconciler.FirstSeen).NotTo(BeNil())
+				Expect(status.BlockingReconciler.Count).To(Equal(1))
+			})
+
+			When("the same sub-reconciler blocks the reconciliation again", func() {
+				var firstSeen *metav1.Time
+
+				BeforeEach(func() {
+					firstSeen = status.BlockingReconciler.FirstSeen.DeepCopy()
+					changed = updateBlockingReconciler(status, &requeue{curError: fmt.Errorf("test error")}, changeCoordinators{})
+				})
+
+				It("should increase the count and keep the first seen timestamp", func() {
+					Expect(changed).To(BeTrue())
+					Expect(status.BlockingReconciler.SubReconciler).To(Equal("ChangeCoordinators"))
+					Expect(status.BlockingReconciler.Message).To(Equal("test error"))
+					Expect(status.BlockingReconciler.FirstSeen).To(Equal(firstSeen))
+					Expect(status.BlockingReconciler.Count).To(Equal(2))
+				})
+			})
+
+			When("a different sub-reconciler blocks the reconciliation", func() {
+				BeforeEach(func() {
+					changed = updateBlockingReconciler(status, &requeue{message: "waiting for exclusions"}, excludeProcesses{})
+				})
+
+				It("should replace the blocking sub-reconciler", func() {
+					Expect(changed).To(BeTrue())
+					Expect(status.BlockingReconciler.SubReconciler).To(Equal("ExcludeProcesses"))
+					Expect(status.BlockingReconciler.Message).To(Equal("waiting for exclusions"))
+					Expect(status.BlockingReconciler.Count).To(Equal(1))
+				})
+			})
+
+			When("the reconciliation is not blocked anymore", func() {
+				BeforeEach(func() {
+					changed = updateBlockingReconciler(status, nil, nil)
+				})
+
+				It("should remove the blocking sub-reconciler", func() {
+					Expect(changed).To(BeTrue())
+					Expect(status.BlockingReconciler).To(BeNil())
+				})
+			})
+		})
+
+		When("the reconciliation is not blocked", func() {
+			BeforeEach(func() {
+				changed = updateBlockingReconciler(status, nil, nil)
+			})
+
+			It("should not change the status", func() {
+				Expect(changed).To(BeFalse())
+				Expect(status.BlockingReconciler).To(BeNil())
+			})
+		})
+	})
 })
 
 func getProcessClassMap(cluster *fdbv1beta2.FoundationDBCluster, pods []corev1.Pod) map[fdbv1beta2.ProcessClass]int {
@@ -26,9 +26,11 @@ import (
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -92,6 +94,19 @@ type requeue struct {
 
 	// delayedRequeue defines that the reconciliation was not completed but the requeue should be delayed to the end.
 	delayedRequeue bool
+
+	// deferred defines that the sub-reconciler was not executed because disruptive operations are only allowed inside
+	// the maintenance windows.
+	deferred bool
+}
+
+// getMessage returns the message of the requeue. If no message is set, the message of the error will be returned.
+func (requeue *requeue) getMessage() string {
+	if requeue.message == "" && requeue.curError != nil {
+		return requeue.curError.Error()
+	}
+
+	return requeue.message
 }
 
 // processRequeue interprets a requeue result from a subreconciler.
@@ -119,3 +134,73 @@ func processRequeue(requeue *requeue, subReconciler interface{}, object runtime.
 
 	return ctrl.Result{Requeue: true, RequeueAfter: requeue.delay}, nil
 }
+
+// getReconciledCondition returns the Reconciled condition for the result of a sub-reconciler chain. If the chain was
+// terminated by a requeue, the condition contains the sub-reconciler and the message of the requeue. If requeue is nil
+// the condition reflects if the provided generation was reconciled.
+func getReconciledCondition(conditionType string, generation int64, reconciled bool, requeue *requeue, subReconciler interface{}) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		ObservedGeneration: generation,
+	}
+
+	if requeue == nil {
+		if reconciled {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = fdbv1beta2.ConditionReasonReconciliationComplete
+			condition.Message = fmt.Sprintf("Reconciled generation %d", generation)
+		} else {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = fdbv1beta2.ConditionReasonReconciliationIncomplete
+			condition.Message = fmt.Sprintf("Generation %d was not fully reconciled", generation)
+		}
+
+		return condition
+	}
+
+	condition.Status = metav1.ConditionFalse
+	condition.Message = fmt.Sprintf("%T: %s", subReconciler, requeue.getMessage())
+	if requeue.curError != nil {
+		condition.Reason = fdbv1beta2.ConditionReasonReconciliationError
+	} else if requeue.delayedRequeue {
+		condition.Reason = fdbv1beta2.ConditionReasonReconciliationDelayed
+	} else {
+		condition.Reason = fdbv1beta2.ConditionReasonReconciliationBlocked
+	}
+
+	return condition
+}
+
+// setStatusCondition sets the provided condition in the list of conditions and returns true if the condition was
+// added or changed. The last transition time will only be updated if the status of the condition changed.
+func setStatusCondition(conditions *[]metav1.Condition, condition metav1.Condition) bool {
+	existing := meta.FindStatusCondition(*conditions, condition.Type)
+	if existing != nil &&
+		existing.Status == condition.Status &&
+		existing.Reason == condition.Reason &&
+		existing.Message == condition.Message &&
+		existing.ObservedGeneration == condition.ObservedGeneration {
+		return false
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+	return true
+}
+
+// newStatusCondition creates a condition with the True or False status depending on the provided value.
+func newStatusCondition(conditionType string, generation int64, value bool, trueReason string, falseReason string, message string) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             falseReason,
+		Message:            message,
+	}
+
+	if value {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = trueReason
+	}
+
+	return condition
+}
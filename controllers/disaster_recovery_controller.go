@@ -0,0 +1,285 @@
+/*
+ * disaster_recovery_controller.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// disasterRecoveryStatusRequeueDelay defines how long the operator waits until the status of a running replication is
+// checked again.
+const disasterRecoveryStatusRequeueDelay = 1 * time.Minute
+
+// FoundationDBDisasterRecoveryReconciler reconciles a FoundationDBDisasterRecovery object
+type FoundationDBDisasterRecoveryReconciler struct {
+	client.Client
+	Recorder               record.EventRecorder
+	Log                    logr.Logger
+	InSimulation           bool
+	DatabaseClientProvider fdbadminclient.DatabaseClientProvider
+	ServerSideApply        bool
+}
+
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbdisasterrecoveries,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbdisasterrecoveries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="coordination.k8s.io",resources=leases,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile runs the reconciliation logic.
+func (r *FoundationDBDisasterRecoveryReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	dr := &fdbv1beta2.FoundationDBDisasterRecovery{}
+
+	err := r.Get(ctx, request.NamespacedName, dr)
+
+	originalGeneration := dr.ObjectMeta.Generation
+
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically garbage collected.
+			// For additional cleanup logic use finalizers.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	drLog := globalControllerLogger.WithValues("namespace", dr.Namespace, "disasterRecovery", dr.Name)
+
+	subReconcilers := []disasterRecoverySubReconciler{
+		updateDisasterRecoveryStatus{},
+		updateDisasterRecoveryAgents{},
+		startDisasterRecovery{},
+		stopDisasterRecovery{},
+		toggleDisasterRecoveryPaused{},
+		switchDisasterRecovery{},
+		updateDisasterRecoveryStatus{},
+	}
+
+	for _, subReconciler := range subReconcilers {
+		requeue := subReconciler.reconcile(ctx, r, dr)
+		if requeue == nil {
+			continue
+		}
+
+		r.updateReconciledCondition(ctx, drLog, dr, getReconciledCondition(fdbv1beta2.DisasterRecoveryConditionReconciled, originalGeneration, false, requeue, subReconciler))
+		return processRequeue(requeue, subReconciler, dr, r.Recorder, drLog)
+	}
+
+	r.updateReconciledCondition(ctx, drLog, dr, getReconciledCondition(fdbv1beta2.DisasterRecoveryConditionReconciled, originalGeneration, dr.Status.Generations.Reconciled >= originalGeneration, nil, nil))
+
+	if dr.Status.Generations.Reconciled < originalGeneration {
+		drLog.Info("Disaster recovery was not fully reconciled by reconciliation process")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	drLog.Info("Reconciliation complete")
+
+	// The lag of the replication changes constantly, so we have to refresh the status periodically.
+	if dr.Status.DRDetails != nil && dr.Status.DRDetails.Running {
+		return ctrl.Result{RequeueAfter: disasterRecoveryStatusRequeueDelay}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getDatabaseClientProvider gets the client provider for a reconciler.
+func (r *FoundationDBDisasterRecoveryReconciler) getDatabaseClientProvider() fdbadminclient.DatabaseClientProvider {
+	if r.DatabaseClientProvider != nil {
+		return r.DatabaseClientProvider
+	}
+	panic("Disaster recovery reconciler does not have a DatabaseClientProvider defined")
+}
+
+// getClusterForReference returns the cluster that is referenced in the disaster recovery. Clusters that are defined by
+// their connection string are not managed in this Kubernetes cluster, so only the information required to connect to
+// those clusters will be set.
+func (r *FoundationDBDisasterRecoveryReconciler) getClusterForReference(ctx context.Context, dr *fdbv1beta2.FoundationDBDisasterRecovery, reference fdbv1beta2.DisasterRecoveryClusterReference, role fdbv1beta2.DisasterRecoveryClusterRole) (*fdbv1beta2.FoundationDBCluster, error) {
+	if reference.ConnectionString != "" {
+		name := reference.Name
+		if name == "" {
+			name = fmt.Sprintf("%s-%s", dr.Name, strings.ToLower(string(role)))
+		}
+
+		return &fdbv1beta2.FoundationDBCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: reference.GetNamespace(dr.Namespace),
+				UID:       types.UID(fmt.Sprintf("%s-%s", dr.UID, strings.ToLower(string(role)))),
+			},
+			Spec: fdbv1beta2.FoundationDBClusterSpec{
+				Version: dr.Spec.Version,
+			},
+			Status: fdbv1beta2.FoundationDBClusterStatus{
+				ConnectionString: reference.ConnectionString,
+				RunningVersion:   dr.Spec.Version,
+			},
+		}, nil
+	}
+
+	if reference.Name == "" {
+		return nil, fmt.Errorf("the %s cluster must define a name or a connection string", strings.ToLower(string(role)))
+	}
+
+	cluster := &fdbv1beta2.FoundationDBCluster{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: reference.GetNamespace(dr.Namespace), Name: reference.Name}, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// getReplicationClusters returns the cluster that is currently replicated and the cluster that currently receives the
+// replicated data.
+func (r *FoundationDBDisasterRecoveryReconciler) getReplicationClusters(ctx context.Context, dr *fdbv1beta2.FoundationDBDisasterRecovery) (*fdbv1beta2.FoundationDBCluster, *fdbv1beta2.FoundationDBCluster, error) {
+	sourceReference, destinationReference := dr.GetReplicationClusters()
+	sourceRole, destinationRole := fdbv1beta2.DisasterRecoveryClusterRoleSource, fdbv1beta2.DisasterRecoveryClusterRoleDestination
+	if dr.GetActiveCluster() == fdbv1beta2.DisasterRecoveryClusterRoleDestination {
+		sourceRole, destinationRole = destinationRole, sourceRole
+	}
+
+	source, err := r.getClusterForReference(ctx, dr, sourceReference, sourceRole)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	destination, err := r.getClusterForReference(ctx, dr, destinationReference, destinationRole)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return source, destination, nil
+}
+
+// adminClientForDisasterRecovery provides an admin client for the cluster that currently receives the replicated data
+// and the connection string of the cluster that is currently replicated.
+func (r *FoundationDBDisasterRecoveryReconciler) adminClientForDisasterRecovery(ctx context.Context, dr *fdbv1beta2.FoundationDBDisasterRecovery) (fdbadminclient.AdminClient, string, error) {
+	source, destination, err := r.getReplicationClusters(ctx, dr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	adminClient, err := r.getDatabaseClientProvider().GetAdminClient(destination, r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	adminClient.SetKnobs(dr.Spec.CustomParameters.GetKnobsForCLI())
+
+	return adminClient, source.Status.ConnectionString, nil
+}
+
+// SetupWithManager prepares a reconciler for use.
+func (r *FoundationDBDisasterRecoveryReconciler) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles int, selector metav1.LabelSelector) error {
+	labelSelectorPredicate, err := predicate.LabelSelectorPredicate(selector)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrentReconciles},
+		).
+		For(&fdbv1beta2.FoundationDBDisasterRecovery{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.ConfigMap{}).
+		// Only react on generation changes or annotation changes and only watch
+		// resources with the provided label selector.
+		WithEventFilter(
+			predicate.And(
+				labelSelectorPredicate,
+				predicate.Or(
+					predicate.GenerationChangedPredicate{},
+					predicate.AnnotationChangedPredicate{},
+				),
+			)).
+		Complete(r)
+}
+
+// disasterRecoverySubReconciler describes a class that does part of the work of
+// reconciliation for a disaster recovery.
+type disasterRecoverySubReconciler interface {
+	/**
+	reconcile runs the reconciler's work.
+
+	If reconciliation can continue, this should return nil.
+
+	If reconciliation encounters an error, this should return a requeue object
+	with an `Error` field.
+
+	If reconciliation cannot proceed, this should return a requeue object with a
+	`Message` field.
+	*/
+	reconcile(ctx context.Context, r *FoundationDBDisasterRecoveryReconciler, dr *fdbv1beta2.FoundationDBDisasterRecovery) *requeue
+}
+
+// updateReconciledCondition sets the Reconciled condition of the disaster recovery and updates the status if the
+// condition has changed. Errors during the update are only logged as the condition will be updated in the next
+// reconciliation.
+func (r *FoundationDBDisasterRecoveryReconciler) updateReconciledCondition(ctx context.Context, logger logr.Logger, dr *fdbv1beta2.FoundationDBDisasterRecovery, condition metav1.Condition) {
+	if !setStatusCondition(&dr.Status.Conditions, condition) {
+		return
+	}
+
+	err := r.updateOrApply(ctx, dr)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciled condition", "reason", condition.Reason)
+	}
+}
+
+// updateOrApply updates the status either with server-side apply or if disabled with the normal update call.
+func (r *FoundationDBDisasterRecoveryReconciler) updateOrApply(ctx context.Context, dr *fdbv1beta2.FoundationDBDisasterRecovery) error {
+	if r.ServerSideApply {
+		patch := &fdbv1beta2.FoundationDBDisasterRecovery{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       dr.Kind,
+				APIVersion: dr.APIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dr.Name,
+				Namespace: dr.Namespace,
+			},
+			Status: dr.Status,
+		}
+
+		return r.Status().Patch(ctx, patch, client.Apply, client.FieldOwner("fdb-operator"))
+	}
+
+	return r.Status().Update(ctx, dr)
+}
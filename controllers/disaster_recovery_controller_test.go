@@ -0,0 +1,250 @@
+/*
+ * disaster_recovery_controller_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("disaster_recovery_controller", func() {
+	var source *fdbv1beta2.FoundationDBCluster
+	var destination *fdbv1beta2.FoundationDBCluster
+	var dr *fdbv1beta2.FoundationDBDisasterRecovery
+	var sourceAdminClient *mock.AdminClient
+	var destinationAdminClient *mock.AdminClient
+
+	reloadDisasterRecovery := func() {
+		Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(dr), dr)).NotTo(HaveOccurred())
+	}
+
+	getConfigMap := func() *corev1.ConfigMap {
+		configMap := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: dr.Namespace, Name: dr.Name + "-dr-config"}, configMap)).NotTo(HaveOccurred())
+		return configMap
+	}
+
+	BeforeEach(func() {
+		source = internal.CreateDefaultCluster()
+		source.Name = "source"
+		Expect(setupClusterForTest(source)).NotTo(HaveOccurred())
+
+		destination = internal.CreateDefaultCluster()
+		destination.Name = "destination"
+		Expect(setupClusterForTest(destination)).NotTo(HaveOccurred())
+
+		var err error
+		sourceAdminClient, err = mock.NewMockAdminClientUncast(source, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		destinationAdminClient, err = mock.NewMockAdminClientUncast(destination, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		dr = internal.CreateDefaultDisasterRecovery(source, destination)
+	})
+
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(context.TODO(), dr)).NotTo(HaveOccurred())
+		_, err := reconcileDisasterRecovery(dr)
+		Expect(err).NotTo(HaveOccurred())
+		reloadDisasterRecovery()
+	})
+
+	When("the disaster recovery is created", func() {
+		It("should start the replication into the destination cluster", func() {
+			Expect(destinationAdminClient.DisasterRecoveries).To(HaveKey(source.Status.ConnectionString))
+			Expect(dr.Status.ActiveCluster).To(Equal(fdbv1beta2.DisasterRecoveryClusterRoleSource))
+			Expect(dr.Status.DRDetails).To(Equal(&fdbv1beta2.FoundationDBDisasterRecoveryStatusDetails{
+				State:   fdbv1beta2.DisasterRecoveryReplicationStateReplicating,
+				Running: true,
+			}))
+		})
+
+		It("should mark the disaster recovery as reconciled", func() {
+			Expect(dr.Status.Generations.Reconciled).To(Equal(dr.Generation))
+			condition := meta.FindStatusCondition(dr.Status.Conditions, fdbv1beta2.DisasterRecoveryConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			condition = meta.FindStatusCondition(dr.Status.Conditions, fdbv1beta2.DisasterRecoveryConditionRunning)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should create the DR agents", func() {
+			Expect(getConfigMap().Data).To(Equal(map[string]string{
+				internal.SourceClusterFileKey:      source.Status.ConnectionString,
+				internal.DestinationClusterFileKey: destination.Status.ConnectionString,
+			}))
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: dr.Namespace, Name: dr.Name + "-dr-agents"}, deployment)).NotTo(HaveOccurred())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+			Expect(dr.Status.AgentCount).To(Equal(3))
+			Expect(dr.Status.DeploymentConfigured).To(BeTrue())
+		})
+	})
+
+	When("the replication lags behind", func() {
+		JustBeforeEach(func() {
+			destinationAdminClient.MockDisasterRecoveryStatus(source.Status.ConnectionString, fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+				State:           fdbv1beta2.DisasterRecoveryReplicationStateReplicating,
+				LagMilliseconds: 1500,
+			})
+			_, err := reconcileDisasterRecovery(dr)
+			Expect(err).NotTo(HaveOccurred())
+			reloadDisasterRecovery()
+		})
+
+		It("should report the lag in the status", func() {
+			Expect(dr.Status.DRDetails).NotTo(BeNil())
+			Expect(dr.Status.DRDetails.LagMilliseconds).To(BeNumerically("==", 1500))
+		})
+	})
+
+	When("the replication is paused", func() {
+		JustBeforeEach(func() {
+			dr.Spec.DRState = fdbv1beta2.DisasterRecoveryStatePaused
+			Expect(k8sClient.Update(context.TODO(), dr)).NotTo(HaveOccurred())
+			_, err := reconcileDisasterRecovery(dr)
+			Expect(err).NotTo(HaveOccurred())
+			reloadDisasterRecovery()
+		})
+
+		It("should pause the replication", func() {
+			Expect(destinationAdminClient.DisasterRecoveries[source.Status.ConnectionString].Paused).To(BeTrue())
+			Expect(dr.Status.DRDetails.Paused).To(BeTrue())
+			Expect(dr.Status.Generations.Reconciled).To(Equal(dr.Generation))
+		})
+
+		When("the replication is resumed", func() {
+			JustBeforeEach(func() {
+				dr.Spec.DRState = fdbv1beta2.DisasterRecoveryStateRunning
+				Expect(k8sClient.Update(context.TODO(), dr)).NotTo(HaveOccurred())
+				_, err := reconcileDisasterRecovery(dr)
+				Expect(err).NotTo(HaveOccurred())
+				reloadDisasterRecovery()
+			})
+
+			It("should resume the replication", func() {
+				Expect(destinationAdminClient.DisasterRecoveries[source.Status.ConnectionString].Paused).To(BeFalse())
+				Expect(dr.Status.DRDetails.Paused).To(BeFalse())
+				Expect(dr.Status.Generations.Reconciled).To(Equal(dr.Generation))
+			})
+		})
+	})
+
+	When("the replication is stopped", func() {
+		JustBeforeEach(func() {
+			dr.Spec.DRState = fdbv1beta2.DisasterRecoveryStateStopped
+			Expect(k8sClient.Update(context.TODO(), dr)).NotTo(HaveOccurred())
+			_, err := reconcileDisasterRecovery(dr)
+			Expect(err).NotTo(HaveOccurred())
+			reloadDisasterRecovery()
+		})
+
+		It("should abort the replication", func() {
+			Expect(destinationAdminClient.DisasterRecoveries[source.Status.ConnectionString].State).To(Equal(fdbv1beta2.DisasterRecoveryReplicationStateAborted))
+			Expect(dr.Status.DRDetails.Running).To(BeFalse())
+			Expect(dr.Status.Generations.Reconciled).To(Equal(dr.Generation))
+		})
+	})
+
+	When("the active cluster is switched", func() {
+		JustBeforeEach(func() {
+			dr.Spec.ActiveCluster = fdbv1beta2.DisasterRecoveryClusterRoleDestination
+			Expect(k8sClient.Update(context.TODO(), dr)).NotTo(HaveOccurred())
+			_, err := reconcileDisasterRecovery(dr)
+			Expect(err).NotTo(HaveOccurred())
+			reloadDisasterRecovery()
+		})
+
+		It("should reverse the replication", func() {
+			Expect(destinationAdminClient.DisasterRecoveries).NotTo(HaveKey(source.Status.ConnectionString))
+			Expect(sourceAdminClient.DisasterRecoveries).To(HaveKey(destination.Status.ConnectionString))
+			Expect(dr.Status.ActiveCluster).To(Equal(fdbv1beta2.DisasterRecoveryClusterRoleDestination))
+			Expect(dr.Status.DRDetails.Running).To(BeTrue())
+			Expect(dr.Status.Generations.Reconciled).To(Equal(dr.Generation))
+		})
+
+		It("should update the cluster files of the DR agents", func() {
+			Expect(getConfigMap().Data).To(Equal(map[string]string{
+				internal.SourceClusterFileKey:      destination.Status.ConnectionString,
+				internal.DestinationClusterFileKey: source.Status.ConnectionString,
+			}))
+		})
+	})
+
+	When("the active cluster is switched before the destination is a complete copy", func() {
+		JustBeforeEach(func() {
+			destinationAdminClient.MockDisasterRecoveryStatus(source.Status.ConnectionString, fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+				State: fdbv1beta2.DisasterRecoveryReplicationStateCopying,
+			})
+			dr.Spec.ActiveCluster = fdbv1beta2.DisasterRecoveryClusterRoleDestination
+			Expect(k8sClient.Update(context.TODO(), dr)).NotTo(HaveOccurred())
+			_, err := reconcileDisasterRecovery(dr)
+			Expect(err).NotTo(HaveOccurred())
+			reloadDisasterRecovery()
+		})
+
+		It("should not switch the active cluster", func() {
+			Expect(destinationAdminClient.DisasterRecoveries).To(HaveKey(source.Status.ConnectionString))
+			Expect(dr.Status.ActiveCluster).To(Equal(fdbv1beta2.DisasterRecoveryClusterRoleSource))
+			Expect(dr.Status.Generations.NeedsSwitchover).To(Equal(dr.Generation))
+
+			condition := meta.FindStatusCondition(dr.Status.Conditions, fdbv1beta2.DisasterRecoveryConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("Cannot switch the active cluster until the replication is running and the destination cluster is a complete copy"))
+		})
+	})
+
+	When("the destination cluster is referenced by its connection string", func() {
+		var remoteConnectionString string
+
+		BeforeEach(func() {
+			remoteConnectionString = "remote:remote@127.0.0.100:4501"
+			dr.Spec.DestinationCluster = fdbv1beta2.DisasterRecoveryClusterReference{
+				ConnectionString: remoteConnectionString,
+			}
+		})
+
+		It("should replicate into the remote cluster", func() {
+			remoteAdminClient, err := mock.NewMockAdminClientUncast(&fdbv1beta2.FoundationDBCluster{ObjectMeta: metav1.ObjectMeta{Name: dr.Name + "-destination"}}, k8sClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remoteAdminClient.DisasterRecoveries).To(HaveKey(source.Status.ConnectionString))
+			Expect(getConfigMap().Data).To(Equal(map[string]string{
+				internal.SourceClusterFileKey:      source.Status.ConnectionString,
+				internal.DestinationClusterFileKey: remoteConnectionString,
+			}))
+			Expect(dr.Status.DRDetails.Running).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,575 @@
+/*
+ * dry_run.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/podclient"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// reconcileDryRun runs the sub-reconcilers with clients that don't perform any changes and stores the recorded actions
+// in the plan ConfigMap of the cluster. In contrast to a normal reconciliation all sub-reconcilers will be executed,
+// even if a sub-reconciler requeues, to show as many planned actions as possible.
+func (r *FoundationDBClusterReconciler) reconcileDryRun(ctx context.Context, logger logr.Logger, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, subReconcilers []clusterSubReconciler) (ctrl.Result, error) {
+	logger.Info("Cluster is in dry-run mode, no changes will be performed")
+
+	dryRunCluster := cluster.DeepCopy()
+	recorder := newDryRunRecorder(dryRunCluster)
+	dryRunReconciler := r.newDryRunReconciler(recorder)
+	normalizedSpec := dryRunCluster.Spec.DeepCopy()
+
+	for _, subReconciler := range subReconcilers {
+		dryRunCluster.Spec = *(normalizedSpec.DeepCopy())
+		recorder.setSubReconciler(subReconciler)
+
+		requeue := runClusterSubReconciler(ctx, logger, subReconciler, dryRunReconciler, dryRunCluster, status)
+		if requeue == nil {
+			continue
+		}
+
+		recorder.record(fdbv1beta2.PlannedActionRequeue, "", requeue.getMessage())
+	}
+
+	plan := recorder.getPlan(cluster.ObjectMeta.Generation)
+	err := r.updatePlanConfigMap(ctx, logger, cluster, plan)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	condition := metav1.Condition{
+		Type:               fdbv1beta2.ClusterConditionReconciled,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: cluster.ObjectMeta.Generation,
+		Reason:             fdbv1beta2.ConditionReasonDryRun,
+		Message:            fmt.Sprintf("Dry-run mode is enabled, %d actions are planned in ConfigMap %s", len(plan.Actions), cluster.GetPlanConfigMapName()),
+	}
+
+	if setStatusCondition(&cluster.Status.Conditions, condition) {
+		err = r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// updatePlanConfigMap creates or updates the plan ConfigMap of the cluster. The ConfigMap will only be updated if the
+// planned actions or the generation changed.
+func (r *FoundationDBClusterReconciler) updatePlanConfigMap(ctx context.Context, logger logr.Logger, cluster *fdbv1beta2.FoundationDBCluster, plan *fdbv1beta2.ReconciliationPlan) error {
+	configMap, err := internal.GetPlanConfigMap(cluster, plan)
+	if err != nil {
+		return err
+	}
+
+	existing := &corev1.ConfigMap{}
+	err = r.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: configMap.Name}, existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			logger.Info("Creating plan ConfigMap", "name", configMap.Name, "actions", len(plan.Actions))
+			return r.Create(ctx, configMap)
+		}
+
+		return err
+	}
+
+	existingPlan := &fdbv1beta2.ReconciliationPlan{}
+	err = json.Unmarshal([]byte(existing.Data[fdbv1beta2.PlanConfigMapKey]), existingPlan)
+	if err == nil && existingPlan.Generation == plan.Generation && equality.Semantic.DeepEqual(existingPlan.Actions, plan.Actions) {
+		return nil
+	}
+
+	logger.Info("Updating plan ConfigMap", "name", configMap.Name, "actions", len(plan.Actions))
+	existing.Data = configMap.Data
+	return r.Update(ctx, existing)
+}
+
+// newDryRunReconciler returns a copy of the reconciler that uses clients that only record the actions they would
+// perform.
+func (r *FoundationDBClusterReconciler) newDryRunReconciler(recorder *dryRunRecorder) *FoundationDBClusterReconciler {
+	dryRunReconciler := *r
+	dryRunReconciler.Client = &dryRunClient{Client: r.Client, recorder: recorder}
+	dryRunReconciler.Recorder = dryRunEventRecorder{}
+	dryRunReconciler.DatabaseClientProvider = dryRunDatabaseClientProvider{provider: r.getDatabaseClientProvider(), recorder: recorder}
+	dryRunReconciler.PodClientProvider = func(cluster *fdbv1beta2.FoundationDBCluster, pod *corev1.Pod) (podclient.FdbPodClient, error) {
+		podClient, err := r.PodClientProvider(cluster, pod)
+		if err != nil {
+			return nil, err
+		}
+
+		return dryRunPodClient{FdbPodClient: podClient}, nil
+	}
+
+	return &dryRunReconciler
+}
+
+// dryRunRecorder collects the actions that would be performed during a dry-run reconciliation.
+type dryRunRecorder struct {
+	lock sync.Mutex
+	// subReconciler is the name of the currently running sub-reconciler.
+	subReconciler string
+	// actions contains all the recorded actions.
+	actions []fdbv1beta2.PlannedAction
+	// removals contains the process groups that are already marked for removal.
+	removals map[fdbv1beta2.ProcessGroupID]fdbv1beta2.None
+}
+
+// newDryRunRecorder creates a new dryRunRecorder for the provided cluster.
+func newDryRunRecorder(cluster *fdbv1beta2.FoundationDBCluster) *dryRunRecorder {
+	removals := map[fdbv1beta2.ProcessGroupID]fdbv1beta2.None{}
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.IsMarkedForRemoval() {
+			removals[processGroup.ProcessGroupID] = fdbv1beta2.None{}
+		}
+	}
+
+	return &dryRunRecorder{
+		removals: removals,
+	}
+}
+
+// setSubReconciler sets the sub-reconciler that will be used for the recorded actions.
+func (recorder *dryRunRecorder) setSubReconciler(subReconciler clusterSubReconciler) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.subReconciler = fmt.Sprintf("%T", subReconciler)
+}
+
+// record adds a planned action for the current sub-reconciler.
+func (recorder *dryRunRecorder) record(actionType fdbv1beta2.PlannedActionType, target string, message string) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.actions = append(recorder.actions, fdbv1beta2.PlannedAction{
+		SubReconciler: recorder.subReconciler,
+		Type:          actionType,
+		Target:        target,
+		Message:       message,
+	})
+}
+
+// recordClusterStatus records the process groups that are marked for removal in the provided status and were not
+// marked for removal before.
+func (recorder *dryRunRecorder) recordClusterStatus(status fdbv1beta2.FoundationDBClusterStatus) {
+	for _, processGroup := range status.ProcessGroups {
+		if !processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		recorder.lock.Lock()
+		_, ok := recorder.removals[processGroup.ProcessGroupID]
+		recorder.removals[processGroup.ProcessGroupID] = fdbv1beta2.None{}
+		recorder.lock.Unlock()
+
+		if ok {
+			continue
+		}
+
+		recorder.record(fdbv1beta2.PlannedActionRemoveProcessGroup, string(processGroup.ProcessGroupID), fmt.Sprintf("process class %s", processGroup.ProcessClass))
+	}
+}
+
+// getPlan returns the plan with all recorded actions for the provided generation.
+func (recorder *dryRunRecorder) getPlan(generation int64) *fdbv1beta2.ReconciliationPlan {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	return &fdbv1beta2.ReconciliationPlan{
+		Generation: generation,
+		Timestamp:  &metav1.Time{Time: time.Now()},
+		Actions:    append([]fdbv1beta2.PlannedAction(nil), recorder.actions...),
+	}
+}
+
+// getDryRunTarget returns the kind and the name of the provided object.
+func getDryRunTarget(scheme *runtime.Scheme, obj client.Object) string {
+	kind := fmt.Sprintf("%T", obj)
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err == nil {
+		kind = gvk.Kind
+	}
+
+	return fmt.Sprintf("%s/%s", kind, obj.GetName())
+}
+
+// dryRunClient is a Kubernetes client that passes through all reads and only records all writes.
+type dryRunClient struct {
+	client.Client
+	recorder *dryRunRecorder
+}
+
+// Create records the creation of the object.
+func (dryRun *dryRunClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionCreate, getDryRunTarget(dryRun.Scheme(), obj), "")
+	return nil
+}
+
+// Update records the update of the object.
+func (dryRun *dryRunClient) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionUpdate, getDryRunTarget(dryRun.Scheme(), obj), "")
+	return nil
+}
+
+// Patch records the patch of the object.
+func (dryRun *dryRunClient) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionPatch, getDryRunTarget(dryRun.Scheme(), obj), "")
+	return nil
+}
+
+// Delete records the deletion of the object.
+func (dryRun *dryRunClient) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionDelete, getDryRunTarget(dryRun.Scheme(), obj), "")
+	return nil
+}
+
+// DeleteAllOf records the deletion of all objects of the provided type.
+func (dryRun *dryRunClient) DeleteAllOf(_ context.Context, obj client.Object, _ ...client.DeleteAllOfOption) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionDelete, getDryRunTarget(dryRun.Scheme(), obj), "all matching objects")
+	return nil
+}
+
+// Status returns a status writer that only records the changes.
+func (dryRun *dryRunClient) Status() client.SubResourceWriter {
+	return &dryRunSubResourceClient{client: dryRun, subResource: "status"}
+}
+
+// SubResource returns a sub-resource client that passes through all reads and only records all writes.
+func (dryRun *dryRunClient) SubResource(subResource string) client.SubResourceClient {
+	return &dryRunSubResourceClient{client: dryRun, subResource: subResource}
+}
+
+// dryRunSubResourceClient is a client for sub-resources that passes through all reads and only records all writes.
+// Status changes of the FoundationDBCluster are not recorded directly, only the process groups that would be
+// marked for removal.
+type dryRunSubResourceClient struct {
+	client      *dryRunClient
+	subResource string
+}
+
+// Get reads the sub-resource.
+func (dryRun *dryRunSubResourceClient) Get(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	return dryRun.client.Client.SubResource(dryRun.subResource).Get(ctx, obj, subResource, opts...)
+}
+
+// Create records the creation of the sub-resource.
+func (dryRun *dryRunSubResourceClient) Create(_ context.Context, obj client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	dryRun.recordChange(fdbv1beta2.PlannedActionCreate, obj)
+	return nil
+}
+
+// Update records the update of the sub-resource.
+func (dryRun *dryRunSubResourceClient) Update(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	dryRun.recordChange(fdbv1beta2.PlannedActionUpdate, obj)
+	return nil
+}
+
+// Patch records the patch of the sub-resource.
+func (dryRun *dryRunSubResourceClient) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+	dryRun.recordChange(fdbv1beta2.PlannedActionPatch, obj)
+	return nil
+}
+
+func (dryRun *dryRunSubResourceClient) recordChange(actionType fdbv1beta2.PlannedActionType, obj client.Object) {
+	cluster, ok := obj.(*fdbv1beta2.FoundationDBCluster)
+	if ok && dryRun.subResource == "status" {
+		dryRun.client.recorder.recordClusterStatus(cluster.Status)
+		return
+	}
+
+	dryRun.client.recorder.record(actionType, getDryRunTarget(dryRun.client.Scheme(), obj), dryRun.subResource)
+}
+
+// dryRunEventRecorder drops all events.
+type dryRunEventRecorder struct{}
+
+// Event drops the event.
+func (dryRunEventRecorder) Event(_ runtime.Object, _, _, _ string) {}
+
+// Eventf drops the event.
+func (dryRunEventRecorder) Eventf(_ runtime.Object, _, _, _ string, _ ...interface{}) {}
+
+// AnnotatedEventf drops the event.
+func (dryRunEventRecorder) AnnotatedEventf(_ runtime.Object, _ map[string]string, _, _, _ string, _ ...interface{}) {
+}
+
+var _ record.EventRecorder = dryRunEventRecorder{}
+
+// dryRunDatabaseClientProvider provides admin and lock clients that only record the changes they would perform.
+type dryRunDatabaseClientProvider struct {
+	provider fdbadminclient.DatabaseClientProvider
+	recorder *dryRunRecorder
+}
+
+// GetLockClient generates a lock client that always acquires the lock and only records changes of the deny list.
+func (provider dryRunDatabaseClientProvider) GetLockClient(cluster *fdbv1beta2.FoundationDBCluster) (fdbadminclient.LockClient, error) {
+	lockClient, err := provider.provider.GetLockClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunLockClient{LockClient: lockClient, recorder: provider.recorder}, nil
+}
+
+// GetAdminClient generates an admin client that passes through all reads and only records all changes.
+func (provider dryRunDatabaseClientProvider) GetAdminClient(cluster *fdbv1beta2.FoundationDBCluster, kubernetesClient client.Client) (fdbadminclient.AdminClient, error) {
+	adminClient, err := provider.provider.GetAdminClient(cluster, kubernetesClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunAdminClient{AdminClient: adminClient, recorder: provider.recorder}, nil
+}
+
+// dryRunAdminClient is an admin client that passes through all reads and only records all changes.
+type dryRunAdminClient struct {
+	fdbadminclient.AdminClient
+	recorder *dryRunRecorder
+}
+
+// ConfigureDatabase records the change of the database configuration.
+func (dryRun *dryRunAdminClient) ConfigureDatabase(configuration fdbv1beta2.DatabaseConfiguration, newDatabase bool, version string) error {
+	configurationString, err := configuration.GetConfigurationString(version)
+	if err != nil {
+		return err
+	}
+
+	if newDatabase {
+		configurationString = "new " + configurationString
+	}
+
+	dryRun.recorder.record(fdbv1beta2.PlannedActionConfigureDatabase, "", configurationString)
+	return nil
+}
+
+// ExcludeProcesses records the exclusion of the processes.
+func (dryRun *dryRunAdminClient) ExcludeProcesses(addresses []fdbv1beta2.ProcessAddress) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionExclude, fdbv1beta2.ProcessAddressesString(addresses, " "), "")
+	return nil
+}
+
+// IncludeProcesses records the inclusion of the processes.
+func (dryRun *dryRunAdminClient) IncludeProcesses(addresses []fdbv1beta2.ProcessAddress) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionInclude, fdbv1beta2.ProcessAddressesString(addresses, " "), "")
+	return nil
+}
+
+// KillProcesses records the restart of the processes.
+func (dryRun *dryRunAdminClient) KillProcesses(addresses []fdbv1beta2.ProcessAddress) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionKill, fdbv1beta2.ProcessAddressesString(addresses, " "), "")
+	return nil
+}
+
+// ChangeCoordinators records the change of the coordinators and returns the current connection string.
+func (dryRun *dryRunAdminClient) ChangeCoordinators(addresses []fdbv1beta2.ProcessAddress) (string, error) {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionChangeCoordinators, fdbv1beta2.ProcessAddressesString(addresses, " "), "")
+	return dryRun.AdminClient.GetConnectionString()
+}
+
+// SetMaintenanceZone records the change of the maintenance zone.
+func (dryRun *dryRunAdminClient) SetMaintenanceZone(zone string, timeoutSeconds int) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionMaintenanceMode, zone, fmt.Sprintf("set maintenance zone for %d seconds", timeoutSeconds))
+	return nil
+}
+
+// ResetMaintenanceMode records the reset of the maintenance mode.
+func (dryRun *dryRunAdminClient) ResetMaintenanceMode() error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionMaintenanceMode, "", "reset maintenance mode")
+	return nil
+}
+
+// SetServerKnobs records the change of the knobs in the configuration database.
+func (dryRun *dryRunAdminClient) SetServerKnobs(configClass string, knobs []fdbv1beta2.FoundationDBKnob) error {
+	if len(knobs) == 0 {
+		return nil
+	}
+
+	settings := make([]string, 0, len(knobs))
+	for _, knob := range knobs {
+		settings = append(settings, fmt.Sprintf("%s=%s", knob.Name, knob.Value))
+	}
+
+	dryRun.recorder.record(fdbv1beta2.PlannedActionUpdateKnobs, configClass, "set "+strings.Join(settings, " "))
+	return nil
+}
+
+// ClearServerKnobs records the removal of the knobs from the configuration database.
+func (dryRun *dryRunAdminClient) ClearServerKnobs(configClass string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	dryRun.recorder.record(fdbv1beta2.PlannedActionUpdateKnobs, configClass, "clear "+strings.Join(names, " "))
+	return nil
+}
+
+// CreateTenant records the creation of the tenant.
+func (dryRun *dryRunAdminClient) CreateTenant(name string) error {
+	dryRun.recorder.record(fdbv1beta2.PlannedActionCreateTenant, name, "")
+	return nil
+}
+
+// DeleteTenant records the deletion of the tenant.
+func (dryRun *dryRunAdminClient) DeleteTenant(name string, force bool) error {
+	message := ""
+	if force {
+		message = "clear all keys of the tenant"
+	}
+
+	dryRun.recorder.record(fdbv1beta2.PlannedActionDeleteTenant, name, message)
+	return nil
+}
+
+// StartBackup is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) StartBackup(_ string, _ int) error {
+	return errDryRunNotSupported
+}
+
+// StopBackup is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) StopBackup(_ string) error {
+	return errDryRunNotSupported
+}
+
+// PauseBackups is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) PauseBackups() error {
+	return errDryRunNotSupported
+}
+
+// ResumeBackups is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) ResumeBackups() error {
+	return errDryRunNotSupported
+}
+
+// ModifyBackup is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) ModifyBackup(_ int) error {
+	return errDryRunNotSupported
+}
+
+// ExpireBackup is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) ExpireBackup(_ string, _ fdbv1beta2.BackupRetention) error {
+	return errDryRunNotSupported
+}
+
+// StartRestore is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) StartRestore(_ string, _ []fdbv1beta2.FoundationDBKeyRange, _ fdbadminclient.RestoreOptions) error {
+	return errDryRunNotSupported
+}
+
+// AbortRestore is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) AbortRestore() error {
+	return errDryRunNotSupported
+}
+
+// StartDisasterRecovery is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) StartDisasterRecovery(_ string) error {
+	return errDryRunNotSupported
+}
+
+// PauseDisasterRecovery is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) PauseDisasterRecovery(_ string) error {
+	return errDryRunNotSupported
+}
+
+// ResumeDisasterRecovery is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) ResumeDisasterRecovery(_ string) error {
+	return errDryRunNotSupported
+}
+
+// AbortDisasterRecovery is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) AbortDisasterRecovery(_ string) error {
+	return errDryRunNotSupported
+}
+
+// SwitchDisasterRecovery is not supported in dry-run mode.
+func (dryRun *dryRunAdminClient) SwitchDisasterRecovery(_ string) error {
+	return errDryRunNotSupported
+}
+
+// errDryRunNotSupported is returned for operations that are not used by the cluster reconciler.
+var errDryRunNotSupported = fmt.Errorf("operation is not supported in dry-run mode")
+
+// dryRunLockClient is a lock client that always acquires the lock and only records changes of the deny list.
+type dryRunLockClient struct {
+	fdbadminclient.LockClient
+	recorder *dryRunRecorder
+}
+
+// TakeLock always acquires the lock, as no changes will be performed.
+func (dryRun *dryRunLockClient) TakeLock() (bool, error) {
+	return true, nil
+}
+
+// ReleaseLock does nothing as no lock was taken.
+func (dryRun *dryRunLockClient) ReleaseLock() error {
+	return nil
+}
+
+// AddPendingUpgrades does nothing in dry-run mode.
+func (dryRun *dryRunLockClient) AddPendingUpgrades(_ fdbv1beta2.Version, _ []fdbv1beta2.ProcessGroupID) error {
+	return nil
+}
+
+// ClearPendingUpgrades does nothing in dry-run mode.
+func (dryRun *dryRunLockClient) ClearPendingUpgrades() error {
+	return nil
+}
+
+// UpdateDenyList records the update of the deny list.
+func (dryRun *dryRunLockClient) UpdateDenyList(locks []fdbv1beta2.LockDenyListEntry) error {
+	for _, entry := range locks {
+		message := "deny"
+		if entry.Allow {
+			message = "allow"
+		}
+
+		dryRun.recorder.record(fdbv1beta2.PlannedActionUpdateDenyList, entry.ID, message)
+	}
+
+	return nil
+}
+
+// dryRunPodClient is a pod client that passes through all reads and reports all files as up-to-date without updating
+// them.
+type dryRunPodClient struct {
+	podclient.FdbPodClient
+}
+
+// UpdateFile reports the file as up-to-date without updating it.
+func (dryRun dryRunPodClient) UpdateFile(_ string, _ string) (bool, error) {
+	return true, nil
+}
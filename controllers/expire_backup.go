@@ -0,0 +1,92 @@
+/*
+ * expire_backup.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// expireBackup provides a reconciliation step for removing backup data that
+// is outside the backup's retention.
+type expireBackup struct{}
+
+// reconcile runs the reconciler's work.
+func (s expireBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, logger logr.Logger) *requeue {
+	if backup.Spec.Retention == nil || backup.Status.BackupDetails == nil || !backup.Status.BackupDetails.Running {
+		return nil
+	}
+
+	now := time.Now()
+	if backup.GetTimeUntilNextExpiry(now) > 0 {
+		return nil
+	}
+
+	adminClient, err := r.adminClientForBackup(ctx, backup)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+	defer adminClient.Close()
+
+	backupURL := backup.BackupURL()
+	logger.Info("Expiring backup data", "url", backupURL)
+	err = adminClient.ExpireBackup(backupURL, *backup.Spec.Retention)
+	if err != nil {
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "BackupExpiryFailed", err.Error())
+		return &requeue{curError: err}
+	}
+
+	description, err := adminClient.DescribeBackup(backupURL)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	backup.Status.Expiry = &fdbv1beta2.BackupExpiryStatus{
+		LastExpiryTime:    &metav1.Time{Time: now},
+		MinRestorableTime: getRestorablePointTime(description.MinRestorablePoint),
+		MaxRestorableTime: getRestorablePointTime(description.MaxRestorablePoint),
+	}
+
+	r.Recorder.Event(backup, corev1.EventTypeNormal, "BackupExpired", fmt.Sprintf("Expired backup data of %s", backupURL))
+
+	err = r.updateOrApply(ctx, backup)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
+
+// getRestorablePointTime returns the time of the restorable point or nil if
+// the point is not defined.
+func getRestorablePointTime(point *fdbv1beta2.FoundationDBBackupRestorablePoint) *metav1.Time {
+	if point == nil || point.Epochs == 0 {
+		return nil
+	}
+
+	return &metav1.Time{Time: time.Unix(point.Epochs, 0)}
+}
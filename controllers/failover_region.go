@@ -0,0 +1,254 @@
+/*
+ * failover_region.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbstatus"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// failoverRegion provides a reconciliation step for failing over to another region if the primary data center is
+// unhealthy and for failing back once the original primary data center has recovered. The region priorities will be
+// changed by the updateDatabaseConfiguration sub-reconciler based on the region failover status.
+type failoverRegion struct{}
+
+// reconcile runs the reconciler's work.
+func (failoverRegion) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	if !cluster.UseAutomaticRegionFailover() || cluster.Spec.DatabaseConfiguration.UsableRegions < 2 || !cluster.Status.Configured {
+		return nil
+	}
+
+	// Only the operator instance that is allowed to configure the database should change the region priorities. The
+	// failover is only possible if this operator instance is still running when the primary data center is down, so
+	// the cluster that configures the database should not run in the primary data center.
+	if !pointer.BoolDeref(cluster.Spec.AutomationOptions.ConfigureDatabase, true) {
+		return nil
+	}
+
+	// If the status is not cached, we have to fetch it.
+	if status == nil {
+		adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+		defer adminClient.Close()
+
+		status, err = adminClient.GetStatus()
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+	}
+
+	result := updateRegionFailoverStatus(cluster, status, time.Now())
+	if result.reason != "" {
+		logger.Info("Region failover status changed", "reason", result.reason, "message", result.message)
+		r.Recorder.Event(cluster, result.eventType, result.reason, result.message)
+	}
+
+	if result.changed {
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if result.delay > 0 {
+		return &requeue{message: result.message, delay: result.delay, delayedRequeue: true}
+	}
+
+	return nil
+}
+
+// regionFailoverResult describes the outcome of updating the region failover status.
+type regionFailoverResult struct {
+	// changed is true if the region failover status was changed.
+	changed bool
+
+	// eventType defines the type of the event that should be recorded.
+	eventType string
+
+	// reason defines the reason of the event that should be recorded. If empty no event will be recorded.
+	reason string
+
+	// message provides details about the failover decision.
+	message string
+
+	// delay defines when the region failover status should be checked again. If 0 no requeue is needed.
+	delay time.Duration
+}
+
+// updateRegionFailoverStatus updates the region failover status based on the health of the primary data center and
+// the lag of the remote data center. The active primary in the status will be used by the desired database
+// configuration, so setting or removing it will fail over or fail back.
+func updateRegionFailoverStatus(cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, now time.Time) regionFailoverResult {
+	if cluster.GetActivePrimaryDataCenter() != "" {
+		return updateActiveRegionFailover(cluster, status, now)
+	}
+
+	primary := cluster.Spec.DatabaseConfiguration.GetPrimaryDataCenter()
+	if primary == "" || fdbstatus.GetReportingProcessCountForDataCenter(status, primary) > 0 {
+		if cluster.Status.RegionFailover == nil {
+			return regionFailoverResult{}
+		}
+
+		cluster.Status.RegionFailover = nil
+		return regionFailoverResult{
+			changed:   true,
+			eventType: corev1.EventTypeNormal,
+			reason:    "PrimaryDataCenterHealthy",
+			message:   fmt.Sprintf("Primary data center %s is healthy again", primary),
+		}
+	}
+
+	delay := cluster.GetRegionFailoverDelay()
+	failover := cluster.Status.RegionFailover
+	if failover == nil || failover.PrimaryUnhealthySince == nil {
+		cluster.Status.RegionFailover = &fdbv1beta2.RegionFailoverStatus{
+			PrimaryUnhealthySince: &metav1.Time{Time: now},
+			Message:               fmt.Sprintf("no process of the primary data center %s is reporting", primary),
+		}
+
+		return regionFailoverResult{
+			changed:   true,
+			eventType: corev1.EventTypeWarning,
+			reason:    "PrimaryDataCenterUnhealthy",
+			message:   fmt.Sprintf("Primary data center %s is unhealthy, failing over if it doesn't recover within %s", primary, delay),
+			delay:     delay,
+		}
+	}
+
+	unhealthyDuration := now.Sub(failover.PrimaryUnhealthySince.Time)
+	if unhealthyDuration < delay {
+		return regionFailoverResult{
+			message: fmt.Sprintf("primary data center %s is unhealthy since %s, waiting until it is unhealthy for %s", primary, unhealthyDuration.Round(time.Second), delay),
+			delay:   delay - unhealthyDuration,
+		}
+	}
+
+	target := cluster.Spec.DatabaseConfiguration.GetFailoverDataCenter()
+	lag := getDataCenterLag(status)
+	var blockedReason string
+	if target == "" {
+		blockedReason = "no data center with a non-negative priority is available"
+	} else if fdbstatus.GetReportingProcessCountForDataCenter(status, target) == 0 {
+		blockedReason = fmt.Sprintf("no process of the data center %s is reporting", target)
+	} else if lag > cluster.GetMaximumDataCenterLag() {
+		blockedReason = fmt.Sprintf("the data center lag is %s, the maximum allowed lag is %s", lag, cluster.GetMaximumDataCenterLag())
+	}
+
+	if blockedReason != "" {
+		message := fmt.Sprintf("cannot fail over from %s: %s", primary, blockedReason)
+		if failover.Message == message {
+			return regionFailoverResult{message: message, delay: delay}
+		}
+
+		failover.Message = message
+		return regionFailoverResult{
+			changed:   true,
+			eventType: corev1.EventTypeWarning,
+			reason:    "RegionFailoverBlocked",
+			message:   message,
+			delay:     delay,
+		}
+	}
+
+	failover.OriginalPrimary = primary
+	failover.ActivePrimary = target
+	failover.FailoverTime = &metav1.Time{Time: now}
+	failover.Message = fmt.Sprintf("failed over from %s to %s after the primary data center was unhealthy for %s", primary, target, unhealthyDuration.Round(time.Second))
+
+	return regionFailoverResult{
+		changed:   true,
+		eventType: corev1.EventTypeWarning,
+		reason:    "RegionFailover",
+		message:   fmt.Sprintf("Failing over from %s to %s, the primary data center was unhealthy for %s and the data center lag is %s", primary, target, unhealthyDuration.Round(time.Second), lag),
+	}
+}
+
+// updateActiveRegionFailover updates the region failover status after the operator failed over. The failover will be
+// removed from the status if the spec uses the active primary as primary data center or if the operator fails back to
+// the original primary data center.
+func updateActiveRegionFailover(cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, now time.Time) regionFailoverResult {
+	failover := cluster.Status.RegionFailover
+	if cluster.Spec.DatabaseConfiguration.GetPrimaryDataCenter() == failover.ActivePrimary {
+		cluster.Status.RegionFailover = nil
+		return regionFailoverResult{
+			changed:   true,
+			eventType: corev1.EventTypeNormal,
+			reason:    "RegionFailoverAdopted",
+			message:   fmt.Sprintf("The spec uses the active primary %s as primary data center", failover.ActivePrimary),
+		}
+	}
+
+	if !cluster.UseAutomaticRegionFailback() {
+		return regionFailoverResult{}
+	}
+
+	// After the failover the data center lag reports how far the original primary data center is behind.
+	lag := getDataCenterLag(status)
+	if fdbstatus.GetReportingProcessCountForDataCenter(status, failover.OriginalPrimary) == 0 || lag > cluster.GetMaximumDataCenterLag() {
+		if failover.OriginalPrimaryHealthySince == nil {
+			return regionFailoverResult{}
+		}
+
+		failover.OriginalPrimaryHealthySince = nil
+		return regionFailoverResult{changed: true}
+	}
+
+	delay := cluster.GetRegionFailoverDelay()
+	if failover.OriginalPrimaryHealthySince == nil {
+		failover.OriginalPrimaryHealthySince = &metav1.Time{Time: now}
+		return regionFailoverResult{
+			changed: true,
+			message: fmt.Sprintf("original primary data center %s is healthy and has caught up, failing back in %s", failover.OriginalPrimary, delay),
+			delay:   delay,
+		}
+	}
+
+	healthyDuration := now.Sub(failover.OriginalPrimaryHealthySince.Time)
+	if healthyDuration < delay {
+		return regionFailoverResult{
+			message: fmt.Sprintf("original primary data center %s is healthy since %s, waiting until it is healthy for %s", failover.OriginalPrimary, healthyDuration.Round(time.Second), delay),
+			delay:   delay - healthyDuration,
+		}
+	}
+
+	cluster.Status.RegionFailover = nil
+	return regionFailoverResult{
+		changed:   true,
+		eventType: corev1.EventTypeNormal,
+		reason:    "RegionFailback",
+		message:   fmt.Sprintf("Failing back from %s to %s, the original primary data center was healthy for %s and the data center lag is %s", failover.ActivePrimary, failover.OriginalPrimary, healthyDuration.Round(time.Second), lag),
+	}
+}
+
+// getDataCenterLag returns the lag of the remote data center from the machine-readable status.
+func getDataCenterLag(status *fdbv1beta2.FoundationDBStatus) time.Duration {
+	return time.Duration(status.Cluster.DataCenterLag.Seconds * float64(time.Second))
+}
@@ -0,0 +1,333 @@
+/*
+ * failover_region_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("failover_region", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var status *fdbv1beta2.FoundationDBStatus
+	var now time.Time
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.AutomationOptions.RegionFailoverOptions.Enabled = pointer.Bool(true)
+		cluster.Spec.DatabaseConfiguration.UsableRegions = 2
+		cluster.Spec.DatabaseConfiguration.Regions = []fdbv1beta2.Region{
+			{
+				DataCenters: []fdbv1beta2.DataCenter{
+					{ID: "primary", Priority: 1},
+				},
+			},
+			{
+				DataCenters: []fdbv1beta2.DataCenter{
+					{ID: "remote", Priority: 0},
+				},
+			},
+		}
+
+		status = &fdbv1beta2.FoundationDBStatus{
+			Cluster: fdbv1beta2.FoundationDBStatusClusterInfo{
+				Processes: map[fdbv1beta2.ProcessGroupID]fdbv1beta2.FoundationDBStatusProcessInfo{
+					"primary-1": {Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "primary"}},
+					"remote-1":  {Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "remote"}},
+				},
+				DataCenterLag: fdbv1beta2.FoundationDBStatusLagInfo{Seconds: 1},
+			},
+		}
+		now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	})
+
+	When("the primary data center is down", func() {
+		var req *requeue
+
+		BeforeEach(func() {
+			// The cluster runs in the remote data center, so the operator instance is still running if the primary
+			// data center is down.
+			cluster.Spec.DataCenter = "remote"
+			delete(status.Cluster.Processes, "primary-1")
+		})
+
+		JustBeforeEach(func() {
+			Expect(k8sClient.Create(context.TODO(), cluster)).NotTo(HaveOccurred())
+			cluster.Status.Configured = true
+			cluster.Status.RegionFailover = &fdbv1beta2.RegionFailoverStatus{
+				PrimaryUnhealthySince: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+			}
+			Expect(k8sClient.Status().Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+
+			req = failoverRegion{}.reconcile(context.TODO(), clusterReconciler, cluster, status, globalControllerLogger)
+			Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(cluster), cluster)).NotTo(HaveOccurred())
+		})
+
+		It("should fail over to the remote data center", func() {
+			Expect(req).To(BeNil())
+			Expect(cluster.Status.RegionFailover).NotTo(BeNil())
+			Expect(cluster.Status.RegionFailover.OriginalPrimary).To(Equal("primary"))
+			Expect(cluster.Status.RegionFailover.ActivePrimary).To(Equal("remote"))
+			Expect(cluster.DesiredDatabaseConfiguration().GetPrimaryDataCenter()).To(Equal("remote"))
+		})
+
+		When("the operator instance is not allowed to configure the database", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.ConfigureDatabase = pointer.Bool(false)
+			})
+
+			It("should not fail over", func() {
+				Expect(req).To(BeNil())
+				Expect(cluster.Status.RegionFailover).NotTo(BeNil())
+				Expect(cluster.Status.RegionFailover.ActivePrimary).To(BeEmpty())
+			})
+		})
+	})
+
+	When("updating the region failover status", func() {
+		var result regionFailoverResult
+
+		JustBeforeEach(func() {
+			result = updateRegionFailoverStatus(cluster, status, now)
+		})
+
+		When("the primary data center is healthy", func() {
+			It("should not change the status", func() {
+				Expect(result).To(Equal(regionFailoverResult{}))
+				Expect(cluster.Status.RegionFailover).To(BeNil())
+			})
+
+			When("the primary data center was tracked as unhealthy", func() {
+				BeforeEach(func() {
+					cluster.Status.RegionFailover = &fdbv1beta2.RegionFailoverStatus{
+						PrimaryUnhealthySince: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+					}
+				})
+
+				It("should remove the failover from the status", func() {
+					Expect(result.changed).To(BeTrue())
+					Expect(result.reason).To(Equal("PrimaryDataCenterHealthy"))
+					Expect(cluster.Status.RegionFailover).To(BeNil())
+				})
+			})
+		})
+
+		When("the primary data center is unhealthy", func() {
+			BeforeEach(func() {
+				delete(status.Cluster.Processes, "primary-1")
+			})
+
+			It("should track the primary data center as unhealthy", func() {
+				Expect(result.changed).To(BeTrue())
+				Expect(result.reason).To(Equal("PrimaryDataCenterUnhealthy"))
+				Expect(result.delay).To(Equal(5 * time.Minute))
+				Expect(cluster.Status.RegionFailover).NotTo(BeNil())
+				Expect(cluster.Status.RegionFailover.PrimaryUnhealthySince.Time).To(Equal(now))
+				Expect(cluster.Status.RegionFailover.ActivePrimary).To(BeEmpty())
+			})
+
+			When("the failover delay has not passed", func() {
+				BeforeEach(func() {
+					cluster.Status.RegionFailover = &fdbv1beta2.RegionFailoverStatus{
+						PrimaryUnhealthySince: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+					}
+				})
+
+				It("should wait for the remaining delay", func() {
+					Expect(result.changed).To(BeFalse())
+					Expect(result.reason).To(BeEmpty())
+					Expect(result.delay).To(Equal(4 * time.Minute))
+					Expect(cluster.Status.RegionFailover.ActivePrimary).To(BeEmpty())
+				})
+			})
+
+			When("the failover delay has passed", func() {
+				BeforeEach(func() {
+					cluster.Status.RegionFailover = &fdbv1beta2.RegionFailoverStatus{
+						PrimaryUnhealthySince: &metav1.Time{Time: now.Add(-10 * time.Minute)},
+					}
+				})
+
+				It("should fail over to the remote data center", func() {
+					Expect(result.changed).To(BeTrue())
+					Expect(result.reason).To(Equal("RegionFailover"))
+					Expect(result.delay).To(BeZero())
+					Expect(cluster.Status.RegionFailover.OriginalPrimary).To(Equal("primary"))
+					Expect(cluster.Status.RegionFailover.ActivePrimary).To(Equal("remote"))
+					Expect(cluster.Status.RegionFailover.FailoverTime.Time).To(Equal(now))
+					Expect(cluster.DesiredDatabaseConfiguration().GetPrimaryDataCenter()).To(Equal("remote"))
+				})
+
+				When("the data center lag is too high", func() {
+					BeforeEach(func() {
+						status.Cluster.DataCenterLag.Seconds = 60
+					})
+
+					It("should block the failover", func() {
+						Expect(result.changed).To(BeTrue())
+						Expect(result.reason).To(Equal("RegionFailoverBlocked"))
+						Expect(result.delay).To(Equal(5 * time.Minute))
+						Expect(cluster.Status.RegionFailover.ActivePrimary).To(BeEmpty())
+						Expect(cluster.Status.RegionFailover.Message).To(Equal("cannot fail over from primary: the data center lag is 1m0s, the maximum allowed lag is 10s"))
+					})
+
+					When("the failover was already blocked", func() {
+						BeforeEach(func() {
+							cluster.Status.RegionFailover.Message = "cannot fail over from primary: the data center lag is 1m0s, the maximum allowed lag is 10s"
+						})
+
+						It("should not record another event", func() {
+							Expect(result.changed).To(BeFalse())
+							Expect(result.reason).To(BeEmpty())
+							Expect(result.delay).To(Equal(5 * time.Minute))
+						})
+					})
+				})
+
+				When("the remote data center is not reporting", func() {
+					BeforeEach(func() {
+						delete(status.Cluster.Processes, "remote-1")
+					})
+
+					It("should block the failover", func() {
+						Expect(result.reason).To(Equal("RegionFailoverBlocked"))
+						Expect(cluster.Status.RegionFailover.ActivePrimary).To(BeEmpty())
+						Expect(cluster.Status.RegionFailover.Message).To(Equal("cannot fail over from primary: no process of the data center remote is reporting"))
+					})
+				})
+
+				When("the remote data center has a negative priority", func() {
+					BeforeEach(func() {
+						cluster.Spec.DatabaseConfiguration.Regions[1].DataCenters[0].Priority = -1
+					})
+
+					It("should block the failover", func() {
+						Expect(result.reason).To(Equal("RegionFailoverBlocked"))
+						Expect(cluster.Status.RegionFailover.ActivePrimary).To(BeEmpty())
+						Expect(cluster.Status.RegionFailover.Message).To(Equal("cannot fail over from primary: no data center with a non-negative priority is available"))
+					})
+				})
+			})
+		})
+
+		When("the operator failed over", func() {
+			BeforeEach(func() {
+				cluster.Status.RegionFailover = &fdbv1beta2.RegionFailoverStatus{
+					PrimaryUnhealthySince: &metav1.Time{Time: now.Add(-1 * time.Hour)},
+					OriginalPrimary:       "primary",
+					ActivePrimary:         "remote",
+					FailoverTime:          &metav1.Time{Time: now.Add(-55 * time.Minute)},
+				}
+			})
+
+			When("failback is disabled", func() {
+				It("should not change the status", func() {
+					Expect(result).To(Equal(regionFailoverResult{}))
+					Expect(cluster.Status.RegionFailover.ActivePrimary).To(Equal("remote"))
+				})
+			})
+
+			When("the spec uses the active primary as primary data center", func() {
+				BeforeEach(func() {
+					cluster.Spec.DatabaseConfiguration.Regions[0].DataCenters[0].Priority = 0
+					cluster.Spec.DatabaseConfiguration.Regions[1].DataCenters[0].Priority = 1
+				})
+
+				It("should remove the failover from the status", func() {
+					Expect(result.changed).To(BeTrue())
+					Expect(result.reason).To(Equal("RegionFailoverAdopted"))
+					Expect(cluster.Status.RegionFailover).To(BeNil())
+				})
+			})
+
+			When("failback is enabled", func() {
+				BeforeEach(func() {
+					cluster.Spec.AutomationOptions.RegionFailoverOptions.Failback = pointer.Bool(true)
+				})
+
+				It("should track the original primary data center as healthy", func() {
+					Expect(result.changed).To(BeTrue())
+					Expect(result.reason).To(BeEmpty())
+					Expect(result.delay).To(Equal(5 * time.Minute))
+					Expect(cluster.Status.RegionFailover.OriginalPrimaryHealthySince.Time).To(Equal(now))
+				})
+
+				When("the original primary data center is not reporting", func() {
+					BeforeEach(func() {
+						delete(status.Cluster.Processes, "primary-1")
+						cluster.Status.RegionFailover.OriginalPrimaryHealthySince = &metav1.Time{Time: now.Add(-1 * time.Minute)}
+					})
+
+					It("should reset the healthy timestamp", func() {
+						Expect(result.changed).To(BeTrue())
+						Expect(result.delay).To(BeZero())
+						Expect(cluster.Status.RegionFailover.OriginalPrimaryHealthySince).To(BeNil())
+						Expect(cluster.Status.RegionFailover.ActivePrimary).To(Equal("remote"))
+					})
+				})
+
+				When("the original primary data center has not caught up", func() {
+					BeforeEach(func() {
+						status.Cluster.DataCenterLag.Seconds = 60
+					})
+
+					It("should not change the status", func() {
+						Expect(result).To(Equal(regionFailoverResult{}))
+						Expect(cluster.Status.RegionFailover.ActivePrimary).To(Equal("remote"))
+					})
+				})
+
+				When("the failback delay has not passed", func() {
+					BeforeEach(func() {
+						cluster.Status.RegionFailover.OriginalPrimaryHealthySince = &metav1.Time{Time: now.Add(-2 * time.Minute)}
+					})
+
+					It("should wait for the remaining delay", func() {
+						Expect(result.changed).To(BeFalse())
+						Expect(result.delay).To(Equal(3 * time.Minute))
+						Expect(cluster.Status.RegionFailover.ActivePrimary).To(Equal("remote"))
+					})
+				})
+
+				When("the failback delay has passed", func() {
+					BeforeEach(func() {
+						cluster.Status.RegionFailover.OriginalPrimaryHealthySince = &metav1.Time{Time: now.Add(-6 * time.Minute)}
+					})
+
+					It("should fail back to the original primary data center", func() {
+						Expect(result.changed).To(BeTrue())
+						Expect(result.reason).To(Equal("RegionFailback"))
+						Expect(cluster.Status.RegionFailover).To(BeNil())
+						Expect(cluster.DesiredDatabaseConfiguration().GetPrimaryDataCenter()).To(Equal("primary"))
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/*
+ * maintenance_window.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// checkMaintenanceWindow checks if the provided sub-reconciler is allowed to run at the provided time based on the
+// maintenance windows of the cluster. If the sub-reconciler is not allowed to run and has pending work, a delayed
+// requeue will be returned to defer the work to the next maintenance window. The delay of the requeue is the time
+// until the next maintenance window starts, if such a time could be found.
+func checkMaintenanceWindow(logger logr.Logger, cluster *fdbv1beta2.FoundationDBCluster, name fdbv1beta2.SubReconcilerName, now time.Time) (bool, *requeue) {
+	if !fdbv1beta2.IsDisruptiveSubReconciler(name) || !cluster.HasMaintenanceWindows() {
+		return true, nil
+	}
+
+	allowed, nextWindowStart, err := cluster.IsDisruptionAllowed(now)
+	if err != nil {
+		return false, &requeue{curError: err, delayedRequeue: true, deferred: true}
+	}
+
+	if allowed {
+		return true, nil
+	}
+
+	if cluster.AllowFailedReplacementsOutsideMaintenanceWindow() && isFailedReplacementSubReconciler(name) && onlyFailedProcessGroupsMarkedForRemoval(cluster) {
+		logger.Info("Allowing sub-reconciler outside of the maintenance window to replace failed process groups")
+		return true, nil
+	}
+
+	// If the sub-reconciler has nothing to do, there is no reason to block the reconciliation.
+	if !hasPendingDisruptiveWork(cluster, name) {
+		logger.V(1).Info("Skipping disruptive sub-reconciler outside of the maintenance window without pending work")
+		return false, nil
+	}
+
+	var delay time.Duration
+	message := fmt.Sprintf("%s is deferred until the next maintenance window", name)
+	if nextWindowStart != nil {
+		message += fmt.Sprintf(" starting at %s", nextWindowStart.Format(time.RFC3339))
+		delay = nextWindowStart.Sub(now)
+	}
+
+	logger.Info("Deferring disruptive sub-reconciler outside of the maintenance window", "nextWindowStart", nextWindowStart)
+
+	return false, &requeue{message: message, delay: delay, delayedRequeue: true, deferred: true}
+}
+
+// hasPendingDisruptiveWork returns true if the cluster status indicates that the provided disruptive sub-reconciler
+// has pending work.
+func hasPendingDisruptiveWork(cluster *fdbv1beta2.FoundationDBCluster, name fdbv1beta2.SubReconcilerName) bool {
+	var conditions []fdbv1beta2.ProcessGroupConditionType
+	switch name {
+	case fdbv1beta2.SubReconcilerExcludeProcesses, fdbv1beta2.SubReconcilerRemoveProcessGroups:
+		for _, processGroup := range cluster.Status.ProcessGroups {
+			if processGroup.IsMarkedForRemoval() {
+				return true
+			}
+		}
+
+		return false
+	case fdbv1beta2.SubReconcilerRemoveIncompatibleProcesses:
+		return cluster.IsBeingUpgraded()
+	case fdbv1beta2.SubReconcilerBounceProcesses:
+		if cluster.IsBeingUpgraded() {
+			return true
+		}
+
+		conditions = []fdbv1beta2.ProcessGroupConditionType{fdbv1beta2.IncorrectCommandLine, fdbv1beta2.IncorrectConfigMap}
+	case fdbv1beta2.SubReconcilerUpdatePods:
+		conditions = []fdbv1beta2.ProcessGroupConditionType{fdbv1beta2.IncorrectPodSpec}
+	}
+
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		for _, condition := range conditions {
+			if processGroup.GetConditionTime(condition) != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isFailedReplacementSubReconciler returns true if the sub-reconciler is required to finish the replacement of failed
+// process groups.
+func isFailedReplacementSubReconciler(name fdbv1beta2.SubReconcilerName) bool {
+	return name == fdbv1beta2.SubReconcilerExcludeProcesses || name == fdbv1beta2.SubReconcilerRemoveProcessGroups
+}
+
+// onlyFailedProcessGroupsMarkedForRemoval returns true if at least one process group is marked for removal and all
+// process groups that are marked for removal have failed.
+func onlyFailedProcessGroupsMarkedForRemoval(cluster *fdbv1beta2.FoundationDBCluster) bool {
+	hasRemovals := false
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if !processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		if !processGroup.HasFailureCondition() {
+			return false
+		}
+
+		hasRemovals = true
+	}
+
+	return hasRemovals
+}
+
+// updateMaintenanceWindowStatus updates the information about the maintenance windows and the deferred sub-reconcilers
+// in the cluster status. This method returns true if the status was changed.
+func updateMaintenanceWindowStatus(cluster *fdbv1beta2.FoundationDBCluster, deferred []fdbv1beta2.SubReconcilerName, now time.Time) bool {
+	if !cluster.HasMaintenanceWindows() {
+		if cluster.Status.MaintenanceWindow == nil {
+			return false
+		}
+
+		cluster.Status.MaintenanceWindow = nil
+		return true
+	}
+
+	allowed, nextWindowStart, err := cluster.IsDisruptionAllowed(now)
+	if err != nil {
+		// The error will be reported by the sub-reconcilers that are deferred.
+		allowed = false
+	}
+
+	maintenanceWindow := &fdbv1beta2.MaintenanceWindowStatus{
+		DisruptionAllowed:      allowed,
+		DeferredSubReconcilers: deferred,
+	}
+
+	if nextWindowStart != nil {
+		maintenanceWindow.NextWindowStart = &metav1.Time{Time: *nextWindowStart}
+	}
+
+	if equality.Semantic.DeepEqual(cluster.Status.MaintenanceWindow, maintenanceWindow) {
+		return false
+	}
+
+	cluster.Status.MaintenanceWindow = maintenanceWindow
+	return true
+}
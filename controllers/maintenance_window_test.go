@@ -0,0 +1,228 @@
+/*
+ * maintenance_window_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("maintenance_window", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var now time.Time
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Status.ProcessGroups = []*fdbv1beta2.ProcessGroupStatus{
+			fdbv1beta2.NewProcessGroupStatus("storage-1", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.1"}),
+			fdbv1beta2.NewProcessGroupStatus("storage-2", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.2"}),
+		}
+		// Remove the initial conditions to represent healthy process groups.
+		for _, processGroup := range cluster.Status.ProcessGroups {
+			processGroup.ProcessGroupConditions = nil
+		}
+		now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	})
+
+	When("checking if a sub-reconciler must be deferred", func() {
+		var allowed bool
+		var result *requeue
+		var name fdbv1beta2.SubReconcilerName
+
+		BeforeEach(func() {
+			name = fdbv1beta2.SubReconcilerBounceProcesses
+		})
+
+		JustBeforeEach(func() {
+			allowed, result = checkMaintenanceWindow(globalControllerLogger, cluster, name, now)
+		})
+
+		When("no maintenance windows are defined", func() {
+			It("should allow the sub-reconciler", func() {
+				Expect(allowed).To(BeTrue())
+				Expect(result).To(BeNil())
+			})
+		})
+
+		When("the cluster is inside the maintenance window", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.MaintenanceWindowOptions.Windows = []fdbv1beta2.MaintenanceWindow{
+					{Schedule: "0 11 * * *", DurationSeconds: 7200},
+				}
+			})
+
+			It("should allow the sub-reconciler", func() {
+				Expect(allowed).To(BeTrue())
+				Expect(result).To(BeNil())
+			})
+		})
+
+		When("the cluster is outside the maintenance window", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.MaintenanceWindowOptions.Windows = []fdbv1beta2.MaintenanceWindow{
+					{Schedule: "0 2 * * *", DurationSeconds: 7200},
+				}
+				cluster.Status.ProcessGroups[1].UpdateCondition(fdbv1beta2.IncorrectCommandLine, true)
+			})
+
+			It("should defer the sub-reconciler", func() {
+				Expect(allowed).To(BeFalse())
+				Expect(result).NotTo(BeNil())
+				Expect(result.deferred).To(BeTrue())
+				Expect(result.delayedRequeue).To(BeTrue())
+				Expect(result.message).To(Equal("BounceProcesses is deferred until the next maintenance window starting at 2023-10-03T02:00:00Z"))
+				Expect(result.delay).To(Equal(14 * time.Hour))
+			})
+
+			When("the sub-reconciler is not disruptive", func() {
+				BeforeEach(func() {
+					name = fdbv1beta2.SubReconcilerAddPods
+				})
+
+				It("should allow the sub-reconciler", func() {
+					Expect(allowed).To(BeTrue())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			When("the sub-reconciler has no pending work", func() {
+				BeforeEach(func() {
+					name = fdbv1beta2.SubReconcilerUpdatePods
+				})
+
+				It("should skip the sub-reconciler without a requeue", func() {
+					Expect(allowed).To(BeFalse())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			When("failed process groups are marked for removal", func() {
+				BeforeEach(func() {
+					name = fdbv1beta2.SubReconcilerRemoveProcessGroups
+					processGroup := cluster.Status.ProcessGroups[0]
+					processGroup.MarkForRemoval()
+					processGroup.UpdateCondition(fdbv1beta2.MissingProcesses, true)
+				})
+
+				It("should defer the sub-reconciler", func() {
+					Expect(result).NotTo(BeNil())
+					Expect(result.deferred).To(BeTrue())
+				})
+
+				When("failed replacements are allowed outside the maintenance window", func() {
+					BeforeEach(func() {
+						cluster.Spec.AutomationOptions.MaintenanceWindowOptions.AllowFailedReplacementsOutsideWindow = pointer.Bool(true)
+					})
+
+					It("should allow the sub-reconciler", func() {
+						Expect(allowed).To(BeTrue())
+						Expect(result).To(BeNil())
+					})
+
+					When("the sub-reconciler bounces processes", func() {
+						BeforeEach(func() {
+							name = fdbv1beta2.SubReconcilerBounceProcesses
+						})
+
+						It("should defer the sub-reconciler", func() {
+							Expect(result).NotTo(BeNil())
+							Expect(result.deferred).To(BeTrue())
+						})
+					})
+
+					When("a healthy process group is marked for removal too", func() {
+						BeforeEach(func() {
+							cluster.Status.ProcessGroups[1].MarkForRemoval()
+						})
+
+						It("should defer the sub-reconciler", func() {
+							Expect(result).NotTo(BeNil())
+							Expect(result.deferred).To(BeTrue())
+						})
+					})
+				})
+			})
+		})
+	})
+
+	When("updating the maintenance window status", func() {
+		var changed bool
+		var deferred []fdbv1beta2.SubReconcilerName
+
+		BeforeEach(func() {
+			deferred = []fdbv1beta2.SubReconcilerName{fdbv1beta2.SubReconcilerBounceProcesses}
+		})
+
+		JustBeforeEach(func() {
+			changed = updateMaintenanceWindowStatus(cluster, deferred, now)
+		})
+
+		When("no maintenance windows are defined", func() {
+			It("should not change the status", func() {
+				Expect(changed).To(BeFalse())
+				Expect(cluster.Status.MaintenanceWindow).To(BeNil())
+			})
+
+			When("the status contains maintenance window information", func() {
+				BeforeEach(func() {
+					cluster.Status.MaintenanceWindow = &fdbv1beta2.MaintenanceWindowStatus{}
+				})
+
+				It("should remove the information", func() {
+					Expect(changed).To(BeTrue())
+					Expect(cluster.Status.MaintenanceWindow).To(BeNil())
+				})
+			})
+		})
+
+		When("the cluster is outside the maintenance window", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.MaintenanceWindowOptions.Windows = []fdbv1beta2.MaintenanceWindow{
+					{Schedule: "0 2 * * *", DurationSeconds: 7200},
+				}
+			})
+
+			It("should report the deferred sub-reconcilers", func() {
+				Expect(changed).To(BeTrue())
+				Expect(cluster.Status.MaintenanceWindow).NotTo(BeNil())
+				Expect(cluster.Status.MaintenanceWindow.DisruptionAllowed).To(BeFalse())
+				Expect(cluster.Status.MaintenanceWindow.NextWindowStart).NotTo(BeNil())
+				Expect(cluster.Status.MaintenanceWindow.NextWindowStart.Time).To(BeTemporally("==", time.Date(2023, 10, 3, 2, 0, 0, 0, time.UTC)))
+				Expect(cluster.Status.MaintenanceWindow.DeferredSubReconcilers).To(ConsistOf(fdbv1beta2.SubReconcilerBounceProcesses))
+			})
+
+			When("the status is already up to date", func() {
+				BeforeEach(func() {
+					Expect(updateMaintenanceWindowStatus(cluster, deferred, now)).To(BeTrue())
+				})
+
+				It("should not change the status", func() {
+					Expect(changed).To(BeFalse())
+				})
+			})
+		})
+	})
+})
@@ -100,6 +100,13 @@ var (
 		append(descClusterDefaultLabels, "process_class"),
 		nil,
 	)
+
+	descSuspendedSubReconcilers = prometheus.NewDesc(
+		"fdb_operator_suspended_sub_reconciler",
+		"the sub-reconcilers that are suspended for the Fdb Cluster.",
+		append(descClusterDefaultLabels, "sub_reconciler"),
+		nil,
+	)
 )
 
 type fdbClusterCollector struct {
@@ -147,6 +154,10 @@ func collectMetrics(ch chan<- prometheus.Metric, cluster *fdbv1beta2.FoundationD
 	addGauge(descProcessGroupsToRemove, float64(len(cluster.Spec.ProcessGroupsToRemove)))
 	addGauge(descProcessGroupsToRemoveWithoutExclusion, float64(len(cluster.Spec.ProcessGroupsToRemoveWithoutExclusion)))
 
+	for _, subReconciler := range cluster.Status.SuspendedSubReconcilers {
+		addGauge(descSuspendedSubReconcilers, 1, string(subReconciler))
+	}
+
 	// Calculate the process group metrics
 	conditionMap, removals, exclusions := getProcessGroupMetrics(cluster)
 
@@ -0,0 +1,264 @@
+/*
+ * migrate_storage_engine.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// storageEngineMigrationDelay defines how long the operator waits before checking the progress of a storage engine
+// migration again.
+const storageEngineMigrationDelay = 30 * time.Second
+
+// migrateStorageEngine provides a reconciliation step for replacing storage process groups that use a different storage
+// engine than the configured storage engine. The process groups are replaced in batches, one fault domain at a time,
+// and the next batch is only started once data distribution has settled.
+type migrateStorageEngine struct{}
+
+// reconcile runs the reconciler's work.
+func (migrateStorageEngine) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	if !cluster.UseStorageEngineMigration() {
+		if cluster.Status.StorageEngineMigration == nil {
+			return nil
+		}
+
+		cluster.Status.StorageEngineMigration = nil
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		return nil
+	}
+
+	if !cluster.Status.Configured {
+		return nil
+	}
+
+	// If the status is not cached, we have to fetch it.
+	if status == nil {
+		adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+		defer adminClient.Close()
+
+		status, err = adminClient.GetStatus()
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+	}
+
+	result := updateStorageEngineMigration(cluster, status, time.Now())
+	if result.reason != "" {
+		logger.Info("Storage engine migration progressed", "reason", result.reason, "message", result.message)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, result.reason, result.message)
+	}
+
+	if result.changed {
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if result.wait {
+		return &requeue{message: result.message, delay: storageEngineMigrationDelay, delayedRequeue: true}
+	}
+
+	return nil
+}
+
+// storageEngineMigrationResult describes the outcome of updating the storage engine migration.
+type storageEngineMigrationResult struct {
+	// changed is true if the cluster status was changed.
+	changed bool
+
+	// reason defines the reason of the event that should be recorded. If empty no event will be recorded.
+	reason string
+
+	// message provides details about the current state of the migration.
+	message string
+
+	// wait is true if the migration is not yet completed and must be checked again.
+	wait bool
+}
+
+// updateStorageEngineMigration updates the storage engine migration status and marks the next batch of storage process
+// groups for removal once the previous batch is removed and data distribution has settled. All storage process groups
+// of a batch share the same fault domain.
+func updateStorageEngineMigration(cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, now time.Time) storageEngineMigrationResult {
+	original := cluster.Status.StorageEngineMigration.DeepCopy()
+	migration := cluster.Status.StorageEngineMigration
+	if migration == nil {
+		migration = &fdbv1beta2.StorageEngineMigrationStatus{}
+	}
+
+	targetEngine := cluster.DesiredDatabaseConfiguration().StorageEngine
+	if migration.StorageEngine != targetEngine {
+		migration = &fdbv1beta2.StorageEngineMigrationStatus{StorageEngine: targetEngine}
+	}
+
+	engines := getStorageEnginesByProcessGroup(status)
+	migration.StorageServers = map[fdbv1beta2.StorageEngine]int{}
+	for _, engine := range engines {
+		migration.StorageServers[engine]++
+	}
+
+	cluster.Status.StorageEngineMigration = migration
+	result := migrateNextStorageEngineBatch(cluster, migration, status, engines, now)
+	migration.Message = result.message
+	result.changed = !equality.Semantic.DeepEqual(original, cluster.Status.StorageEngineMigration)
+
+	return result
+}
+
+// migrateNextStorageEngineBatch marks the next batch of storage process groups for removal if the previous batch was
+// removed and data distribution has settled.
+func migrateNextStorageEngineBatch(cluster *fdbv1beta2.FoundationDBCluster, migration *fdbv1beta2.StorageEngineMigrationStatus, status *fdbv1beta2.FoundationDBStatus, engines map[fdbv1beta2.ProcessGroupID]fdbv1beta2.StorageEngine, now time.Time) storageEngineMigrationResult {
+	targetEngine := migration.StorageEngine
+	result := storageEngineMigrationResult{wait: true}
+	if status.Cluster.DatabaseConfiguration.StorageEngine != targetEngine {
+		result.message = fmt.Sprintf("waiting for the database to be configured with storage engine %s", targetEngine)
+		return result
+	}
+
+	if len(migration.ProcessGroups) > 0 {
+		remaining := getRemainingProcessGroups(cluster, migration.ProcessGroups)
+		if remaining > 0 {
+			result.message = fmt.Sprintf("waiting for %d process group(s) of fault domain %s to be replaced", remaining, migration.FaultDomain)
+			return result
+		}
+
+		migration.CompletedBatches++
+		migration.FaultDomain = ""
+		migration.ProcessGroups = nil
+		migration.BatchStartTime = nil
+	}
+
+	candidates := map[fdbv1beta2.FaultDomain][]fdbv1beta2.ProcessGroupID{}
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.ProcessClass != fdbv1beta2.ProcessClassStorage || processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		engine, ok := engines[processGroup.ProcessGroupID]
+		if !ok || engine == targetEngine {
+			continue
+		}
+
+		candidates[processGroup.FaultDomain] = append(candidates[processGroup.FaultDomain], processGroup.ProcessGroupID)
+	}
+
+	if len(candidates) == 0 {
+		result.wait = false
+		result.message = fmt.Sprintf("all storage servers use storage engine %s", targetEngine)
+		return result
+	}
+
+	movingData := status.Cluster.Data.MovingData
+	if movingData.InFlightBytes > cluster.GetStorageEngineMigrationMaximumInFlightBytes() || movingData.InQueueBytes > cluster.GetStorageEngineMigrationMaximumInQueueBytes() {
+		result.message = fmt.Sprintf("waiting for data distribution to settle, %d bytes in flight and %d bytes in queue", movingData.InFlightBytes, movingData.InQueueBytes)
+		return result
+	}
+
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.IsMarkedForRemoval() {
+			result.message = "waiting for other process groups to be removed"
+			return result
+		}
+	}
+
+	faultDomains := make([]fdbv1beta2.FaultDomain, 0, len(candidates))
+	for faultDomain := range candidates {
+		faultDomains = append(faultDomains, faultDomain)
+	}
+	sort.Slice(faultDomains, func(i, j int) bool {
+		return faultDomains[i] < faultDomains[j]
+	})
+
+	faultDomain := faultDomains[0]
+	batch := candidates[faultDomain]
+	sort.Slice(batch, func(i, j int) bool {
+		return batch[i] < batch[j]
+	})
+
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		for _, processGroupID := range batch {
+			if processGroup.ProcessGroupID == processGroupID {
+				processGroup.MarkForRemoval()
+			}
+		}
+	}
+
+	migration.FaultDomain = faultDomain
+	migration.ProcessGroups = batch
+	migration.BatchStartTime = &metav1.Time{Time: now}
+
+	result.reason = "StorageEngineMigration"
+	result.message = fmt.Sprintf("replacing %d process group(s) of fault domain %s to migrate to storage engine %s", len(batch), faultDomain, targetEngine)
+	return result
+}
+
+// getStorageEnginesByProcessGroup returns the storage engine of each storage server in the machine-readable status,
+// keyed by the process group of the storage server. Storage servers that don't report a storage engine are ignored.
+func getStorageEnginesByProcessGroup(status *fdbv1beta2.FoundationDBStatus) map[fdbv1beta2.ProcessGroupID]fdbv1beta2.StorageEngine {
+	engines := map[fdbv1beta2.ProcessGroupID]fdbv1beta2.StorageEngine{}
+	for _, process := range status.Cluster.Processes {
+		processGroupID, ok := process.Locality[fdbv1beta2.FDBLocalityInstanceIDKey]
+		if !ok {
+			continue
+		}
+
+		for _, role := range process.Roles {
+			if role.Role != string(fdbv1beta2.ProcessRoleStorage) || role.StorageMetadata == nil || role.StorageMetadata.StorageEngine == "" {
+				continue
+			}
+
+			engines[fdbv1beta2.ProcessGroupID(processGroupID)] = role.StorageMetadata.StorageEngine
+		}
+	}
+
+	return engines
+}
+
+// getRemainingProcessGroups returns how many of the provided process groups are still present in the cluster status.
+func getRemainingProcessGroups(cluster *fdbv1beta2.FoundationDBCluster, processGroupIDs []fdbv1beta2.ProcessGroupID) int {
+	remaining := 0
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		for _, processGroupID := range processGroupIDs {
+			if processGroup.ProcessGroupID == processGroupID {
+				remaining++
+			}
+		}
+	}
+
+	return remaining
+}
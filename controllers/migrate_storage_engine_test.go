@@ -0,0 +1,215 @@
+/*
+ * migrate_storage_engine_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("migrate_storage_engine", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var status *fdbv1beta2.FoundationDBStatus
+	var now time.Time
+
+	addStorageProcess := func(processGroupID fdbv1beta2.ProcessGroupID, faultDomain fdbv1beta2.FaultDomain, engine fdbv1beta2.StorageEngine) {
+		processGroup := fdbv1beta2.NewProcessGroupStatus(processGroupID, fdbv1beta2.ProcessClassStorage, nil)
+		processGroup.FaultDomain = faultDomain
+		cluster.Status.ProcessGroups = append(cluster.Status.ProcessGroups, processGroup)
+
+		status.Cluster.Processes[processGroupID] = fdbv1beta2.FoundationDBStatusProcessInfo{
+			Locality: map[string]string{fdbv1beta2.FDBLocalityInstanceIDKey: string(processGroupID)},
+			Roles: []fdbv1beta2.FoundationDBStatusProcessRoleInfo{
+				{
+					Role:            string(fdbv1beta2.ProcessRoleStorage),
+					StorageMetadata: &fdbv1beta2.FoundationDBStatusStorageMetadata{StorageEngine: engine},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.AutomationOptions.StorageEngineMigrationOptions.Enabled = pointer.Bool(true)
+		cluster.Spec.DatabaseConfiguration.StorageEngine = fdbv1beta2.StorageEngineRedwood1Experimental
+		cluster.Status.ProcessGroups = nil
+
+		status = &fdbv1beta2.FoundationDBStatus{
+			Cluster: fdbv1beta2.FoundationDBStatusClusterInfo{
+				DatabaseConfiguration: fdbv1beta2.DatabaseConfiguration{
+					StorageEngine: fdbv1beta2.StorageEngineRedwood1Experimental,
+				},
+				Processes: map[fdbv1beta2.ProcessGroupID]fdbv1beta2.FoundationDBStatusProcessInfo{},
+			},
+		}
+
+		addStorageProcess("storage-1", "zone-b", fdbv1beta2.StorageEngineSSD2)
+		addStorageProcess("storage-2", "zone-a", fdbv1beta2.StorageEngineSSD2)
+		addStorageProcess("storage-3", "zone-a", fdbv1beta2.StorageEngineSSD2)
+		addStorageProcess("storage-4", "zone-c", fdbv1beta2.StorageEngineRedwood1Experimental)
+		now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	})
+
+	When("updating the storage engine migration", func() {
+		var result storageEngineMigrationResult
+
+		JustBeforeEach(func() {
+			result = updateStorageEngineMigration(cluster, status, now)
+		})
+
+		It("should replace the process groups of the first fault domain", func() {
+			Expect(result.changed).To(BeTrue())
+			Expect(result.wait).To(BeTrue())
+			Expect(result.reason).To(Equal("StorageEngineMigration"))
+
+			migration := cluster.Status.StorageEngineMigration
+			Expect(migration.StorageEngine).To(Equal(fdbv1beta2.StorageEngineRedwood1Experimental))
+			Expect(migration.StorageServers).To(Equal(map[fdbv1beta2.StorageEngine]int{
+				fdbv1beta2.StorageEngineSSD2:                 3,
+				fdbv1beta2.StorageEngineRedwood1Experimental: 1,
+			}))
+			Expect(migration.FaultDomain).To(Equal(fdbv1beta2.FaultDomain("zone-a")))
+			Expect(migration.ProcessGroups).To(ConsistOf(fdbv1beta2.ProcessGroupID("storage-2"), fdbv1beta2.ProcessGroupID("storage-3")))
+			Expect(migration.BatchStartTime.Time).To(Equal(now))
+
+			var removals []fdbv1beta2.ProcessGroupID
+			for _, processGroup := range cluster.Status.ProcessGroups {
+				if processGroup.IsMarkedForRemoval() {
+					removals = append(removals, processGroup.ProcessGroupID)
+				}
+			}
+			Expect(removals).To(ConsistOf(fdbv1beta2.ProcessGroupID("storage-2"), fdbv1beta2.ProcessGroupID("storage-3")))
+		})
+
+		When("the database is not yet configured with the new storage engine", func() {
+			BeforeEach(func() {
+				status.Cluster.DatabaseConfiguration.StorageEngine = fdbv1beta2.StorageEngineSSD2
+			})
+
+			It("should wait for the configuration change", func() {
+				Expect(result.wait).To(BeTrue())
+				Expect(result.reason).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.ProcessGroups).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.Message).To(Equal("waiting for the database to be configured with storage engine ssd-redwood-1-experimental"))
+			})
+		})
+
+		When("data distribution is moving data", func() {
+			BeforeEach(func() {
+				status.Cluster.Data.MovingData.InFlightBytes = 100
+			})
+
+			It("should wait for data distribution to settle", func() {
+				Expect(result.wait).To(BeTrue())
+				Expect(result.reason).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.ProcessGroups).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.Message).To(Equal("waiting for data distribution to settle, 100 bytes in flight and 0 bytes in queue"))
+			})
+
+			When("the moving data is below the configured limits", func() {
+				BeforeEach(func() {
+					cluster.Spec.AutomationOptions.StorageEngineMigrationOptions.MaximumInFlightBytes = pointer.Int(1000)
+				})
+
+				It("should start the next batch", func() {
+					Expect(result.reason).To(Equal("StorageEngineMigration"))
+					Expect(cluster.Status.StorageEngineMigration.FaultDomain).To(Equal(fdbv1beta2.FaultDomain("zone-a")))
+				})
+			})
+		})
+
+		When("another process group is marked for removal", func() {
+			BeforeEach(func() {
+				cluster.Status.ProcessGroups[3].MarkForRemoval()
+			})
+
+			It("should wait for the removal", func() {
+				Expect(result.wait).To(BeTrue())
+				Expect(result.reason).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.ProcessGroups).To(BeEmpty())
+			})
+		})
+
+		When("the current batch is not yet replaced", func() {
+			BeforeEach(func() {
+				cluster.Status.StorageEngineMigration = &fdbv1beta2.StorageEngineMigrationStatus{
+					StorageEngine:  fdbv1beta2.StorageEngineRedwood1Experimental,
+					FaultDomain:    "zone-a",
+					ProcessGroups:  []fdbv1beta2.ProcessGroupID{"storage-2", "storage-3"},
+					BatchStartTime: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				}
+			})
+
+			It("should wait for the batch", func() {
+				Expect(result.wait).To(BeTrue())
+				Expect(result.reason).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.CompletedBatches).To(BeZero())
+				Expect(cluster.Status.StorageEngineMigration.Message).To(Equal("waiting for 2 process group(s) of fault domain zone-a to be replaced"))
+			})
+		})
+
+		When("the current batch is replaced", func() {
+			BeforeEach(func() {
+				cluster.Status.StorageEngineMigration = &fdbv1beta2.StorageEngineMigrationStatus{
+					StorageEngine:  fdbv1beta2.StorageEngineRedwood1Experimental,
+					FaultDomain:    "zone-a",
+					ProcessGroups:  []fdbv1beta2.ProcessGroupID{"storage-2", "storage-3"},
+					BatchStartTime: &metav1.Time{Time: now.Add(-1 * time.Minute)},
+				}
+				cluster.Status.ProcessGroups = cluster.Status.ProcessGroups[:1]
+				cluster.Status.ProcessGroups = append(cluster.Status.ProcessGroups, fdbv1beta2.NewProcessGroupStatus("storage-4", fdbv1beta2.ProcessClassStorage, nil))
+				delete(status.Cluster.Processes, "storage-2")
+				delete(status.Cluster.Processes, "storage-3")
+			})
+
+			It("should start the next batch", func() {
+				Expect(result.reason).To(Equal("StorageEngineMigration"))
+				Expect(cluster.Status.StorageEngineMigration.CompletedBatches).To(Equal(1))
+				Expect(cluster.Status.StorageEngineMigration.FaultDomain).To(Equal(fdbv1beta2.FaultDomain("zone-b")))
+				Expect(cluster.Status.StorageEngineMigration.ProcessGroups).To(ConsistOf(fdbv1beta2.ProcessGroupID("storage-1")))
+			})
+		})
+
+		When("all storage servers use the configured storage engine", func() {
+			BeforeEach(func() {
+				cluster.Spec.DatabaseConfiguration.StorageEngine = fdbv1beta2.StorageEngineSSD
+				status.Cluster.DatabaseConfiguration.StorageEngine = fdbv1beta2.StorageEngineSSD2
+				delete(status.Cluster.Processes, "storage-4")
+			})
+
+			It("should report the migration as completed", func() {
+				Expect(result.changed).To(BeTrue())
+				Expect(result.wait).To(BeFalse())
+				Expect(result.reason).To(BeEmpty())
+				Expect(cluster.Status.StorageEngineMigration.StorageServers).To(Equal(map[fdbv1beta2.StorageEngine]int{
+					fdbv1beta2.StorageEngineSSD2: 3,
+				}))
+				Expect(cluster.Status.StorageEngineMigration.Message).To(Equal("all storage servers use storage engine ssd-2"))
+			})
+		})
+	})
+})
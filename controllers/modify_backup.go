@@ -24,6 +24,7 @@ import (
 	"context"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
 )
 
 // modifyBackup provides a reconciliation step for modifying a backup's
@@ -32,7 +33,7 @@ type modifyBackup struct {
 }
 
 // reconcile runs the reconciler's work.
-func (s modifyBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup) *requeue {
+func (s modifyBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, _ logr.Logger) *requeue {
 	if backup.Status.BackupDetails == nil || !backup.ShouldRun() {
 		return nil
 	}
@@ -0,0 +1,218 @@
+/*
+ * multi_region_cluster_controller.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// multiRegionClusterRequeueDelay defines how long the operator waits until the clusters of an unreconciled multi-region
+// cluster are checked again.
+const multiRegionClusterRequeueDelay = 1 * time.Minute
+
+// FoundationDBMultiRegionClusterReconciler reconciles a FoundationDBMultiRegionCluster object
+type FoundationDBMultiRegionClusterReconciler struct {
+	client.Client
+	Recorder        record.EventRecorder
+	Log             logr.Logger
+	ServerSideApply bool
+}
+
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbmultiregionclusters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbmultiregionclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbclusters,verbs=get;list;watch;create;update
+
+// Reconcile runs the reconciliation logic.
+func (r *FoundationDBMultiRegionClusterReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	mrc := &fdbv1beta2.FoundationDBMultiRegionCluster{}
+	err := r.Get(ctx, request.NamespacedName, mrc)
+
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically garbage collected.
+			// For additional cleanup logic use finalizers.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	mrcLog := globalControllerLogger.WithValues("namespace", mrc.Namespace, "multiRegionCluster", mrc.Name)
+
+	subReconcilers := []multiRegionClusterSubReconciler{
+		updateMultiRegionClusterStatus{},
+		updateMultiRegionClusters{},
+		updateMultiRegionClusterStatus{},
+	}
+
+	for _, subReconciler := range subReconcilers {
+		requeue := subReconciler.reconcile(ctx, r, mrc, mrcLog)
+		if requeue == nil {
+			continue
+		}
+
+		r.updateReconciledCondition(ctx, mrcLog, mrc, getReconciledCondition(fdbv1beta2.MultiRegionClusterConditionReconciled, mrc.ObjectMeta.Generation, false, requeue, subReconciler))
+		return processRequeue(requeue, subReconciler, mrc, r.Recorder, mrcLog)
+	}
+
+	r.updateReconciledCondition(ctx, mrcLog, mrc, getReconciledCondition(fdbv1beta2.MultiRegionClusterConditionReconciled, mrc.ObjectMeta.Generation, true, nil, nil))
+
+	// The clusters of the data centers are reconciled by the cluster reconciler, so we have to check the clusters again
+	// until all clusters are reconciled.
+	if mrc.Status.Generations.Reconciled < mrc.ObjectMeta.Generation {
+		mrcLog.Info("Waiting for the clusters of all data centers to be reconciled")
+		return ctrl.Result{RequeueAfter: multiRegionClusterRequeueDelay}, nil
+	}
+
+	mrcLog.Info("Reconciliation complete", "generation", mrc.Status.Generations.Reconciled)
+
+	return ctrl.Result{}, nil
+}
+
+// getMultiRegionClusterMembers returns the existing clusters of all data centers of the multi-region cluster. Data
+// centers without a cluster will not be part of the result.
+func (r *FoundationDBMultiRegionClusterReconciler) getMultiRegionClusterMembers(ctx context.Context, mrc *fdbv1beta2.FoundationDBMultiRegionCluster) (map[string]*fdbv1beta2.FoundationDBCluster, error) {
+	clusters := map[string]*fdbv1beta2.FoundationDBCluster{}
+
+	for _, dcID := range mrc.GetDataCenterIDs() {
+		cluster := &fdbv1beta2.FoundationDBCluster{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: mrc.GetClusterNamespace(dcID), Name: mrc.GetClusterName(dcID)}, cluster)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		clusters[dcID] = cluster
+	}
+
+	return clusters, nil
+}
+
+// getDesiredMultiRegionClusterMember returns the desired cluster for the provided data center. The seed connection
+// string of an existing cluster will not be changed, new clusters will use the connection string of the multi-region
+// cluster. The cluster of the configuring data center will be bootstrapped with a single region until the database is
+// configured.
+func getDesiredMultiRegionClusterMember(mrc *fdbv1beta2.FoundationDBMultiRegionCluster, dcID string, existing *fdbv1beta2.FoundationDBCluster) (*fdbv1beta2.FoundationDBCluster, error) {
+	seedConnectionString := mrc.Status.ConnectionString
+	if existing != nil {
+		seedConnectionString = existing.Spec.SeedConnectionString
+	}
+
+	bootstrap := dcID == mrc.Status.ConfiguringDataCenter && (existing == nil || !existing.Status.Configured || existing.Status.ConnectionString == "")
+	if bootstrap && existing == nil {
+		seedConnectionString = ""
+	}
+
+	return internal.GetMultiRegionClusterMember(mrc, dcID, seedConnectionString, bootstrap)
+}
+
+// SetupWithManager prepares a reconciler for use.
+func (r *FoundationDBMultiRegionClusterReconciler) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles int, selector metav1.LabelSelector) error {
+	labelSelectorPredicate, err := predicate.LabelSelectorPredicate(selector)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrentReconciles},
+		).
+		For(&fdbv1beta2.FoundationDBMultiRegionCluster{}).
+		Owns(&fdbv1beta2.FoundationDBCluster{}).
+		// Only react on generation changes or annotation changes and only watch
+		// resources with the provided label selector.
+		WithEventFilter(
+			predicate.And(
+				labelSelectorPredicate,
+				predicate.Or(
+					predicate.GenerationChangedPredicate{},
+					predicate.AnnotationChangedPredicate{},
+				),
+			)).
+		Complete(r)
+}
+
+// multiRegionClusterSubReconciler describes a class that does part of the work of
+// reconciliation for a multi-region cluster.
+type multiRegionClusterSubReconciler interface {
+	/**
+	reconcile runs the reconciler's work.
+
+	If reconciliation can continue, this should return nil.
+
+	If reconciliation encounters an error, this should return a `requeue` object
+	with an `Error` field.
+
+	If reconciliation cannot proceed, this should return a `requeue` object with
+	a `Message` field.
+	*/
+	reconcile(ctx context.Context, r *FoundationDBMultiRegionClusterReconciler, mrc *fdbv1beta2.FoundationDBMultiRegionCluster, logger logr.Logger) *requeue
+}
+
+// updateReconciledCondition sets the Reconciled condition of the multi-region cluster and updates the status if the
+// condition has changed. Errors during the update are only logged as the condition will be updated in the next
+// reconciliation.
+func (r *FoundationDBMultiRegionClusterReconciler) updateReconciledCondition(ctx context.Context, logger logr.Logger, mrc *fdbv1beta2.FoundationDBMultiRegionCluster, condition metav1.Condition) {
+	if !setStatusCondition(&mrc.Status.Conditions, condition) {
+		return
+	}
+
+	err := r.updateOrApply(ctx, mrc)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciled condition", "reason", condition.Reason)
+	}
+}
+
+// updateOrApply updates the status either with server-side apply or if disabled with the normal update call.
+func (r *FoundationDBMultiRegionClusterReconciler) updateOrApply(ctx context.Context, mrc *fdbv1beta2.FoundationDBMultiRegionCluster) error {
+	if r.ServerSideApply {
+		patch := &fdbv1beta2.FoundationDBMultiRegionCluster{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       mrc.Kind,
+				APIVersion: mrc.APIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      mrc.Name,
+				Namespace: mrc.Namespace,
+			},
+			Status: mrc.Status,
+		}
+
+		return r.Status().Patch(ctx, patch, client.Apply, client.FieldOwner("fdb-operator")) //, client.ForceOwnership)
+	}
+
+	return r.Status().Update(ctx, mrc)
+}
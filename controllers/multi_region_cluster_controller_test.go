@@ -0,0 +1,207 @@
+/*
+ * multi_region_cluster_controller_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("multi_region_cluster_controller", func() {
+	var mrc *fdbv1beta2.FoundationDBMultiRegionCluster
+
+	reloadMultiRegionCluster := func() {
+		Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(mrc), mrc)).NotTo(HaveOccurred())
+	}
+
+	getCluster := func(dcID string) *fdbv1beta2.FoundationDBCluster {
+		cluster := &fdbv1beta2.FoundationDBCluster{}
+		Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKey{Namespace: mrc.GetClusterNamespace(dcID), Name: mrc.GetClusterName(dcID)}, cluster)).NotTo(HaveOccurred())
+		return cluster
+	}
+
+	reconcileMultiRegionClusterAndReload := func() {
+		_, err := reconcileMultiRegionCluster(mrc)
+		Expect(err).NotTo(HaveOccurred())
+		reloadMultiRegionCluster()
+	}
+
+	// The mock admin clients can't simulate multiple clusters that share the same database, so the clusters of the data
+	// centers are marked as reconciled instead of running the cluster reconciler.
+	markMemberClustersReconciled := func() {
+		for _, dcID := range mrc.GetDataCenterIDs() {
+			cluster := getCluster(dcID)
+			cluster.Status.Generations = fdbv1beta2.ClusterGenerationStatus{Reconciled: cluster.Generation}
+			Expect(k8sClient.Status().Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		mrc = internal.CreateDefaultMultiRegionCluster()
+	})
+
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(context.TODO(), mrc)).NotTo(HaveOccurred())
+		reconcileMultiRegionClusterAndReload()
+	})
+
+	When("the multi-region cluster is created", func() {
+		It("should only create the cluster of the primary data center", func() {
+			clusters := &fdbv1beta2.FoundationDBClusterList{}
+			Expect(k8sClient.List(context.TODO(), clusters)).NotTo(HaveOccurred())
+			Expect(clusters.Items).To(HaveLen(1))
+
+			cluster := clusters.Items[0]
+			Expect(cluster.Name).To(Equal(mrc.GetClusterName("primary")))
+			Expect(cluster.Labels).To(HaveKeyWithValue(fdbv1beta2.MultiRegionClusterLabel, mrc.Name))
+			Expect(cluster.Spec.DataCenter).To(Equal("primary"))
+			Expect(cluster.Spec.SeedConnectionString).To(BeEmpty())
+			Expect(cluster.Spec.AutomationOptions.ConfigureDatabase).To(BeNil())
+			Expect(cluster.Spec.DatabaseConfiguration.Regions).To(HaveLen(1))
+		})
+
+		It("should wait for the database to be configured", func() {
+			Expect(mrc.Status.ConfiguringDataCenter).To(Equal("primary"))
+			Expect(mrc.Status.Generations).To(Equal(fdbv1beta2.MultiRegionClusterGenerationStatus{
+				NeedsBootstrap:     mrc.Generation,
+				NeedsClusterUpdate: mrc.Generation,
+			}))
+
+			condition := meta.FindStatusCondition(mrc.Status.Conditions, fdbv1beta2.MultiRegionClusterConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("Waiting for the database to be configured in data center primary"))
+		})
+
+		When("the database is configured in the primary data center", func() {
+			var primary *fdbv1beta2.FoundationDBCluster
+
+			JustBeforeEach(func() {
+				_, err := reconcileCluster(getCluster("primary"))
+				Expect(err).NotTo(HaveOccurred())
+				primary = getCluster("primary")
+				Expect(primary.Status.Configured).To(BeTrue())
+
+				reconcileMultiRegionClusterAndReload()
+			})
+
+			It("should create the clusters of all data centers", func() {
+				Expect(mrc.Status.ConnectionString).To(Equal(primary.Status.ConnectionString))
+
+				for _, dcID := range []string{"primary-satellite", "remote", "remote-satellite"} {
+					cluster := getCluster(dcID)
+					Expect(cluster.Spec.DataCenter).To(Equal(dcID))
+					Expect(cluster.Spec.SeedConnectionString).To(Equal(primary.Status.ConnectionString))
+					Expect(cluster.Spec.DatabaseConfiguration).To(Equal(mrc.Spec.ClusterSpec.DatabaseConfiguration))
+					Expect(cluster.Spec.AutomationOptions.ConfigureDatabase).To(Equal(pointer.Bool(false)))
+
+					dcStatus := mrc.GetDataCenterStatus(dcID)
+					Expect(dcStatus).NotTo(BeNil())
+					Expect(dcStatus.Created).To(BeTrue())
+				}
+			})
+
+			It("should update the cluster of the primary data center with all regions", func() {
+				primary = getCluster("primary")
+				Expect(primary.Spec.SeedConnectionString).To(BeEmpty())
+				Expect(primary.Spec.DatabaseConfiguration).To(Equal(mrc.Spec.ClusterSpec.DatabaseConfiguration))
+				Expect(primary.Spec.AutomationOptions.ConfigureDatabase).To(BeNil())
+			})
+
+			When("all clusters are reconciled", func() {
+				JustBeforeEach(func() {
+					markMemberClustersReconciled()
+					reconcileMultiRegionClusterAndReload()
+				})
+
+				It("should mark the multi-region cluster as reconciled", func() {
+					Expect(mrc.Status.Generations).To(Equal(fdbv1beta2.MultiRegionClusterGenerationStatus{
+						Reconciled: mrc.Generation,
+					}))
+
+					for _, dcStatus := range mrc.Status.DataCenters {
+						Expect(dcStatus.Reconciled).To(BeTrue(), dcStatus.ID)
+					}
+
+					condition := meta.FindStatusCondition(mrc.Status.Conditions, fdbv1beta2.MultiRegionClusterConditionReconciled)
+					Expect(condition).NotTo(BeNil())
+					Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				})
+
+				When("the shared configuration is changed", func() {
+					JustBeforeEach(func() {
+						mrc.Spec.ClusterSpec.DatabaseConfiguration.Regions[1].DataCenters[0].Priority = -1
+						mrc.Spec.ClusterSpec.ProcessCounts.Storage = 5
+						Expect(k8sClient.Update(context.TODO(), mrc)).NotTo(HaveOccurred())
+						reconcileMultiRegionClusterAndReload()
+					})
+
+					It("should update the clusters of all data centers", func() {
+						for _, dcID := range mrc.GetDataCenterIDs() {
+							cluster := getCluster(dcID)
+							Expect(cluster.Spec.ProcessCounts.Storage).To(Equal(5))
+							Expect(cluster.Spec.DatabaseConfiguration.Regions[1].DataCenters[0].Priority).To(Equal(-1))
+						}
+					})
+
+					It("should keep the configuring data center", func() {
+						Expect(mrc.Status.ConfiguringDataCenter).To(Equal("primary"))
+						Expect(getCluster("primary").Spec.AutomationOptions.ConfigureDatabase).To(BeNil())
+						Expect(getCluster("remote").Spec.AutomationOptions.ConfigureDatabase).To(Equal(pointer.Bool(false)))
+					})
+
+					It("should wait for the clusters to be reconciled", func() {
+						Expect(mrc.Status.Generations.Reconciled).To(BeNumerically("<", mrc.Generation))
+						Expect(mrc.Status.Generations.NeedsClusterUpdate).To(Equal(mrc.Generation))
+					})
+				})
+			})
+		})
+	})
+
+	When("a data center uses custom settings", func() {
+		BeforeEach(func() {
+			mrc.Spec.DataCenters = []fdbv1beta2.MultiRegionDataCenter{
+				{
+					ID:     "primary",
+					Labels: map[string]string{"dc": "primary"},
+					ProcessCounts: &fdbv1beta2.ProcessCounts{
+						Storage: 6,
+					},
+				},
+			}
+		})
+
+		It("should apply the settings to the cluster of the data center", func() {
+			cluster := getCluster("primary")
+			Expect(cluster.Labels).To(HaveKeyWithValue("dc", "primary"))
+			Expect(cluster.Spec.ProcessCounts.Storage).To(Equal(6))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+/*
+ * resize_pvcs.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pvcResizeDelay defines how long the operator waits before checking the PVCs again if a PVC is being resized.
+const pvcResizeDelay = 1 * time.Minute
+
+// resizePVCs provides a reconciliation step for expanding the PVCs of process groups in place if the requested storage
+// size was increased and the StorageClass of the PVC allows volume expansion. Shrinking a PVC or any other change of the
+// PVC spec requires a replacement of the process group, which is done by the replaceMisconfiguredProcessGroups
+// sub-reconciler.
+type resizePVCs struct{}
+
+// reconcile runs the reconciler's work.
+func (resizePVCs) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, _ *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	statusChanged := false
+	resizingPVCs := 0
+
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		// Process groups that are not part of the canary rollout will be resized once the canary is promoted.
+		if !cluster.IsCanaryUpdateAllowed(processGroup.ProcessGroupID) {
+			continue
+		}
+
+		resizing, err := resizePVC(ctx, r, cluster, processGroup, logger.WithValues("processGroupID", processGroup.ProcessGroupID))
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+
+		if resizing {
+			resizingPVCs++
+		}
+
+		if (processGroup.GetConditionTime(fdbv1beta2.ResizingPVC) != nil) != resizing {
+			processGroup.UpdateCondition(fdbv1beta2.ResizingPVC, resizing)
+			statusChanged = true
+		}
+	}
+
+	if statusChanged {
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if resizingPVCs > 0 {
+		return &requeue{message: fmt.Sprintf("waiting for %d PVCs to be resized", resizingPVCs), delay: pvcResizeDelay, delayedRequeue: true}
+	}
+
+	return nil
+}
+
+// resizePVC updates the storage request of the PVC of the provided process group if the PVC can be expanded in place.
+// The method returns true if the PVC is being resized.
+func resizePVC(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, processGroup *fdbv1beta2.ProcessGroupStatus, logger logr.Logger) (bool, error) {
+	desiredPVC, err := internal.GetPvc(cluster, processGroup)
+	if err != nil || desiredPVC == nil {
+		return false, err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err = r.Get(ctx, client.ObjectKey{Namespace: desiredPVC.Namespace, Name: desiredPVC.Name}, pvc)
+	if err != nil {
+		// Missing PVCs will be created by the addPVCs sub-reconciler.
+		return false, client.IgnoreNotFound(err)
+	}
+
+	canBeExpanded, err := internal.PVCCanBeExpanded(ctx, r, pvc, desiredPVC)
+	if err != nil {
+		return false, err
+	}
+
+	if !canBeExpanded {
+		return internal.PVCIsResizing(pvc), nil
+	}
+
+	currentSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	desiredSize := desiredPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+	logger.Info("Expanding PVC", "pvc", pvc.Name, "currentSize", currentSize.String(), "desiredSize", desiredSize.String())
+
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desiredSize
+	pvc.Annotations[fdbv1beta2.LastSpecKey] = desiredPVC.Annotations[fdbv1beta2.LastSpecKey]
+	err = r.Update(ctx, pvc)
+	if err != nil {
+		return false, err
+	}
+
+	r.Recorder.Event(cluster, corev1.EventTypeNormal, "ResizingPVC", fmt.Sprintf("expanding PVC %s from %s to %s", pvc.Name, currentSize.String(), desiredSize.String()))
+
+	return true, nil
+}
+
+// pvcIsBeingResized returns true if the provided PVC is being resized or will be expanded in place to the storage
+// request of the desired PVC.
+func pvcIsBeingResized(ctx context.Context, r *FoundationDBClusterReconciler, pvc *corev1.PersistentVolumeClaim, desiredPVC *corev1.PersistentVolumeClaim) (bool, error) {
+	if internal.PVCIsResizing(pvc) {
+		return true, nil
+	}
+
+	return internal.PVCCanBeExpanded(ctx, r, pvc, desiredPVC)
+}
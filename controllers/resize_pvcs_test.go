@@ -0,0 +1,197 @@
+/*
+ * resize_pvcs_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("resize_pvcs", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var storageClass *storagev1.StorageClass
+	var result *requeue
+	var pvcs *corev1.PersistentVolumeClaimList
+
+	setStorageRequest := func(size string) {
+		cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral].VolumeClaimTemplate.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse(size)
+	}
+
+	BeforeEach(func() {
+		storageClass = &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "expandable",
+			},
+			Provisioner:          "test",
+			AllowVolumeExpansion: pointer.Bool(true),
+		}
+		Expect(k8sClient.Create(context.TODO(), storageClass)).NotTo(HaveOccurred())
+
+		cluster = internal.CreateDefaultCluster()
+		Expect(internal.NormalizeClusterSpec(cluster, internal.DeprecationOptions{})).NotTo(HaveOccurred())
+		generalSettings := cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral]
+		generalSettings.VolumeClaimTemplate = &corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.String(storageClass.Name),
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("16Gi"),
+					},
+				},
+			},
+		}
+		cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral] = generalSettings
+		Expect(k8sClient.Create(context.TODO(), cluster)).NotTo(HaveOccurred())
+
+		reconcileResult, err := reconcileCluster(cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconcileResult.Requeue).To(BeFalse())
+
+		_, err = reloadCluster(cluster)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		result = resizePVCs{}.reconcile(context.TODO(), clusterReconciler, cluster, nil, globalControllerLogger)
+
+		pvcs = &corev1.PersistentVolumeClaimList{}
+		Expect(k8sClient.List(context.TODO(), pvcs)).NotTo(HaveOccurred())
+		Expect(pvcs.Items).NotTo(BeEmpty())
+	})
+
+	When("the storage request is unchanged", func() {
+		It("should not requeue", func() {
+			Expect(result).To(BeNil())
+			Expect(fdbv1beta2.FilterByCondition(cluster.Status.ProcessGroups, fdbv1beta2.ResizingPVC, false)).To(BeEmpty())
+		})
+	})
+
+	When("the storage request was increased", func() {
+		BeforeEach(func() {
+			setStorageRequest("32Gi")
+		})
+
+		It("should expand the PVCs", func() {
+			Expect(result).NotTo(BeNil())
+			Expect(result.delayedRequeue).To(BeTrue())
+			Expect(result.message).To(Equal(fmt.Sprintf("waiting for %d PVCs to be resized", len(pvcs.Items))))
+
+			for _, pvc := range pvcs.Items {
+				Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("32Gi"))
+			}
+
+			Expect(fdbv1beta2.FilterByCondition(cluster.Status.ProcessGroups, fdbv1beta2.ResizingPVC, false)).To(HaveLen(len(pvcs.Items)))
+		})
+
+		It("should update the spec hash of the PVCs", func() {
+			for _, pvc := range pvcs.Items {
+				processGroupID := internal.GetProcessGroupIDFromMeta(cluster, pvc.ObjectMeta)
+				desiredPVC, err := internal.GetPvc(cluster, fdbv1beta2.FindProcessGroupByID(cluster.Status.ProcessGroups, processGroupID))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pvc.Annotations[fdbv1beta2.LastSpecKey]).To(Equal(desiredPVC.Annotations[fdbv1beta2.LastSpecKey]))
+			}
+		})
+
+		When("the StorageClass doesn't allow volume expansion", func() {
+			BeforeEach(func() {
+				storageClass.AllowVolumeExpansion = pointer.Bool(false)
+				Expect(k8sClient.Update(context.TODO(), storageClass)).NotTo(HaveOccurred())
+			})
+
+			It("should not expand the PVCs", func() {
+				Expect(result).To(BeNil())
+
+				for _, pvc := range pvcs.Items {
+					Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("16Gi"))
+				}
+
+				Expect(fdbv1beta2.FilterByCondition(cluster.Status.ProcessGroups, fdbv1beta2.ResizingPVC, false)).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the storage request was decreased", func() {
+		BeforeEach(func() {
+			setStorageRequest("8Gi")
+		})
+
+		It("should not change the PVCs", func() {
+			Expect(result).To(BeNil())
+
+			for _, pvc := range pvcs.Items {
+				Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("16Gi"))
+			}
+		})
+	})
+
+	When("the PVCs were resized", func() {
+		BeforeEach(func() {
+			for _, processGroup := range cluster.Status.ProcessGroups {
+				processGroup.UpdateCondition(fdbv1beta2.ResizingPVC, true)
+			}
+
+			pvcList := &corev1.PersistentVolumeClaimList{}
+			Expect(k8sClient.List(context.TODO(), pvcList)).NotTo(HaveOccurred())
+			for _, pvc := range pvcList.Items {
+				pvc.Status.Capacity = corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("16Gi"),
+				}
+				Expect(k8sClient.Status().Update(context.TODO(), &pvc)).NotTo(HaveOccurred())
+			}
+		})
+
+		It("should remove the condition", func() {
+			Expect(result).To(BeNil())
+			Expect(fdbv1beta2.FilterByCondition(cluster.Status.ProcessGroups, fdbv1beta2.ResizingPVC, false)).To(BeEmpty())
+		})
+
+		When("the file system resize is pending", func() {
+			BeforeEach(func() {
+				pvcList := &corev1.PersistentVolumeClaimList{}
+				Expect(k8sClient.List(context.TODO(), pvcList)).NotTo(HaveOccurred())
+				pvc := pvcList.Items[0]
+				pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+					{
+						Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+						Status: corev1.ConditionTrue,
+					},
+				}
+				Expect(k8sClient.Status().Update(context.TODO(), &pvc)).NotTo(HaveOccurred())
+			})
+
+			It("should keep the condition for the process group", func() {
+				Expect(result).NotTo(BeNil())
+				Expect(result.message).To(Equal("waiting for 1 PVCs to be resized"))
+				Expect(fdbv1beta2.FilterByCondition(cluster.Status.ProcessGroups, fdbv1beta2.ResizingPVC, false)).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -22,6 +22,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -39,6 +40,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// restoreStatusRequeueDelay defines how long the operator waits until the status of a running restore is checked again.
+const restoreStatusRequeueDelay = 1 * time.Minute
+
 // FoundationDBRestoreReconciler reconciles a FoundationDBRestore object
 type FoundationDBRestoreReconciler struct {
 	client.Client
@@ -50,6 +54,7 @@ type FoundationDBRestoreReconciler struct {
 
 // +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbrestores,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbrestores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbbackups,verbs=get;list;watch
 // +kubebuilder:rbac:groups="coordination.k8s.io",resources=leases,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile runs the reconciliation logic.
@@ -70,7 +75,9 @@ func (r *FoundationDBRestoreReconciler) Reconcile(ctx context.Context, request c
 	restoreLog := globalControllerLogger.WithValues("namespace", restore.Namespace, "restore", restore.Name)
 
 	subReconcilers := []restoreSubReconciler{
+		updateRestoreStatus{},
 		startRestore{},
+		cancelRestore{},
 	}
 
 	for _, subReconciler := range subReconcilers {
@@ -79,9 +86,19 @@ func (r *FoundationDBRestoreReconciler) Reconcile(ctx context.Context, request c
 			continue
 		}
 
+		r.updateReconciledCondition(ctx, restoreLog, restore, getReconciledCondition(fdbv1beta2.RestoreConditionReconciled, restore.ObjectMeta.Generation, false, requeue, subReconciler))
 		return processRequeue(requeue, subReconciler, restore, r.Recorder, restoreLog)
 	}
 
+	r.updateReconciledCondition(ctx, restoreLog, restore, getReconciledCondition(fdbv1beta2.RestoreConditionReconciled, restore.ObjectMeta.Generation, true, nil, nil))
+
+	// The progress of the restore is only reported by fdbrestore, so we have to check the restore again until it
+	// reaches a terminal phase.
+	if restore.GetPhase() == fdbv1beta2.RestorePhaseRunning {
+		restoreLog.Info("Waiting for restore to finish", "progressPercent", restore.Status.ProgressPercent)
+		return ctrl.Result{RequeueAfter: restoreStatusRequeueDelay}, nil
+	}
+
 	restoreLog.Info("Reconciliation complete")
 
 	return ctrl.Result{}, nil
@@ -155,6 +172,19 @@ type restoreSubReconciler interface {
 	reconcile(ctx context.Context, r *FoundationDBRestoreReconciler, restore *fdbv1beta2.FoundationDBRestore) *requeue
 }
 
+// updateReconciledCondition sets the Reconciled condition of the restore and updates the status if the condition has
+// changed. Errors during the update are only logged as the condition will be updated in the next reconciliation.
+func (r *FoundationDBRestoreReconciler) updateReconciledCondition(ctx context.Context, logger logr.Logger, restore *fdbv1beta2.FoundationDBRestore, condition metav1.Condition) {
+	if !setStatusCondition(&restore.Status.Conditions, condition) {
+		return
+	}
+
+	err := r.updateOrApply(ctx, restore)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciled condition", "reason", condition.Reason)
+	}
+}
+
 // updateOrApply updates the status either with server-side apply or if disabled with the normal update call.
 func (r *FoundationDBRestoreReconciler) updateOrApply(ctx context.Context, restore *fdbv1beta2.FoundationDBRestore) error {
 	if r.ServerSideApply {
@@ -21,6 +21,8 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
 	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,7 +31,11 @@ import (
 	"context"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 func reloadRestore(restore *fdbv1beta2.FoundationDBRestore) error {
@@ -94,7 +100,105 @@ var _ = Describe("restore_controller", func() {
 			It("should start a restore", func() {
 				status, err := adminClient.GetRestoreStatus()
 				Expect(err).NotTo(HaveOccurred())
-				Expect(status).To(Equal("blobstore://test@test-service/test-backup?bucket=fdb-backups\n"))
+				Expect(status).NotTo(BeNil())
+				Expect(status.URL).To(Equal("blobstore://test@test-service/test-backup?bucket=fdb-backups"))
+			})
+
+			It("should set the conditions on the resource", func() {
+				Expect(meta.IsStatusConditionTrue(restore.Status.Conditions, fdbv1beta2.RestoreConditionReconciled)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(restore.Status.Conditions, fdbv1beta2.RestoreConditionRunning)).To(BeTrue())
+			})
+
+			It("should update the status on the resource", func() {
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseRunning))
+				Expect(restore.Status.StartTime).NotTo(BeNil())
+				Expect(restore.Status.EndTime).To(BeNil())
+				Expect(restore.Status.TargetVersion).NotTo(BeZero())
+			})
+		})
+
+		When("the restore makes progress", func() {
+			BeforeEach(func() {
+				status, err := adminClient.GetRestoreStatus()
+				Expect(err).NotTo(HaveOccurred())
+				status.BlocksCompleted = 40
+				status.BytesWritten = 4096
+				adminClient.MockRestoreStatus(status)
+			})
+
+			It("should update the progress", func() {
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseRunning))
+				Expect(restore.Status.Running).To(BeTrue())
+				Expect(restore.Status.ProgressPercent).To(Equal(40))
+				Expect(restore.Status.RestoredBytes).To(Equal(int64(4096)))
+			})
+		})
+
+		When("the restore has completed", func() {
+			BeforeEach(func() {
+				status, err := adminClient.GetRestoreStatus()
+				Expect(err).NotTo(HaveOccurred())
+				status.State = fdbv1beta2.RestoreStateCompleted
+				status.BlocksCompleted = status.BlocksTotal
+				adminClient.MockRestoreStatus(status)
+			})
+
+			It("should move the restore into the completed phase", func() {
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseCompleted))
+				Expect(restore.Status.Running).To(BeFalse())
+				Expect(restore.Status.ProgressPercent).To(Equal(100))
+				Expect(restore.Status.EndTime).NotTo(BeNil())
+				Expect(meta.IsStatusConditionFalse(restore.Status.Conditions, fdbv1beta2.RestoreConditionRunning)).To(BeTrue())
+			})
+
+			When("another restore is started on the destination cluster", func() {
+				JustBeforeEach(func() {
+					status, err := adminClient.GetRestoreStatus()
+					Expect(err).NotTo(HaveOccurred())
+					status.State = fdbv1beta2.RestoreStateRunning
+					adminClient.MockRestoreStatus(status)
+
+					_, err = reconcileRestore(restore)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(reloadRestore(restore)).NotTo(HaveOccurred())
+				})
+
+				It("should keep the completed phase", func() {
+					Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseCompleted))
+				})
+			})
+		})
+
+		When("the restore has failed", func() {
+			BeforeEach(func() {
+				status, err := adminClient.GetRestoreStatus()
+				Expect(err).NotTo(HaveOccurred())
+				status.State = fdbv1beta2.RestoreStateAborted
+				status.LastError = "restore_destination_not_empty"
+				adminClient.MockRestoreStatus(status)
+			})
+
+			It("should move the restore into the failed phase", func() {
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseFailed))
+				Expect(restore.Status.Running).To(BeFalse())
+				Expect(restore.Status.Error).To(Equal("restore_destination_not_empty"))
+				Expect(restore.Status.EndTime).NotTo(BeNil())
+			})
+		})
+
+		When("the restore is cancelled", func() {
+			BeforeEach(func() {
+				restore.Spec.Cancel = true
+				err = k8sClient.Update(context.TODO(), restore)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should abort the restore", func() {
+				status, err := adminClient.GetRestoreStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.State).To(Equal(fdbv1beta2.RestoreStateAborted))
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseFailed))
+				Expect(restore.Status.Error).To(Equal("The restore was cancelled"))
 			})
 		})
 
@@ -113,4 +217,221 @@ var _ = Describe("restore_controller", func() {
 			})
 		})
 	})
+
+	Describe("Updating the restore status", func() {
+		var liveStatus *fdbv1beta2.FoundationDBLiveRestoreStatus
+		var result ctrl.Result
+
+		BeforeEach(func() {
+			Expect(setupClusterForTest(cluster)).NotTo(HaveOccurred())
+			Expect(k8sClient.Create(context.TODO(), restore)).NotTo(HaveOccurred())
+			_, err = reconcileRestore(restore)
+			Expect(err).NotTo(HaveOccurred())
+
+			liveStatus, err = adminClient.GetRestoreStatus()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			adminClient.MockRestoreStatus(liveStatus)
+			result, err = reconcileRestore(restore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reloadRestore(restore)).NotTo(HaveOccurred())
+		})
+
+		When("the restore status belongs to another backup", func() {
+			BeforeEach(func() {
+				liveStatus.URL = "blobstore://test@test-service/other-backup?bucket=fdb-backups"
+				liveStatus.State = fdbv1beta2.RestoreStateCompleted
+			})
+
+			It("should ignore the restore status", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseRunning))
+				Expect(restore.Status.EndTime).To(BeNil())
+
+				condition := meta.FindStatusCondition(restore.Status.Conditions, fdbv1beta2.RestoreConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Message).To(ContainSubstring("the restore status belongs to a restore from blobstore://test@test-service/other-backup?bucket=fdb-backups"))
+			})
+		})
+
+		When("the restore is in an unknown state", func() {
+			BeforeEach(func() {
+				liveStatus.State = "unknown"
+			})
+
+			It("should not update the restore status", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseRunning))
+				Expect(restore.Status.Running).To(BeTrue())
+
+				condition := meta.FindStatusCondition(restore.Status.Conditions, fdbv1beta2.RestoreConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Message).To(ContainSubstring("unknown restore state unknown"))
+			})
+		})
+	})
+
+	Describe("Point-in-time restore", func() {
+		var result ctrl.Result
+
+		BeforeEach(func() {
+			Expect(setupClusterForTest(cluster)).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			Expect(k8sClient.Create(context.TODO(), restore)).NotTo(HaveOccurred())
+			result, err = reconcileRestore(restore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reloadRestore(restore)).NotTo(HaveOccurred())
+		})
+
+		When("the target version is restorable", func() {
+			var targetVersion int64
+
+			BeforeEach(func() {
+				targetVersion = time.Now().Add(-1*time.Hour).Unix() * 1000000
+				restore.Spec.TargetVersion = pointer.Int64(targetVersion)
+			})
+
+			It("should start the restore to the target version", func() {
+				Expect(result.Requeue).To(BeFalse())
+				Expect(restore.Status.Running).To(BeTrue())
+				Expect(adminClient.RestoreOptions.TargetVersion).To(HaveValue(Equal(targetVersion)))
+			})
+		})
+
+		When("the target version is not restorable", func() {
+			BeforeEach(func() {
+				restore.Spec.TargetVersion = pointer.Int64(1000)
+			})
+
+			It("should not start the restore", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Running).To(BeFalse())
+
+				status, err := adminClient.GetRestoreStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(BeNil())
+
+				condition := meta.FindStatusCondition(restore.Status.Conditions, fdbv1beta2.RestoreConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Message).To(ContainSubstring("target version 1000 is outside of the restorable versions"))
+			})
+		})
+
+		When("the target timestamp is restorable", func() {
+			var targetTimestamp time.Time
+
+			BeforeEach(func() {
+				Expect(k8sClient.Create(context.TODO(), internal.CreateDefaultBackup(cluster))).NotTo(HaveOccurred())
+				targetTimestamp = time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+				restore.Spec.TargetTimestamp = &metav1.Time{Time: targetTimestamp}
+			})
+
+			It("should start the restore to the target timestamp", func() {
+				Expect(result.Requeue).To(BeFalse())
+				Expect(restore.Status.Running).To(BeTrue())
+				Expect(adminClient.RestoreOptions.TargetTimestamp).NotTo(BeNil())
+				Expect(adminClient.RestoreOptions.TargetTimestamp.Unix()).To(Equal(targetTimestamp.Unix()))
+			})
+		})
+
+		When("the target timestamp is not restorable", func() {
+			BeforeEach(func() {
+				Expect(k8sClient.Create(context.TODO(), internal.CreateDefaultBackup(cluster))).NotTo(HaveOccurred())
+				restore.Spec.TargetTimestamp = &metav1.Time{Time: time.Now().Add(-48 * time.Hour)}
+			})
+
+			It("should not start the restore", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Running).To(BeFalse())
+			})
+		})
+
+		When("a target timestamp is used for the backup of another cluster", func() {
+			BeforeEach(func() {
+				backup := internal.CreateDefaultBackup(cluster)
+				backup.Spec.ClusterName = "other-cluster"
+				Expect(k8sClient.Create(context.TODO(), backup)).NotTo(HaveOccurred())
+				restore.Spec.TargetTimestamp = &metav1.Time{Time: time.Now().Add(-1 * time.Hour)}
+			})
+
+			It("should not start the restore", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Running).To(BeFalse())
+
+				condition := meta.FindStatusCondition(restore.Status.Conditions, fdbv1beta2.RestoreConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Message).To(ContainSubstring("target timestamp is only supported for backups of the destination cluster"))
+			})
+		})
+
+		When("a target timestamp is used for a backup that is not managed by the operator", func() {
+			BeforeEach(func() {
+				restore.Spec.TargetTimestamp = &metav1.Time{Time: time.Now().Add(-1 * time.Hour)}
+			})
+
+			It("should not start the restore", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Running).To(BeFalse())
+			})
+		})
+
+		When("the restore is cancelled before it was started", func() {
+			BeforeEach(func() {
+				restore.Spec.Cancel = true
+			})
+
+			It("should not start the restore", func() {
+				status, err := adminClient.GetRestoreStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(BeNil())
+				Expect(restore.Status.Phase).To(Equal(fdbv1beta2.RestorePhaseFailed))
+				Expect(restore.Status.Error).To(Equal("The restore was cancelled before it was started"))
+			})
+		})
+
+		When("a prefix should be added and removed", func() {
+			BeforeEach(func() {
+				restore.Spec.KeyRanges = []fdbv1beta2.FoundationDBKeyRange{
+					{
+						Start: "app/",
+						End:   "app0",
+					},
+				}
+				restore.Spec.AddPrefix = "restored/"
+				restore.Spec.RemovePrefix = "app/"
+			})
+
+			It("should pass the prefixes to the restore", func() {
+				Expect(restore.Status.Running).To(BeTrue())
+				Expect(adminClient.RestoreOptions.AddPrefix).To(Equal("restored/"))
+				Expect(adminClient.RestoreOptions.RemovePrefix).To(Equal("app/"))
+			})
+		})
+
+		When("a key range is not included in the backup", func() {
+			BeforeEach(func() {
+				Expect(k8sClient.Create(context.TODO(), internal.CreateDefaultBackup(cluster))).NotTo(HaveOccurred())
+				restore.Spec.KeyRanges = []fdbv1beta2.FoundationDBKeyRange{
+					{
+						Start: `\xff\x02`,
+						End:   `\xff\x03`,
+					},
+				}
+			})
+
+			It("should not start the restore", func() {
+				Expect(result.Requeue).To(BeTrue())
+				Expect(restore.Status.Running).To(BeFalse())
+
+				condition := meta.FindStatusCondition(restore.Status.Conditions, fdbv1beta2.RestoreConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Message).To(ContainSubstring("is not included in the backup"))
+			})
+		})
+	})
 })
@@ -0,0 +1,151 @@
+/*
+ * rollback_upgrade.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// rollbackUpgrade provides a reconciliation step for rolling back protocol compatible upgrades that didn't finish
+// within the stall timeout. The failed version will be locked out until the rollback is acknowledged. The previous
+// version is recorded as the rollback target in the cluster status and will be used as desired version, the version
+// in the cluster spec is not modified.
+type rollbackUpgrade struct{}
+
+// reconcile runs the reconciler's work.
+func (rollbackUpgrade) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, _ *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	changed := false
+	if cluster.Status.UpgradeRollback != nil && cluster.IsUpgradeRollbackAcknowledged() {
+		failedVersion := cluster.Status.UpgradeRollback.FailedVersion
+		logger.Info("Upgrade rollback was acknowledged", "failedVersion", failedVersion)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, "UpgradeRollbackAcknowledged", fmt.Sprintf("Version %s is no longer locked out", failedVersion))
+		cluster.Status.UpgradeRollback = nil
+		meta.RemoveStatusCondition(&cluster.Status.Conditions, fdbv1beta2.ClusterConditionUpgradeRolledBack)
+		changed = true
+	}
+
+	upgradeChanged, rollback := updateUpgradeStatus(cluster, time.Now())
+	if rollback != nil {
+		logger.Info("Rolling back stalled upgrade", "failedVersion", rollback.FailedVersion, "previousVersion", rollback.PreviousVersion, "reason", rollback.Reason)
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, "UpgradeRolledBack", fmt.Sprintf("Rolling back to version %s: %s", rollback.PreviousVersion, rollback.Reason))
+		setStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+			Type:               fdbv1beta2.ClusterConditionUpgradeRolledBack,
+			ObservedGeneration: cluster.ObjectMeta.Generation,
+			Status:             metav1.ConditionTrue,
+			Reason:             fdbv1beta2.ConditionReasonUpgradeStalled,
+			Message:            rollback.Reason,
+		})
+	}
+
+	if changed || upgradeChanged {
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if cluster.IsVersionLockedOut(cluster.Spec.Version) {
+		logger.Info("Desired version is locked out after an upgrade rollback", "failedVersion", cluster.Spec.Version, "previousVersion", cluster.GetDesiredVersion())
+	}
+
+	// The reconciliation is restarted to roll out the previous version with the updated status.
+	if rollback != nil {
+		return &requeue{message: fmt.Sprintf("rolled back the stalled upgrade to version %s", rollback.PreviousVersion)}
+	}
+
+	return nil
+}
+
+// updateUpgradeStatus updates the information about the current protocol compatible upgrade and returns the rollback
+// information if the upgrade stalled. The returned bool is true if the status was changed.
+func updateUpgradeStatus(cluster *fdbv1beta2.FoundationDBCluster, now time.Time) (bool, *fdbv1beta2.UpgradeRollbackStatus) {
+	// A rollback to the previous version will not be rolled back again.
+	rollingBack := cluster.Status.UpgradeRollback != nil && cluster.Status.UpgradeRollback.PreviousVersion == cluster.GetDesiredVersion()
+	if !cluster.VersionCompatibleUpgradeInProgress() || rollingBack {
+		if cluster.Status.Upgrade == nil {
+			return false, nil
+		}
+
+		cluster.Status.Upgrade = nil
+		return true, nil
+	}
+
+	upgrade := cluster.Status.Upgrade
+	if upgrade == nil || upgrade.Version != cluster.GetDesiredVersion() {
+		cluster.Status.Upgrade = &fdbv1beta2.UpgradeStatus{
+			Version:         cluster.GetDesiredVersion(),
+			PreviousVersion: cluster.Status.RunningVersion,
+			StartTime:       &metav1.Time{Time: now},
+		}
+
+		return true, nil
+	}
+
+	if !cluster.UseAutomaticUpgradeRollback() || upgrade.StartTime == nil {
+		return false, nil
+	}
+
+	stallTimeout := cluster.GetUpgradeStallTimeout()
+	if now.Sub(upgrade.StartTime.Time) < stallTimeout {
+		return false, nil
+	}
+
+	reason := fmt.Sprintf("upgrade from %s to %s did not finish within %s", upgrade.PreviousVersion, upgrade.Version, stallTimeout)
+
+	var pendingProcessGroups int
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		if processGroup.GetConditionTime(fdbv1beta2.IncorrectCommandLine) != nil || processGroup.GetConditionTime(fdbv1beta2.IncorrectPodSpec) != nil {
+			pendingProcessGroups++
+		}
+	}
+
+	if pendingProcessGroups > 0 {
+		reason += fmt.Sprintf(", %d process groups are not running the new version", pendingProcessGroups)
+	}
+
+	if cluster.Status.BlockingReconciler != nil {
+		reason += fmt.Sprintf(", the reconciliation is blocked by %s: %s", cluster.Status.BlockingReconciler.SubReconciler, cluster.Status.BlockingReconciler.Message)
+	}
+
+	rollback := &fdbv1beta2.UpgradeRollbackStatus{
+		FailedVersion:   upgrade.Version,
+		PreviousVersion: upgrade.PreviousVersion,
+		Reason:          reason,
+		Timestamp:       &metav1.Time{Time: now},
+	}
+
+	cluster.Status.UpgradeRollback = rollback
+	cluster.Status.Upgrade = nil
+
+	return true, rollback
+}
@@ -0,0 +1,239 @@
+/*
+ * rollback_upgrade_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("rollback_upgrade", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var now time.Time
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.Version = "7.1.25"
+		cluster.Status.RunningVersion = "7.1.21"
+		cluster.Spec.AutomationOptions.UpgradeRollbackOptions.Enabled = pointer.Bool(true)
+		cluster.Status.ProcessGroups = []*fdbv1beta2.ProcessGroupStatus{
+			fdbv1beta2.NewProcessGroupStatus("storage-1", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.1"}),
+			fdbv1beta2.NewProcessGroupStatus("storage-2", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.2"}),
+		}
+		// Remove the initial conditions to represent healthy process groups.
+		for _, processGroup := range cluster.Status.ProcessGroups {
+			processGroup.ProcessGroupConditions = nil
+		}
+		now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	})
+
+	When("updating the upgrade status", func() {
+		var changed bool
+		var rollback *fdbv1beta2.UpgradeRollbackStatus
+
+		JustBeforeEach(func() {
+			changed, rollback = updateUpgradeStatus(cluster, now)
+		})
+
+		When("no upgrade is in progress", func() {
+			BeforeEach(func() {
+				cluster.Status.RunningVersion = cluster.Spec.Version
+			})
+
+			It("should not change the status", func() {
+				Expect(changed).To(BeFalse())
+				Expect(rollback).To(BeNil())
+				Expect(cluster.Status.Upgrade).To(BeNil())
+			})
+
+			When("the status contains a finished upgrade", func() {
+				BeforeEach(func() {
+					cluster.Status.Upgrade = &fdbv1beta2.UpgradeStatus{Version: "7.1.25", PreviousVersion: "7.1.21"}
+				})
+
+				It("should remove the upgrade from the status", func() {
+					Expect(changed).To(BeTrue())
+					Expect(rollback).To(BeNil())
+					Expect(cluster.Status.Upgrade).To(BeNil())
+				})
+			})
+		})
+
+		When("a version incompatible upgrade is in progress", func() {
+			BeforeEach(func() {
+				cluster.Spec.Version = "7.3.0"
+			})
+
+			It("should not track the upgrade", func() {
+				Expect(changed).To(BeFalse())
+				Expect(rollback).To(BeNil())
+				Expect(cluster.Status.Upgrade).To(BeNil())
+			})
+		})
+
+		When("a protocol compatible upgrade was started", func() {
+			It("should track the upgrade", func() {
+				Expect(changed).To(BeTrue())
+				Expect(rollback).To(BeNil())
+				Expect(cluster.Status.Upgrade).To(Equal(&fdbv1beta2.UpgradeStatus{
+					Version:         "7.1.25",
+					PreviousVersion: "7.1.21",
+					StartTime:       &metav1.Time{Time: now},
+				}))
+			})
+		})
+
+		When("a protocol compatible upgrade is in progress", func() {
+			BeforeEach(func() {
+				cluster.Status.Upgrade = &fdbv1beta2.UpgradeStatus{
+					Version:         "7.1.25",
+					PreviousVersion: "7.1.21",
+					StartTime:       &metav1.Time{Time: now.Add(-30 * time.Minute)},
+				}
+			})
+
+			It("should not change the status", func() {
+				Expect(changed).To(BeFalse())
+				Expect(rollback).To(BeNil())
+			})
+
+			When("the upgrade stalled", func() {
+				BeforeEach(func() {
+					cluster.Status.Upgrade.StartTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+					cluster.Status.ProcessGroups[0].UpdateCondition(fdbv1beta2.IncorrectCommandLine, true)
+					cluster.Status.BlockingReconciler = &fdbv1beta2.BlockingReconcilerInfo{
+						SubReconciler: "BounceProcesses",
+						Message:       "processes are missing",
+					}
+				})
+
+				It("should roll back the upgrade", func() {
+					Expect(changed).To(BeTrue())
+					Expect(rollback).NotTo(BeNil())
+					Expect(rollback.FailedVersion).To(Equal("7.1.25"))
+					Expect(rollback.PreviousVersion).To(Equal("7.1.21"))
+					Expect(rollback.Reason).To(Equal("upgrade from 7.1.21 to 7.1.25 did not finish within 1h0m0s, 1 process groups are not running the new version, the reconciliation is blocked by BounceProcesses: processes are missing"))
+					Expect(cluster.Status.UpgradeRollback).To(Equal(rollback))
+					Expect(cluster.Status.Upgrade).To(BeNil())
+					Expect(cluster.IsVersionLockedOut("7.1.25")).To(BeTrue())
+				})
+
+				When("automatic rollbacks are disabled", func() {
+					BeforeEach(func() {
+						cluster.Spec.AutomationOptions.UpgradeRollbackOptions.Enabled = nil
+					})
+
+					It("should not roll back the upgrade", func() {
+						Expect(changed).To(BeFalse())
+						Expect(rollback).To(BeNil())
+					})
+				})
+
+				When("a custom stall timeout is defined", func() {
+					BeforeEach(func() {
+						cluster.Spec.AutomationOptions.UpgradeRollbackOptions.StallTimeoutSeconds = pointer.Int(3 * 3600)
+					})
+
+					It("should not roll back the upgrade", func() {
+						Expect(changed).To(BeFalse())
+						Expect(rollback).To(BeNil())
+					})
+				})
+			})
+
+			When("the desired version changed", func() {
+				BeforeEach(func() {
+					cluster.Spec.Version = "7.1.27"
+				})
+
+				It("should track the new upgrade", func() {
+					Expect(changed).To(BeTrue())
+					Expect(rollback).To(BeNil())
+					Expect(cluster.Status.Upgrade.Version).To(Equal("7.1.27"))
+					Expect(cluster.Status.Upgrade.StartTime.Time).To(BeTemporally("==", now))
+				})
+			})
+		})
+
+		When("the cluster is rolled back to the previous version", func() {
+			BeforeEach(func() {
+				cluster.Spec.Version = "7.1.21"
+				cluster.Status.RunningVersion = "7.1.25"
+				cluster.Status.UpgradeRollback = &fdbv1beta2.UpgradeRollbackStatus{
+					FailedVersion:   "7.1.25",
+					PreviousVersion: "7.1.21",
+				}
+			})
+
+			It("should not track the rollback as upgrade", func() {
+				Expect(changed).To(BeFalse())
+				Expect(rollback).To(BeNil())
+				Expect(cluster.Status.Upgrade).To(BeNil())
+			})
+		})
+	})
+
+	When("checking if a version is locked out", func() {
+		BeforeEach(func() {
+			cluster.Status.UpgradeRollback = &fdbv1beta2.UpgradeRollbackStatus{
+				FailedVersion:   "7.1.25",
+				PreviousVersion: "7.1.21",
+			}
+		})
+
+		It("should lock out the failed version", func() {
+			Expect(cluster.IsVersionLockedOut("7.1.25")).To(BeTrue())
+			Expect(cluster.IsVersionLockedOut("7.1.27")).To(BeFalse())
+		})
+
+		When("the failed version is defined in the spec", func() {
+			BeforeEach(func() {
+				cluster.Spec.Version = "7.1.25"
+			})
+
+			It("should use the previous version as desired version", func() {
+				Expect(cluster.GetDesiredVersion()).To(Equal("7.1.21"))
+				Expect(cluster.Spec.Version).To(Equal("7.1.25"))
+			})
+		})
+
+		When("the rollback is acknowledged", func() {
+			BeforeEach(func() {
+				cluster.Annotations = map[string]string{fdbv1beta2.AcknowledgeUpgradeRollbackAnnotation: "7.1.25"}
+			})
+
+			It("should not lock out the failed version", func() {
+				Expect(cluster.IsVersionLockedOut("7.1.25")).To(BeFalse())
+			})
+
+			It("should use the version from the spec as desired version", func() {
+				cluster.Spec.Version = "7.1.25"
+				Expect(cluster.GetDesiredVersion()).To(Equal("7.1.25"))
+			})
+		})
+	})
+})
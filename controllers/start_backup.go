@@ -24,6 +24,7 @@ import (
 	"context"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
 )
 
 // startBackup provides a reconciliation step for starting a new backup.
@@ -31,7 +32,7 @@ type startBackup struct {
 }
 
 // reconcile runs the reconciler's work.
-func (s startBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup) *requeue {
+func (s startBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, _ logr.Logger) *requeue {
 	if !backup.ShouldRun() || (backup.Status.BackupDetails != nil && backup.Status.BackupDetails.Running) {
 		return nil
 	}
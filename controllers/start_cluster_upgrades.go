@@ -0,0 +1,194 @@
+/*
+ * start_cluster_upgrades.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// startClusterUpgrades provides a reconciliation step for starting the upgrades of the clusters in the current wave of
+// an upgrade plan.
+type startClusterUpgrades struct{}
+
+// reconcile runs the reconciler's work.
+func (startClusterUpgrades) reconcile(ctx context.Context, r *FoundationDBUpgradePlanReconciler, plan *fdbv1beta2.FoundationDBUpgradePlan, logger logr.Logger) *requeue {
+	// A failed cluster upgrade must be resolved before further upgrades will be started.
+	if plan.Status.Phase == fdbv1beta2.UpgradePlanPhaseCompleted || plan.Status.Phase == fdbv1beta2.UpgradePlanPhaseFailed {
+		return nil
+	}
+
+	var wave *fdbv1beta2.UpgradeWave
+	waves := plan.GetWaves()
+	for idx := range waves {
+		if waves[idx].Name == plan.Status.CurrentWave {
+			wave = &waves[idx]
+			break
+		}
+	}
+
+	if wave == nil {
+		return nil
+	}
+
+	var upgrading int
+	for _, clusterStatus := range plan.Status.Clusters {
+		if clusterStatus.Wave == wave.Name && clusterStatus.State == fdbv1beta2.UpgradePlanClusterStateUpgrading {
+			upgrading++
+		}
+	}
+
+	var changed bool
+	var preflightFailures []string
+	for idx := range plan.Status.Clusters {
+		clusterStatus := &plan.Status.Clusters[idx]
+		if clusterStatus.Wave != wave.Name {
+			continue
+		}
+
+		if clusterStatus.State != fdbv1beta2.UpgradePlanClusterStatePending && clusterStatus.State != fdbv1beta2.UpgradePlanClusterStatePreflightFailed {
+			continue
+		}
+
+		if upgrading >= wave.GetMaxConcurrency() {
+			break
+		}
+
+		cluster := &fdbv1beta2.FoundationDBCluster{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: plan.Namespace, Name: clusterStatus.Name}, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		message, err := runUpgradePreflightChecks(r, cluster, plan.Spec.Version)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		if message != "" {
+			if clusterStatus.State != fdbv1beta2.UpgradePlanClusterStatePreflightFailed || clusterStatus.Message != message {
+				logger.Info("Preflight checks failed", "cluster", cluster.Name, "message", message)
+				r.Recorder.Event(plan, corev1.EventTypeWarning, "UpgradePreflightFailed", fmt.Sprintf("Preflight checks for cluster %s failed: %s", cluster.Name, message))
+				clusterStatus.State = fdbv1beta2.UpgradePlanClusterStatePreflightFailed
+				clusterStatus.Message = message
+				changed = true
+			}
+
+			preflightFailures = append(preflightFailures, cluster.Name)
+			continue
+		}
+
+		previousVersion := cluster.GetRunningVersion()
+		logger.Info("Starting cluster upgrade", "cluster", cluster.Name, "previousVersion", previousVersion, "version", plan.Spec.Version)
+		cluster.Spec.Version = plan.Spec.Version
+		err = r.Update(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		r.Recorder.Event(plan, corev1.EventTypeNormal, "ClusterUpgradeStarted", fmt.Sprintf("Upgrading cluster %s from version %s to version %s", cluster.Name, previousVersion, plan.Spec.Version))
+		clusterStatus.State = fdbv1beta2.UpgradePlanClusterStateUpgrading
+		clusterStatus.PreviousVersion = previousVersion
+		clusterStatus.StartTime = &metav1.Time{Time: time.Now()}
+		clusterStatus.Message = ""
+		changed = true
+		upgrading++
+	}
+
+	if changed {
+		plan.Status.Phase, plan.Status.CurrentWave = getUpgradePlanPhase(plan)
+		err := r.updateOrApply(ctx, plan)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	if len(preflightFailures) > 0 {
+		return &requeue{message: fmt.Sprintf("preflight checks failed for clusters: %s", strings.Join(preflightFailures, ", ")), delay: upgradePlanRequeueDelay}
+	}
+
+	return nil
+}
+
+// runUpgradePreflightChecks checks if the cluster can be upgraded to the provided version. If the upgrade is not
+// possible, the returned message contains the reason.
+func runUpgradePreflightChecks(r *FoundationDBUpgradePlanReconciler, cluster *fdbv1beta2.FoundationDBCluster, version string) (string, error) {
+	if cluster.Status.Generations.Reconciled < cluster.ObjectMeta.Generation {
+		return fmt.Sprintf("cluster is not reconciled, generation %d is reconciled but generation %d is desired", cluster.Status.Generations.Reconciled, cluster.ObjectMeta.Generation), nil
+	}
+
+	runningVersion, err := fdbv1beta2.ParseFdbVersion(cluster.GetRunningVersion())
+	if err != nil {
+		return "", err
+	}
+
+	desiredVersion, err := fdbv1beta2.ParseFdbVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	if !runningVersion.SupportsVersionChange(desiredVersion) {
+		return fmt.Sprintf("cluster version change from version %s to version %s is not supported", runningVersion, desiredVersion), nil
+	}
+
+	adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+	if err != nil {
+		return "", err
+	}
+	defer adminClient.Close()
+
+	supported, err := adminClient.VersionSupported(version)
+	if err != nil {
+		return "", err
+	}
+
+	if !supported {
+		return fmt.Sprintf("version %s is not supported", version), nil
+	}
+
+	if desiredVersion.IsProtocolCompatible(runningVersion) || cluster.Spec.IgnoreUpgradabilityChecks {
+		return "", nil
+	}
+
+	status, err := adminClient.GetStatus()
+	if err != nil {
+		return "", err
+	}
+
+	unsupportedClients, err := getUnsupportedClientsForVersion(adminClient, cluster, status, version)
+	if err != nil {
+		return "", err
+	}
+
+	if len(unsupportedClients) > 0 {
+		return fmt.Sprintf("%d clients do not support version %s: %s", len(unsupportedClients), version, strings.Join(unsupportedClients, ", ")), nil
+	}
+
+	return "", nil
+}
@@ -22,9 +22,15 @@ package controllers
 
 import (
 	"context"
-	"strings"
+	"fmt"
+	"time"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // startRestore provides a reconciliation step for starting a new restore.
@@ -33,29 +39,152 @@ type startRestore struct {
 
 // reconcile runs the reconciler's work.
 func (s startRestore) reconcile(ctx context.Context, r *FoundationDBRestoreReconciler, restore *fdbv1beta2.FoundationDBRestore) *requeue {
+	if restore.GetPhase() != fdbv1beta2.RestorePhasePending {
+		return nil
+	}
+
+	if restore.Spec.Cancel {
+		setRestoreTerminalPhase(restore, fdbv1beta2.RestorePhaseFailed, "The restore was cancelled before it was started", time.Now())
+		err := r.updateOrApply(ctx, restore)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		return nil
+	}
+
 	adminClient, err := r.adminClientForRestore(ctx, restore)
 	if err != nil {
 		return &requeue{curError: err}
 	}
 	defer adminClient.Close()
 
+	// The restore status is reported per tag, so the status can belong to a previous restore.
 	status, err := adminClient.GetRestoreStatus()
 	if err != nil {
 		return &requeue{curError: err}
 	}
 
-	if len(strings.TrimSpace(status)) == 0 {
-		err = adminClient.StartRestore(restore.BackupURL(), restore.Spec.KeyRanges)
+	if status != nil {
+		phase, err := getRestorePhaseForState(status.State)
 		if err != nil {
-			return &requeue{curError: err}
+			return &requeue{message: err.Error(), delay: time.Minute}
 		}
 
-		restore.Status.Running = true
-		err = r.updateOrApply(ctx, restore)
-		if err != nil {
-			return &requeue{curError: err}
+		if phase == fdbv1beta2.RestorePhaseRunning {
+			return &requeue{message: fmt.Sprintf("another restore from %s is running", status.URL), delay: time.Minute}
 		}
 	}
 
+	description, err := adminClient.DescribeBackup(restore.BackupURL())
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	backup, err := getBackupForRestore(ctx, r, restore)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	message := validateRestoreTarget(restore, description, backup)
+	if message != "" {
+		r.Recorder.Event(restore, corev1.EventTypeWarning, "InvalidRestoreTarget", message)
+		return &requeue{message: message, delay: time.Minute}
+	}
+
+	err = adminClient.StartRestore(restore.BackupURL(), restore.Spec.KeyRanges, getRestoreOptions(restore))
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	restore.Status.Running = true
+	restore.Status.Phase = fdbv1beta2.RestorePhaseRunning
+	restore.Status.StartTime = &metav1.Time{Time: time.Now()}
+	restore.Status.TargetVersion = pointer.Int64Deref(restore.Spec.TargetVersion, 0)
+	setStatusCondition(&restore.Status.Conditions, newStatusCondition(fdbv1beta2.RestoreConditionRunning, restore.ObjectMeta.Generation, true, fdbv1beta2.ConditionReasonRestoreRunning, fdbv1beta2.ConditionReasonRestoreNotRunning, fmt.Sprintf("The restore from %s was started", restore.BackupURL())))
+	err = r.updateOrApply(ctx, restore)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
 	return nil
 }
+
+// getRestoreOptions returns the options for the restore command based on the restore spec.
+func getRestoreOptions(restore *fdbv1beta2.FoundationDBRestore) fdbadminclient.RestoreOptions {
+	options := fdbadminclient.RestoreOptions{
+		TargetVersion: restore.Spec.TargetVersion,
+		AddPrefix:     restore.Spec.AddPrefix,
+		RemovePrefix:  restore.Spec.RemovePrefix,
+	}
+
+	if restore.Spec.TargetTimestamp != nil {
+		options.TargetTimestamp = &restore.Spec.TargetTimestamp.Time
+	}
+
+	return options
+}
+
+// getBackupForRestore returns the FoundationDBBackup in the namespace of the restore that writes to the backup URL of
+// the restore. If the backup is not managed by the operator, nil will be returned.
+func getBackupForRestore(ctx context.Context, r *FoundationDBRestoreReconciler, restore *fdbv1beta2.FoundationDBRestore) (*fdbv1beta2.FoundationDBBackup, error) {
+	backups := &fdbv1beta2.FoundationDBBackupList{}
+	err := r.List(ctx, backups, client.InNamespace(restore.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, backup := range backups.Items {
+		if backup.Spec.BlobStoreConfiguration == nil {
+			continue
+		}
+
+		if backup.BackupURL() == restore.BackupURL() {
+			return &backups.Items[idx], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// validateRestoreTarget checks if the backup can be restored to the target version or timestamp of the restore and if
+// the restored key ranges are included in the backup. If the target is not restorable, the returned message contains
+// the reason.
+func validateRestoreTarget(restore *fdbv1beta2.FoundationDBRestore, description *fdbv1beta2.FoundationDBBackupDescription, backup *fdbv1beta2.FoundationDBBackup) string {
+	if !description.Restorable || description.MinRestorablePoint == nil || description.MaxRestorablePoint == nil {
+		return fmt.Sprintf("the backup %s is not restorable", restore.BackupURL())
+	}
+
+	minPoint := description.MinRestorablePoint
+	maxPoint := description.MaxRestorablePoint
+
+	if restore.Spec.TargetVersion != nil {
+		targetVersion := *restore.Spec.TargetVersion
+		if targetVersion < minPoint.Version || targetVersion > maxPoint.Version {
+			return fmt.Sprintf("target version %d is outside of the restorable versions %d to %d", targetVersion, minPoint.Version, maxPoint.Version)
+		}
+	}
+
+	if restore.Spec.TargetTimestamp != nil {
+		// The timestamp is resolved by the timekeeper of the original cluster and the restore passes the cluster file
+		// of the destination cluster as the original cluster file.
+		if backup == nil || backup.Spec.ClusterName != restore.Spec.DestinationClusterName {
+			return fmt.Sprintf("target timestamp is only supported for backups of the destination cluster %s, use a target version instead", restore.Spec.DestinationClusterName)
+		}
+
+		targetTimestamp := restore.Spec.TargetTimestamp.Unix()
+		if targetTimestamp < minPoint.Epochs || targetTimestamp > maxPoint.Epochs {
+			return fmt.Sprintf("target timestamp %s is outside of the restorable timestamps %s to %s", restore.Spec.TargetTimestamp.UTC().Format(time.RFC3339), time.Unix(minPoint.Epochs, 0).UTC().Format(time.RFC3339), time.Unix(maxPoint.Epochs, 0).UTC().Format(time.RFC3339))
+		}
+	}
+
+	// The key ranges of backups that are not managed by the operator are unknown.
+	if backup != nil {
+		err := restore.ValidateKeyRangesForBackup(backup.KeyRanges())
+		if err != nil {
+			return fmt.Sprintf("invalid key ranges for the backup %s: %s", restore.BackupURL(), err.Error())
+		}
+	}
+
+	return ""
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
 )
 
 // stopBackup provides a reconciliation step for stopping backup.
@@ -31,7 +32,7 @@ type stopBackup struct {
 }
 
 // reconcile runs the reconciler's work.
-func (s stopBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup) *requeue {
+func (s stopBackup) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, _ logr.Logger) *requeue {
 	if backup.ShouldRun() || backup.Status.BackupDetails == nil || !backup.Status.BackupDetails.Running {
 		return nil
 	}
@@ -60,6 +60,10 @@ var k8sClient *mockclient.MockClient
 var clusterReconciler *FoundationDBClusterReconciler
 var backupReconciler *FoundationDBBackupReconciler
 var restoreReconciler *FoundationDBRestoreReconciler
+var upgradePlanReconciler *FoundationDBUpgradePlanReconciler
+var disasterRecoveryReconciler *FoundationDBDisasterRecoveryReconciler
+var multiRegionClusterReconciler *FoundationDBMultiRegionClusterReconciler
+var tenantReconciler *FoundationDBTenantReconciler
 var requeueLimit = 20
 
 func TestAPIs(t *testing.T) {
@@ -95,6 +99,34 @@ var _ = BeforeSuite(func() {
 		Recorder:               k8sClient,
 		DatabaseClientProvider: mock.DatabaseClientProvider{},
 	}
+
+	upgradePlanReconciler = &FoundationDBUpgradePlanReconciler{
+		Client:                 k8sClient,
+		Log:                    ctrl.Log.WithName("controllers").WithName("FoundationDBUpgradePlan"),
+		Recorder:               k8sClient,
+		DatabaseClientProvider: mock.DatabaseClientProvider{},
+	}
+
+	disasterRecoveryReconciler = &FoundationDBDisasterRecoveryReconciler{
+		Client:                 k8sClient,
+		Log:                    ctrl.Log.WithName("controllers").WithName("FoundationDBDisasterRecovery"),
+		Recorder:               k8sClient,
+		InSimulation:           true,
+		DatabaseClientProvider: mock.DatabaseClientProvider{},
+	}
+
+	multiRegionClusterReconciler = &FoundationDBMultiRegionClusterReconciler{
+		Client:   k8sClient,
+		Log:      ctrl.Log.WithName("controllers").WithName("FoundationDBMultiRegionCluster"),
+		Recorder: k8sClient,
+	}
+
+	tenantReconciler = &FoundationDBTenantReconciler{
+		Client:                 k8sClient,
+		Log:                    ctrl.Log.WithName("controllers").WithName("FoundationDBTenant"),
+		Recorder:               k8sClient,
+		DatabaseClientProvider: mock.DatabaseClientProvider{},
+	}
 })
 
 var _ = AfterSuite(func() {
@@ -142,6 +174,22 @@ func reconcileRestore(restore *fdbv1beta2.FoundationDBRestore) (reconcile.Result
 	return reconcileObject(restoreReconciler, restore.ObjectMeta, requeueLimit)
 }
 
+func reconcileUpgradePlan(plan *fdbv1beta2.FoundationDBUpgradePlan) (reconcile.Result, error) {
+	return reconcileObject(upgradePlanReconciler, plan.ObjectMeta, requeueLimit)
+}
+
+func reconcileDisasterRecovery(dr *fdbv1beta2.FoundationDBDisasterRecovery) (reconcile.Result, error) {
+	return reconcileObject(disasterRecoveryReconciler, dr.ObjectMeta, requeueLimit)
+}
+
+func reconcileMultiRegionCluster(mrc *fdbv1beta2.FoundationDBMultiRegionCluster) (reconcile.Result, error) {
+	return reconcileObject(multiRegionClusterReconciler, mrc.ObjectMeta, requeueLimit)
+}
+
+func reconcileTenant(tenant *fdbv1beta2.FoundationDBTenant) (reconcile.Result, error) {
+	return reconcileObject(tenantReconciler, tenant.ObjectMeta, requeueLimit)
+}
+
 func reconcileObject(reconciler reconcile.Reconciler, metadata metav1.ObjectMeta, requeueLimit int) (reconcile.Result, error) {
 	attempts := requeueLimit + 1
 	result := reconcile.Result{Requeue: true}
@@ -0,0 +1,69 @@
+/*
+ * switch_disaster_recovery.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// switchDisasterRecovery provides a reconciliation step for switching the active cluster.
+type switchDisasterRecovery struct{}
+
+// reconcile runs the reconciler's work.
+func (s switchDisasterRecovery) reconcile(ctx context.Context, r *FoundationDBDisasterRecoveryReconciler, dr *fdbv1beta2.FoundationDBDisasterRecovery) *requeue {
+	if !dr.NeedsSwitchover() {
+		return nil
+	}
+
+	// A switchover is only safe if the destination cluster contains all the data of the active cluster.
+	if dr.Status.DRDetails == nil || dr.Status.DRDetails.State != fdbv1beta2.DisasterRecoveryReplicationStateReplicating || dr.Status.DRDetails.Paused {
+		return &requeue{message: "Cannot switch the active cluster until the replication is running and the destination cluster is a complete copy", delay: disasterRecoveryStatusRequeueDelay}
+	}
+
+	adminClient, sourceConnectionString, err := r.adminClientForDisasterRecovery(ctx, dr)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+	defer adminClient.Close()
+
+	desiredActiveCluster := dr.GetDesiredActiveCluster()
+	globalControllerLogger.Info("Switching active cluster", "namespace", dr.Namespace, "disasterRecovery", dr.Name, "currentActiveCluster", dr.GetActiveCluster(), "desiredActiveCluster", desiredActiveCluster)
+	err = adminClient.SwitchDisasterRecovery(sourceConnectionString)
+	if err != nil {
+		r.Recorder.Event(dr, corev1.EventTypeWarning, "DisasterRecoverySwitchoverFailed", err.Error())
+		return &requeue{curError: err}
+	}
+
+	r.Recorder.Event(dr, corev1.EventTypeNormal, "DisasterRecoverySwitched", fmt.Sprintf("The %s cluster is now the active cluster", strings.ToLower(string(desiredActiveCluster))))
+	dr.Status.ActiveCluster = desiredActiveCluster
+
+	err = r.updateOrApply(ctx, dr)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
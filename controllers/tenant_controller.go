@@ -0,0 +1,282 @@
+/*
+ * tenant_controller.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// tenantDeletionRequeueDelay defines how long the operator waits until it checks again if a tenant that contains data
+// can be deleted.
+const tenantDeletionRequeueDelay = 1 * time.Minute
+
+// FoundationDBTenantReconciler reconciles a FoundationDBTenant object
+type FoundationDBTenantReconciler struct {
+	client.Client
+	Recorder               record.EventRecorder
+	Log                    logr.Logger
+	DatabaseClientProvider fdbadminclient.DatabaseClientProvider
+	ServerSideApply        bool
+}
+
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbtenants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbtenants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbclusters,verbs=get;list;watch
+
+// Reconcile runs the reconciliation logic.
+func (r *FoundationDBTenantReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	tenant := &fdbv1beta2.FoundationDBTenant{}
+
+	err := r.Get(ctx, request.NamespacedName, tenant)
+
+	originalGeneration := tenant.ObjectMeta.Generation
+
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Object not found, return.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	tenantLog := globalControllerLogger.WithValues("namespace", tenant.Namespace, "tenant", tenant.Name)
+
+	if !tenant.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.deleteTenant(ctx, tenantLog, tenant)
+	}
+
+	// The finalizer makes sure that the tenant in the database is deleted before the FoundationDBTenant is removed.
+	if !controllerutil.ContainsFinalizer(tenant, fdbv1beta2.FoundationDBTenantFinalizer) {
+		controllerutil.AddFinalizer(tenant, fdbv1beta2.FoundationDBTenantFinalizer)
+		err = r.Update(ctx, tenant)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	subReconcilers := []tenantSubReconciler{
+		updateTenantStatus{},
+		createTenant{},
+		updateTenantStatus{},
+	}
+
+	for _, subReconciler := range subReconcilers {
+		requeue := subReconciler.reconcile(ctx, r, tenant)
+		if requeue == nil {
+			continue
+		}
+
+		r.updateReconciledCondition(ctx, tenantLog, tenant, getReconciledCondition(fdbv1beta2.TenantConditionReconciled, originalGeneration, false, requeue, subReconciler))
+		return processRequeue(requeue, subReconciler, tenant, r.Recorder, tenantLog)
+	}
+
+	r.updateReconciledCondition(ctx, tenantLog, tenant, getReconciledCondition(fdbv1beta2.TenantConditionReconciled, originalGeneration, tenant.Status.Generations.Reconciled >= originalGeneration, nil, nil))
+
+	if tenant.Status.Generations.Reconciled < originalGeneration {
+		tenantLog.Info("Tenant was not fully reconciled by reconciliation process")
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	tenantLog.Info("Reconciliation complete")
+
+	return ctrl.Result{}, nil
+}
+
+// deleteTenant deletes the tenant in the database and removes the finalizer afterwards. If the tenant contains data and
+// the deletion is not forced, the tenant will be kept and the deletion will be retried later.
+func (r *FoundationDBTenantReconciler) deleteTenant(ctx context.Context, logger logr.Logger, tenant *fdbv1beta2.FoundationDBTenant) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(tenant, fdbv1beta2.FoundationDBTenantFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	cluster, err := r.getClusterForTenant(ctx, tenant)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	// If the cluster is gone, the tenant is gone as well.
+	if cluster != nil && tenant.Status.Created {
+		adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		defer adminClient.Close()
+
+		tenantName := tenant.Status.TenantName
+		liveStatus, err := adminClient.GetTenant(tenantName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if liveStatus != nil {
+			force := tenant.ShouldForceDeletion()
+			if !force {
+				isEmpty, err := adminClient.IsTenantEmpty(tenantName)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+
+				if !isEmpty {
+					message := fmt.Sprintf("Tenant %s contains data and will not be deleted", tenantName)
+					logger.Info("Waiting for tenant to be empty before deletion", "tenantName", tenantName)
+					r.Recorder.Event(tenant, corev1.EventTypeWarning, "TenantNotEmpty", message)
+					r.updateReconciledCondition(ctx, logger, tenant, metav1.Condition{
+						Type:               fdbv1beta2.TenantConditionReconciled,
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: tenant.ObjectMeta.Generation,
+						Reason:             fdbv1beta2.ConditionReasonReconciliationBlocked,
+						Message:            message,
+					})
+
+					return ctrl.Result{Requeue: true, RequeueAfter: tenantDeletionRequeueDelay}, nil
+				}
+			}
+
+			logger.Info("Deleting tenant", "tenantName", tenantName, "force", force)
+			err = adminClient.DeleteTenant(tenantName, force)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			r.Recorder.Event(tenant, corev1.EventTypeNormal, "DeletedTenant", fmt.Sprintf("Deleted tenant %s", tenantName))
+		}
+	}
+
+	controllerutil.RemoveFinalizer(tenant, fdbv1beta2.FoundationDBTenantFinalizer)
+	err = r.Update(ctx, tenant)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getDatabaseClientProvider gets the client provider for a reconciler.
+func (r *FoundationDBTenantReconciler) getDatabaseClientProvider() fdbadminclient.DatabaseClientProvider {
+	if r.DatabaseClientProvider != nil {
+		return r.DatabaseClientProvider
+	}
+	panic("Tenant reconciler does not have a DatabaseClientProvider defined")
+}
+
+// getClusterForTenant returns the cluster that contains the tenant.
+func (r *FoundationDBTenantReconciler) getClusterForTenant(ctx context.Context, tenant *fdbv1beta2.FoundationDBTenant) (*fdbv1beta2.FoundationDBCluster, error) {
+	cluster := &fdbv1beta2.FoundationDBCluster{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: tenant.Namespace, Name: tenant.Spec.ClusterName}, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// SetupWithManager prepares a reconciler for use.
+func (r *FoundationDBTenantReconciler) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles int, selector metav1.LabelSelector) error {
+	labelSelectorPredicate, err := predicate.LabelSelectorPredicate(selector)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrentReconciles},
+		).
+		For(&fdbv1beta2.FoundationDBTenant{}).
+		// Only react on generation changes or annotation changes and only watch
+		// resources with the provided label selector.
+		WithEventFilter(
+			predicate.And(
+				labelSelectorPredicate,
+				predicate.Or(
+					predicate.GenerationChangedPredicate{},
+					predicate.AnnotationChangedPredicate{},
+				),
+			)).
+		Complete(r)
+}
+
+// tenantSubReconciler describes a class that does part of the work of
+// reconciliation for a tenant.
+type tenantSubReconciler interface {
+	/**
+	reconcile runs the reconciler's work.
+
+	If reconciliation can continue, this should return nil.
+
+	If reconciliation encounters an error, this should return a requeue object
+	with an `Error` field.
+
+	If reconciliation cannot proceed, this should return a requeue object with a
+	`Message` field.
+	*/
+	reconcile(ctx context.Context, r *FoundationDBTenantReconciler, tenant *fdbv1beta2.FoundationDBTenant) *requeue
+}
+
+// updateReconciledCondition sets the Reconciled condition of the tenant and updates the status if the condition has
+// changed. Errors during the update are only logged as the condition will be updated in the next reconciliation.
+func (r *FoundationDBTenantReconciler) updateReconciledCondition(ctx context.Context, logger logr.Logger, tenant *fdbv1beta2.FoundationDBTenant, condition metav1.Condition) {
+	if !setStatusCondition(&tenant.Status.Conditions, condition) {
+		return
+	}
+
+	err := r.updateOrApply(ctx, tenant)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciled condition", "reason", condition.Reason)
+	}
+}
+
+// updateOrApply updates the status either with server-side apply or if disabled with the normal update call.
+func (r *FoundationDBTenantReconciler) updateOrApply(ctx context.Context, tenant *fdbv1beta2.FoundationDBTenant) error {
+	if r.ServerSideApply {
+		patch := &fdbv1beta2.FoundationDBTenant{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       tenant.Kind,
+				APIVersion: tenant.APIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tenant.Name,
+				Namespace: tenant.Namespace,
+			},
+			Status: tenant.Status,
+		}
+
+		return r.Status().Patch(ctx, patch, client.Apply, client.FieldOwner("fdb-operator"))
+	}
+
+	return r.Status().Update(ctx, tenant)
+}
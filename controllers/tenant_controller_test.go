@@ -0,0 +1,212 @@
+/*
+ * tenant_controller_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("tenant_controller", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var tenant *fdbv1beta2.FoundationDBTenant
+	var adminClient *mock.AdminClient
+
+	reconcileTenantAndReload := func() {
+		_, err := reconcileTenant(tenant)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(tenant), tenant)).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.Version = fdbv1beta2.Versions.SupportsTenants.String()
+		tenantMode := fdbv1beta2.TenantModeOptionalExperimental
+		cluster.Spec.DatabaseConfiguration.TenantMode = &tenantMode
+		tenant = &fdbv1beta2.FoundationDBTenant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app",
+				Namespace: cluster.Namespace,
+			},
+			Spec: fdbv1beta2.FoundationDBTenantSpec{
+				ClusterName: cluster.Name,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(setupClusterForTest(cluster)).NotTo(HaveOccurred())
+
+		var err error
+		adminClient, err = mock.NewMockAdminClientUncast(cluster, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Create(context.TODO(), tenant)).NotTo(HaveOccurred())
+		reconcileTenantAndReload()
+	})
+
+	When("the tenant is created", func() {
+		It("should create the tenant in the database", func() {
+			Expect(adminClient.Tenants).To(HaveKey("app"))
+			Expect(tenant.Finalizers).To(ConsistOf(fdbv1beta2.FoundationDBTenantFinalizer))
+			Expect(tenant.Status.Created).To(BeTrue())
+			Expect(tenant.Status.TenantName).To(Equal("app"))
+			Expect(tenant.Status.ID).To(Equal(adminClient.Tenants["app"].ID))
+			Expect(tenant.Status.Prefix).To(Equal(adminClient.Tenants["app"].Prefix))
+			Expect(tenant.Status.Generations).To(Equal(fdbv1beta2.TenantGenerationStatus{Reconciled: tenant.Generation}))
+
+			condition := meta.FindStatusCondition(tenant.Status.Conditions, fdbv1beta2.TenantConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		When("the tenant name is changed", func() {
+			JustBeforeEach(func() {
+				tenant.Spec.TenantName = "other"
+				Expect(k8sClient.Update(context.TODO(), tenant)).NotTo(HaveOccurred())
+				reconcileTenantAndReload()
+			})
+
+			It("should not rename the tenant", func() {
+				Expect(adminClient.Tenants).To(HaveKey("app"))
+				Expect(adminClient.Tenants).NotTo(HaveKey("other"))
+				Expect(tenant.Status.TenantName).To(Equal("app"))
+
+				condition := meta.FindStatusCondition(tenant.Status.Conditions, fdbv1beta2.TenantConditionReconciled)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Message).To(ContainSubstring("tenant name cannot be changed from app to other"))
+			})
+		})
+
+		When("the tenant is deleted", func() {
+			var hasData bool
+			var forceDeletion bool
+
+			BeforeEach(func() {
+				hasData = false
+				forceDeletion = false
+			})
+
+			JustBeforeEach(func() {
+				adminClient.MockTenantHasData("app", hasData)
+				if forceDeletion {
+					tenant.Spec.ForceDeletion = pointer.Bool(true)
+					Expect(k8sClient.Update(context.TODO(), tenant)).NotTo(HaveOccurred())
+				}
+
+				Expect(k8sClient.Delete(context.TODO(), tenant)).NotTo(HaveOccurred())
+				_, err := reconcileTenant(tenant)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should delete the tenant in the database", func() {
+				Expect(adminClient.Tenants).NotTo(HaveKey("app"))
+				err := k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(tenant), tenant)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			When("the tenant contains data", func() {
+				BeforeEach(func() {
+					hasData = true
+				})
+
+				It("should keep the tenant", func() {
+					Expect(adminClient.Tenants).To(HaveKey("app"))
+					Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(tenant), tenant)).NotTo(HaveOccurred())
+					Expect(tenant.Finalizers).To(ConsistOf(fdbv1beta2.FoundationDBTenantFinalizer))
+
+					condition := meta.FindStatusCondition(tenant.Status.Conditions, fdbv1beta2.TenantConditionReconciled)
+					Expect(condition).NotTo(BeNil())
+					Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+					Expect(condition.Message).To(Equal("Tenant app contains data and will not be deleted"))
+				})
+
+				When("the deletion is forced", func() {
+					BeforeEach(func() {
+						forceDeletion = true
+					})
+
+					It("should delete the tenant in the database", func() {
+						Expect(adminClient.Tenants).NotTo(HaveKey("app"))
+						err := k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(tenant), tenant)
+						Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+					})
+				})
+			})
+		})
+	})
+
+	When("the admin client is used in dry-run mode", func() {
+		var recorder *dryRunRecorder
+
+		JustBeforeEach(func() {
+			recorder = newDryRunRecorder(cluster)
+			dryRunAdminClient, err := clusterReconciler.newDryRunReconciler(recorder).DatabaseClientProvider.GetAdminClient(cluster, k8sClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(dryRunAdminClient.CreateTenant("other")).NotTo(HaveOccurred())
+			Expect(dryRunAdminClient.DeleteTenant("app", true)).NotTo(HaveOccurred())
+		})
+
+		It("should only record the tenant changes", func() {
+			Expect(adminClient.Tenants).To(HaveKey("app"))
+			Expect(adminClient.Tenants).NotTo(HaveKey("other"))
+			Expect(recorder.getPlan(cluster.ObjectMeta.Generation).Actions).To(ConsistOf(
+				fdbv1beta2.PlannedAction{
+					Type:   fdbv1beta2.PlannedActionCreateTenant,
+					Target: "other",
+				},
+				fdbv1beta2.PlannedAction{
+					Type:    fdbv1beta2.PlannedActionDeleteTenant,
+					Target:  "app",
+					Message: "clear all keys of the tenant",
+				},
+			))
+		})
+	})
+
+	When("tenants are disabled on the cluster", func() {
+		BeforeEach(func() {
+			cluster.Spec.DatabaseConfiguration.TenantMode = nil
+		})
+
+		It("should not create the tenant", func() {
+			Expect(adminClient.Tenants).To(BeEmpty())
+			Expect(tenant.Status.Created).To(BeFalse())
+
+			condition := meta.FindStatusCondition(tenant.Status.Conditions, fdbv1beta2.TenantConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("tenants are disabled on cluster " + cluster.Name))
+		})
+	})
+})
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
 )
 
 // toggleBackupPaused provides a reconciliation step for pausing an unpausing
@@ -31,7 +32,7 @@ import (
 type toggleBackupPaused struct{}
 
 // reconcile runs the reconciler's work.
-func (s toggleBackupPaused) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup) *requeue {
+func (s toggleBackupPaused) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, _ logr.Logger) *requeue {
 	if backup.Status.BackupDetails == nil {
 		if backup.ShouldRun() {
 			return &requeue{message: "Cannot toggle backup state because backup is not running"}
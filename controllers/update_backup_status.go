@@ -22,11 +22,13 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
 	"k8s.io/apimachinery/pkg/api/equality"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -36,9 +38,14 @@ import (
 type updateBackupStatus struct{}
 
 // reconcile runs the reconciler's work.
-func (s updateBackupStatus) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup) *requeue {
+func (s updateBackupStatus) reconcile(ctx context.Context, r *FoundationDBBackupReconciler, backup *fdbv1beta2.FoundationDBBackup, _ logr.Logger) *requeue {
 	status := fdbv1beta2.FoundationDBBackupStatus{}
 	status.Generations.Reconciled = backup.Status.Generations.Reconciled
+	status.Conditions = backup.Status.DeepCopy().Conditions
+	status.Expiry = backup.Status.DeepCopy().Expiry
+	if backup.UseAgentAutoscaling() {
+		status.AgentAutoscaling = backup.Status.DeepCopy().AgentAutoscaling
+	}
 
 	backupDeployments := &appsv1.DeploymentList{}
 	err := r.List(ctx, backupDeployments, client.InNamespace(backup.Namespace), client.MatchingLabels(map[string]string{fdbv1beta2.BackupDeploymentLabel: string(backup.ObjectMeta.UID)}))
@@ -94,6 +101,18 @@ func (s updateBackupStatus) reconcile(ctx context.Context, r *FoundationDBBackup
 		SnapshotPeriodSeconds: liveStatus.SnapshotIntervalSeconds,
 	}
 
+	runningMessage := "The backup is not running"
+	if liveStatus.Status.Running {
+		runningMessage = fmt.Sprintf("The backup is running and writing to %s", liveStatus.DestinationURL)
+	}
+	setStatusCondition(&status.Conditions, newStatusCondition(fdbv1beta2.BackupConditionRunning, backup.ObjectMeta.Generation, liveStatus.Status.Running, fdbv1beta2.ConditionReasonBackupRunning, fdbv1beta2.ConditionReasonBackupNotRunning, runningMessage))
+
+	pausedMessage := "The backup agents are not paused"
+	if liveStatus.BackupAgentsPaused {
+		pausedMessage = "The backup agents are paused"
+	}
+	setStatusCondition(&status.Conditions, newStatusCondition(fdbv1beta2.BackupConditionPaused, backup.ObjectMeta.Generation, liveStatus.BackupAgentsPaused, fdbv1beta2.ConditionReasonBackupPaused, fdbv1beta2.ConditionReasonBackupNotPaused, pausedMessage))
+
 	originalStatus := backup.Status.DeepCopy()
 
 	backup.Status = status
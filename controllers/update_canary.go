@@ -0,0 +1,305 @@
+/*
+ * update_canary.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbstatus"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// updateCanary provides a reconciliation step for rolling out changes to a subset of process groups first. The
+// update sub-reconcilers will only update the canary process groups until the canary rollout is promoted.
+type updateCanary struct{}
+
+// reconcile runs the reconciler's work.
+func (updateCanary) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, status *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	originalCanary := cluster.Status.Canary.DeepCopy()
+
+	// Version incompatible upgrades require all processes to be restarted at the same time, so they can't make use
+	// of a canary rollout.
+	if !cluster.UseCanaryRollouts() || cluster.IsBeingUpgradedWithVersionIncompatibleVersion() || !hasPendingCanaryRollout(cluster) {
+		if originalCanary == nil && meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionCanaryHalted) == nil {
+			return nil
+		}
+
+		cluster.Status.Canary = nil
+		meta.RemoveStatusCondition(&cluster.Status.Conditions, fdbv1beta2.ClusterConditionCanaryHalted)
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		return nil
+	}
+
+	if originalCanary == nil || originalCanary.Generation != cluster.ObjectMeta.Generation {
+		processGroups := selectCanaryProcessGroups(cluster)
+		cluster.Status.Canary = &fdbv1beta2.CanaryStatus{
+			Generation:    cluster.ObjectMeta.Generation,
+			Phase:         fdbv1beta2.CanaryPhaseUpdating,
+			ProcessGroups: processGroups,
+		}
+
+		logger.Info("Starting canary rollout", "generation", cluster.ObjectMeta.Generation, "processGroups", processGroups)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, "CanaryStarted", fmt.Sprintf("Rolling out generation %d to canary process groups: %v", cluster.ObjectMeta.Generation, processGroups))
+	}
+
+	canary := cluster.Status.Canary
+	hasDesiredFaultTolerance := true
+	if canary.Phase == fdbv1beta2.CanaryPhaseSoaking {
+		// If the status is not cached, we have to fetch it.
+		if status == nil {
+			adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+			if err != nil {
+				return &requeue{curError: err}
+			}
+			defer adminClient.Close()
+
+			status, err = adminClient.GetStatus()
+			if err != nil {
+				return &requeue{curError: err}
+			}
+		}
+
+		hasDesiredFaultTolerance = fdbstatus.HasDesiredFaultToleranceFromStatus(logger, status, cluster)
+	}
+
+	previousPhase := canary.Phase
+	result := progressCanary(cluster, canary, hasDesiredFaultTolerance, time.Now())
+	if previousPhase != canary.Phase {
+		logger.Info("Canary rollout changed phase", "previousPhase", previousPhase, "phase", canary.Phase, "message", canary.Message)
+
+		switch canary.Phase {
+		case fdbv1beta2.CanaryPhaseHalted:
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, "CanaryHalted", canary.Message)
+		case fdbv1beta2.CanaryPhasePromoted:
+			r.Recorder.Event(cluster, corev1.EventTypeNormal, "CanaryPromoted", canary.Message)
+		}
+	}
+
+	conditionChanged := setStatusCondition(&cluster.Status.Conditions, getCanaryHaltedCondition(cluster.ObjectMeta.Generation, canary))
+	if conditionChanged || !equality.Semantic.DeepEqual(originalCanary, canary) {
+		err := r.updateOrApply(ctx, cluster)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	return result
+}
+
+// hasPendingCanaryRollout returns true if the cluster has changes that are not yet rolled out to all process groups
+// or if the canary rollout for the current generation is not yet promoted.
+func hasPendingCanaryRollout(cluster *fdbv1beta2.FoundationDBCluster) bool {
+	canary := cluster.Status.Canary
+	if canary != nil && canary.Generation == cluster.ObjectMeta.Generation && canary.Phase != fdbv1beta2.CanaryPhasePromoted {
+		return true
+	}
+
+	if cluster.IsBeingUpgraded() {
+		return true
+	}
+
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if needsCanaryUpdate(cluster, processGroup) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// needsCanaryUpdate returns true if the process group must be updated to the current spec or version.
+func needsCanaryUpdate(cluster *fdbv1beta2.FoundationDBCluster, processGroup *fdbv1beta2.ProcessGroupStatus) bool {
+	if processGroup.IsMarkedForRemoval() || processGroup.ProcessClass == fdbv1beta2.ProcessClassTest {
+		return false
+	}
+
+	if cluster.IsBeingUpgraded() {
+		return true
+	}
+
+	return processGroup.GetConditionTime(fdbv1beta2.IncorrectPodSpec) != nil || processGroup.GetConditionTime(fdbv1beta2.IncorrectCommandLine) != nil
+}
+
+// selectCanaryProcessGroups selects the process groups that will be updated first. For every process class either the
+// configured number of process groups or all process groups of one fault domain will be selected.
+func selectCanaryProcessGroups(cluster *fdbv1beta2.FoundationDBCluster) []fdbv1beta2.ProcessGroupID {
+	candidates := map[fdbv1beta2.ProcessClass][]*fdbv1beta2.ProcessGroupStatus{}
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if !needsCanaryUpdate(cluster, processGroup) {
+			continue
+		}
+
+		candidates[processGroup.ProcessClass] = append(candidates[processGroup.ProcessClass], processGroup)
+	}
+
+	mode := cluster.GetCanaryMode()
+	count := cluster.GetCanaryProcessGroupCount()
+	canaries := make([]fdbv1beta2.ProcessGroupID, 0)
+	for _, processGroups := range candidates {
+		sort.Slice(processGroups, func(i, j int) bool {
+			return processGroups[i].ProcessGroupID < processGroups[j].ProcessGroupID
+		})
+
+		if mode == fdbv1beta2.CanaryModeFaultDomain {
+			faultDomain := processGroups[0].FaultDomain
+			for _, processGroup := range processGroups {
+				if processGroup.FaultDomain == faultDomain {
+					canaries = append(canaries, processGroup.ProcessGroupID)
+				}
+			}
+
+			continue
+		}
+
+		for idx, processGroup := range processGroups {
+			if idx >= count {
+				break
+			}
+
+			canaries = append(canaries, processGroup.ProcessGroupID)
+		}
+	}
+
+	sort.Slice(canaries, func(i, j int) bool {
+		return canaries[i] < canaries[j]
+	})
+
+	return canaries
+}
+
+// progressCanary moves the canary rollout to the next phase if the requirements of the current phase are met. The
+// returned requeue is nil if the canary rollout is promoted.
+func progressCanary(cluster *fdbv1beta2.FoundationDBCluster, canary *fdbv1beta2.CanaryStatus, hasDesiredFaultTolerance bool, now time.Time) *requeue {
+	processGroups := make(map[fdbv1beta2.ProcessGroupID]*fdbv1beta2.ProcessGroupStatus, len(cluster.Status.ProcessGroups))
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		processGroups[processGroup.ProcessGroupID] = processGroup
+	}
+
+	switch canary.Phase {
+	case fdbv1beta2.CanaryPhaseUpdating:
+		var pending int
+		for _, processGroupID := range canary.ProcessGroups {
+			processGroup, ok := processGroups[processGroupID]
+			if !ok || processGroup.IsMarkedForRemoval() {
+				continue
+			}
+
+			// The IncorrectCommandLine condition is also set during version compatible upgrades, so we don't have to
+			// check the running version, which will only be updated once all processes are upgraded.
+			if processGroup.GetConditionTime(fdbv1beta2.IncorrectPodSpec) != nil || processGroup.GetConditionTime(fdbv1beta2.IncorrectCommandLine) != nil || processGroup.HasFailureCondition() {
+				pending++
+			}
+		}
+
+		if pending > 0 {
+			canary.Message = fmt.Sprintf("Waiting for %d canary process groups to be updated", pending)
+			return &requeue{message: canary.Message, delayedRequeue: true}
+		}
+
+		canary.Phase = fdbv1beta2.CanaryPhaseSoaking
+		canary.SoakStartTime = &metav1.Time{Time: now}
+		canary.Message = fmt.Sprintf("Canary process groups are updated, soaking for %d seconds", cluster.GetCanarySoakTimeSeconds())
+
+		return &requeue{message: canary.Message, delayedRequeue: true}
+	case fdbv1beta2.CanaryPhaseSoaking:
+		var unhealthy []fdbv1beta2.ProcessGroupID
+		for _, processGroupID := range canary.ProcessGroups {
+			processGroup, ok := processGroups[processGroupID]
+			if !ok || processGroup.IsMarkedForRemoval() {
+				continue
+			}
+
+			if processGroup.HasFailureCondition() {
+				unhealthy = append(unhealthy, processGroupID)
+			}
+		}
+
+		if len(unhealthy) > 0 {
+			canary.Phase = fdbv1beta2.CanaryPhaseHalted
+			canary.Message = fmt.Sprintf("Canary process groups are unhealthy: %v", unhealthy)
+			return &requeue{message: canary.Message, delayedRequeue: true}
+		}
+
+		if !hasDesiredFaultTolerance {
+			canary.Phase = fdbv1beta2.CanaryPhaseHalted
+			canary.Message = "Cluster lost the desired fault tolerance during the canary soak time"
+			return &requeue{message: canary.Message, delayedRequeue: true}
+		}
+
+		var soakStart time.Time
+		if canary.SoakStartTime != nil {
+			soakStart = canary.SoakStartTime.Time
+		}
+
+		remaining := soakStart.Add(time.Duration(cluster.GetCanarySoakTimeSeconds()) * time.Second).Sub(now)
+		if remaining > 0 {
+			canary.Message = fmt.Sprintf("Canary process groups are updated, soaking for %d seconds", cluster.GetCanarySoakTimeSeconds())
+			return &requeue{message: fmt.Sprintf("Canary process groups are soaking, %s remaining", remaining.Round(time.Second)), delayedRequeue: true}
+		}
+
+		if cluster.UseManualCanaryPromotion() && !cluster.IsCanaryPromotionRequested() {
+			canary.Phase = fdbv1beta2.CanaryPhaseAwaitingPromotion
+			canary.Message = fmt.Sprintf("Canary process groups passed the soak time, set the %s annotation to %d to promote the rollout", fdbv1beta2.CanaryPromotionAnnotation, canary.Generation)
+			return &requeue{message: canary.Message, delayedRequeue: true}
+		}
+
+		canary.Phase = fdbv1beta2.CanaryPhasePromoted
+		canary.Message = fmt.Sprintf("Canary process groups passed the soak time, rolling out generation %d to all process groups", canary.Generation)
+		return nil
+	case fdbv1beta2.CanaryPhaseAwaitingPromotion, fdbv1beta2.CanaryPhaseHalted:
+		if !cluster.IsCanaryPromotionRequested() {
+			return &requeue{message: canary.Message, delayedRequeue: true}
+		}
+
+		canary.Phase = fdbv1beta2.CanaryPhasePromoted
+		canary.Message = fmt.Sprintf("Canary rollout was promoted manually, rolling out generation %d to all process groups", canary.Generation)
+		return nil
+	}
+
+	return nil
+}
+
+// getCanaryHaltedCondition returns the CanaryHalted condition for the provided canary rollout.
+func getCanaryHaltedCondition(generation int64, canary *fdbv1beta2.CanaryStatus) metav1.Condition {
+	reason := fdbv1beta2.ConditionReasonCanaryProgressing
+	switch canary.Phase {
+	case fdbv1beta2.CanaryPhaseHalted:
+		reason = fdbv1beta2.ConditionReasonCanaryUnhealthy
+	case fdbv1beta2.CanaryPhaseAwaitingPromotion:
+		reason = fdbv1beta2.ConditionReasonCanaryAwaitingPromotion
+	}
+
+	halted := reason != fdbv1beta2.ConditionReasonCanaryProgressing
+
+	return newStatusCondition(fdbv1beta2.ClusterConditionCanaryHalted, generation, halted, reason, fdbv1beta2.ConditionReasonCanaryProgressing, canary.Message)
+}
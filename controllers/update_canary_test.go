@@ -0,0 +1,251 @@
+/*
+ * update_canary_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("update_canary", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.ObjectMeta.Generation = 2
+		cluster.Spec.AutomationOptions.CanaryOptions.Enabled = pointer.Bool(true)
+		cluster.Status.ProcessGroups = []*fdbv1beta2.ProcessGroupStatus{
+			fdbv1beta2.NewProcessGroupStatus("storage-1", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.1"}),
+			fdbv1beta2.NewProcessGroupStatus("storage-2", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.2"}),
+			fdbv1beta2.NewProcessGroupStatus("storage-3", fdbv1beta2.ProcessClassStorage, []string{"1.1.1.3"}),
+			fdbv1beta2.NewProcessGroupStatus("log-1", fdbv1beta2.ProcessClassLog, []string{"1.1.1.4"}),
+			fdbv1beta2.NewProcessGroupStatus("log-2", fdbv1beta2.ProcessClassLog, []string{"1.1.1.5"}),
+		}
+
+		for idx, processGroup := range cluster.Status.ProcessGroups {
+			// Remove the initial conditions to represent healthy process groups.
+			processGroup.ProcessGroupConditions = nil
+			processGroup.FaultDomain = fdbv1beta2.FaultDomain([]string{"zone-a", "zone-b"}[idx%2])
+			processGroup.UpdateCondition(fdbv1beta2.IncorrectPodSpec, true)
+		}
+	})
+
+	When("selecting the canary process groups", func() {
+		var canaries []fdbv1beta2.ProcessGroupID
+
+		JustBeforeEach(func() {
+			canaries = selectCanaryProcessGroups(cluster)
+		})
+
+		It("should select one process group per process class", func() {
+			Expect(canaries).To(ConsistOf(fdbv1beta2.ProcessGroupID("log-1"), fdbv1beta2.ProcessGroupID("storage-1")))
+		})
+
+		When("two process groups per process class should be selected", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.CanaryOptions.ProcessGroupCount = pointer.Int(2)
+			})
+
+			It("should select two process groups per process class", func() {
+				Expect(canaries).To(ConsistOf(
+					fdbv1beta2.ProcessGroupID("log-1"),
+					fdbv1beta2.ProcessGroupID("log-2"),
+					fdbv1beta2.ProcessGroupID("storage-1"),
+					fdbv1beta2.ProcessGroupID("storage-2"),
+				))
+			})
+		})
+
+		When("one fault domain per process class should be selected", func() {
+			BeforeEach(func() {
+				cluster.Spec.AutomationOptions.CanaryOptions.Mode = fdbv1beta2.CanaryModeFaultDomain
+			})
+
+			It("should select all process groups of the first fault domain", func() {
+				Expect(canaries).To(ConsistOf(
+					fdbv1beta2.ProcessGroupID("log-1"),
+					fdbv1beta2.ProcessGroupID("storage-1"),
+					fdbv1beta2.ProcessGroupID("storage-3"),
+				))
+			})
+		})
+
+		When("a process group is already updated", func() {
+			BeforeEach(func() {
+				cluster.Status.ProcessGroups[0].UpdateCondition(fdbv1beta2.IncorrectPodSpec, false)
+			})
+
+			It("should select a process group that must be updated", func() {
+				Expect(canaries).To(ConsistOf(fdbv1beta2.ProcessGroupID("log-1"), fdbv1beta2.ProcessGroupID("storage-2")))
+			})
+		})
+	})
+
+	When("progressing the canary rollout", func() {
+		var canary *fdbv1beta2.CanaryStatus
+		var hasDesiredFaultTolerance bool
+		var now time.Time
+		var result *requeue
+
+		BeforeEach(func() {
+			hasDesiredFaultTolerance = true
+			now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+			canary = &fdbv1beta2.CanaryStatus{
+				Generation:    2,
+				Phase:         fdbv1beta2.CanaryPhaseUpdating,
+				ProcessGroups: []fdbv1beta2.ProcessGroupID{"storage-1"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			cluster.Status.Canary = canary
+			result = progressCanary(cluster, canary, hasDesiredFaultTolerance, now)
+		})
+
+		When("the canary process groups are not yet updated", func() {
+			It("should wait for the update", func() {
+				Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseUpdating))
+				Expect(result).NotTo(BeNil())
+				Expect(result.delayedRequeue).To(BeTrue())
+				Expect(result.message).To(Equal("Waiting for 1 canary process groups to be updated"))
+			})
+		})
+
+		When("the canary process groups are updated", func() {
+			BeforeEach(func() {
+				cluster.Status.ProcessGroups[0].UpdateCondition(fdbv1beta2.IncorrectPodSpec, false)
+			})
+
+			It("should start the soak time", func() {
+				Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseSoaking))
+				Expect(canary.SoakStartTime).NotTo(BeNil())
+				Expect(canary.SoakStartTime.Time).To(BeTemporally("==", now))
+				Expect(result).NotTo(BeNil())
+				Expect(result.delayedRequeue).To(BeTrue())
+			})
+		})
+
+		When("the canary process groups are soaking", func() {
+			BeforeEach(func() {
+				cluster.Status.ProcessGroups[0].UpdateCondition(fdbv1beta2.IncorrectPodSpec, false)
+				canary.Phase = fdbv1beta2.CanaryPhaseSoaking
+				canary.SoakStartTime = &metav1.Time{Time: now.Add(-5 * time.Minute)}
+			})
+
+			It("should wait until the soak time has passed", func() {
+				Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseSoaking))
+				Expect(result).NotTo(BeNil())
+				Expect(result.message).To(Equal("Canary process groups are soaking, 5m0s remaining"))
+			})
+
+			When("the cluster lost the desired fault tolerance", func() {
+				BeforeEach(func() {
+					hasDesiredFaultTolerance = false
+				})
+
+				It("should halt the canary rollout", func() {
+					Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseHalted))
+					Expect(canary.Message).To(Equal("Cluster lost the desired fault tolerance during the canary soak time"))
+					Expect(result).NotTo(BeNil())
+				})
+			})
+
+			When("a canary process group has failed", func() {
+				BeforeEach(func() {
+					cluster.Status.ProcessGroups[0].UpdateCondition(fdbv1beta2.MissingProcesses, true)
+				})
+
+				It("should halt the canary rollout", func() {
+					Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseHalted))
+					Expect(canary.Message).To(Equal("Canary process groups are unhealthy: [storage-1]"))
+				})
+			})
+
+			When("the soak time has passed", func() {
+				BeforeEach(func() {
+					canary.SoakStartTime = &metav1.Time{Time: now.Add(-11 * time.Minute)}
+				})
+
+				It("should promote the canary rollout", func() {
+					Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhasePromoted))
+					Expect(result).To(BeNil())
+				})
+
+				When("manual promotion is required", func() {
+					BeforeEach(func() {
+						cluster.Spec.AutomationOptions.CanaryOptions.ManualPromotion = pointer.Bool(true)
+					})
+
+					It("should wait for the promotion", func() {
+						Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseAwaitingPromotion))
+						Expect(canary.Message).To(Equal("Canary process groups passed the soak time, set the foundationdb.org/promote-canary annotation to 2 to promote the rollout"))
+						Expect(result).NotTo(BeNil())
+						Expect(getCanaryHaltedCondition(cluster.ObjectMeta.Generation, canary).Reason).To(Equal(fdbv1beta2.ConditionReasonCanaryAwaitingPromotion))
+					})
+				})
+			})
+		})
+
+		When("the canary rollout is halted", func() {
+			BeforeEach(func() {
+				canary.Phase = fdbv1beta2.CanaryPhaseHalted
+			})
+
+			It("should stay halted", func() {
+				Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseHalted))
+				Expect(result).NotTo(BeNil())
+				condition := getCanaryHaltedCondition(cluster.ObjectMeta.Generation, canary)
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(fdbv1beta2.ConditionReasonCanaryUnhealthy))
+			})
+
+			When("the promotion annotation is set for a different generation", func() {
+				BeforeEach(func() {
+					cluster.Annotations = map[string]string{fdbv1beta2.CanaryPromotionAnnotation: "1"}
+				})
+
+				It("should stay halted", func() {
+					Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhaseHalted))
+				})
+			})
+
+			When("the promotion annotation is set for the current generation", func() {
+				BeforeEach(func() {
+					cluster.Annotations = map[string]string{fdbv1beta2.CanaryPromotionAnnotation: "2"}
+				})
+
+				It("should promote the canary rollout", func() {
+					Expect(canary.Phase).To(Equal(fdbv1beta2.CanaryPhasePromoted))
+					Expect(result).To(BeNil())
+					condition := getCanaryHaltedCondition(cluster.ObjectMeta.Generation, canary)
+					Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+					Expect(condition.Reason).To(Equal(fdbv1beta2.ConditionReasonCanaryProgressing))
+				})
+			})
+		})
+	})
+})
@@ -68,11 +68,13 @@ func (u updateDatabaseConfiguration) reconcile(_ context.Context, r *FoundationD
 
 	desiredConfiguration := cluster.DesiredDatabaseConfiguration()
 	desiredConfiguration.RoleCounts.Storage = 0
-	currentConfiguration := status.Cluster.DatabaseConfiguration.NormalizeConfigurationWithSeparatedProxies(cluster.Spec.Version, cluster.Spec.DatabaseConfiguration.AreSeparatedProxiesConfigured())
+	currentConfiguration := status.Cluster.DatabaseConfiguration.NormalizeConfigurationWithSeparatedProxies(cluster.GetDesiredVersion(), cluster.Spec.DatabaseConfiguration.AreSeparatedProxiesConfigured())
 	// We have to reset the excluded servers here otherwise we will trigger a reconfiguration if one or more servers
 	// are excluded.
 	currentConfiguration.ExcludedServers = nil
 	cluster.ClearMissingVersionFlags(&currentConfiguration)
+	cluster.ClearMissingStorageWiggleSettings(&currentConfiguration)
+	cluster.ClearMissingTenantMode(&currentConfiguration)
 
 	runningVersion, err := fdbv1beta2.ParseFdbVersion(cluster.GetRunningVersion())
 	if err != nil {
@@ -86,7 +88,7 @@ func (u updateDatabaseConfiguration) reconcile(_ context.Context, r *FoundationD
 		} else {
 			nextConfiguration = currentConfiguration.GetNextConfigurationChange(desiredConfiguration)
 		}
-		configurationString, _ := nextConfiguration.GetConfigurationString(cluster.Spec.Version)
+		configurationString, _ := nextConfiguration.GetConfigurationString(cluster.GetDesiredVersion())
 
 		if !initialConfig {
 			err = fdbstatus.ConfigurationChangeAllowed(status, runningVersion.SupportsRecoveryState() && r.EnableRecoveryState)
@@ -110,7 +112,7 @@ func (u updateDatabaseConfiguration) reconcile(_ context.Context, r *FoundationD
 		r.Recorder.Event(cluster, corev1.EventTypeNormal, "ConfiguringDatabase",
 			fmt.Sprintf("Setting database configuration to `%s`", configurationString),
 		)
-		err = adminClient.ConfigureDatabase(nextConfiguration, initialConfig, cluster.Spec.Version)
+		err = adminClient.ConfigureDatabase(nextConfiguration, initialConfig, cluster.GetDesiredVersion())
 		if err != nil {
 			return &requeue{curError: err, delayedRequeue: true}
 		}
@@ -0,0 +1,127 @@
+/*
+ * update_disaster_recovery_agents.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// updateDisasterRecoveryAgents provides a reconciliation step for updating the
+// config map and the deployment for the DR agents.
+type updateDisasterRecoveryAgents struct{}
+
+// reconcile runs the reconciler's work.
+func (u updateDisasterRecoveryAgents) reconcile(ctx context.Context, r *FoundationDBDisasterRecoveryReconciler, dr *fdbv1beta2.FoundationDBDisasterRecovery) *requeue {
+	logger := globalControllerLogger.WithValues("namespace", dr.Namespace, "disasterRecovery", dr.Name, "reconciler", "updateDisasterRecoveryAgents")
+
+	source, destination, err := r.getReplicationClusters(ctx, dr)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if source.Status.ConnectionString == "" || destination.Status.ConnectionString == "" {
+		return &requeue{message: "Cannot configure the DR agents because the connection strings of the clusters are not known yet", delay: disasterRecoveryStatusRequeueDelay}
+	}
+
+	configMap := internal.GetDisasterRecoveryConfigMap(dr, source.Status.ConnectionString, destination.Status.ConnectionString)
+	existingConfigMap := &corev1.ConfigMap{}
+	err = r.Get(ctx, client.ObjectKeyFromObject(configMap), existingConfigMap)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return &requeue{curError: err}
+		}
+
+		logger.V(1).Info("Creating config map", "name", configMap.Name)
+		err = r.Create(ctx, configMap)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	} else if !equality.Semantic.DeepEqual(existingConfigMap.Data, configMap.Data) {
+		logger.Info("Updating config map", "name", configMap.Name)
+		existingConfigMap.Data = configMap.Data
+		err = r.Update(ctx, existingConfigMap)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	deploymentName := fmt.Sprintf("%s-dr-agents", dr.ObjectMeta.Name)
+	existingDeployment := &appsv1.Deployment{}
+	needCreation := false
+
+	err = r.Get(ctx, client.ObjectKey{Name: deploymentName, Namespace: dr.Namespace}, existingDeployment)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			needCreation = true
+		} else {
+			return &requeue{curError: err}
+		}
+	}
+
+	deployment, err := internal.GetDisasterRecoveryDeployment(dr, configMap)
+	if err != nil {
+		r.Recorder.Event(dr, corev1.EventTypeWarning, "GetDisasterRecoveryDeployment", err.Error())
+		return &requeue{curError: err}
+	}
+
+	if deployment != nil && deployment.ObjectMeta.Name != deploymentName {
+		return &requeue{curError: fmt.Errorf("inconsistent deployment names: %s != %s", deployment.ObjectMeta.Name, deploymentName)}
+	}
+
+	if needCreation && deployment != nil {
+		logger.V(1).Info("Creating deployment", "name", deployment.Name)
+		err = r.Create(ctx, deployment)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+	if !needCreation && deployment != nil {
+		annotationChange := mergeAnnotations(&existingDeployment.ObjectMeta, deployment.ObjectMeta)
+		deployment.ObjectMeta.Annotations = existingDeployment.ObjectMeta.Annotations
+
+		if annotationChange || !reflect.DeepEqual(existingDeployment.ObjectMeta.Labels, deployment.ObjectMeta.Labels) {
+			err = r.Update(ctx, deployment)
+			if err != nil {
+				return &requeue{curError: err}
+			}
+		}
+	}
+
+	if !needCreation && deployment == nil {
+		logger.V(1).Info("Deleting deployment", "name", existingDeployment.Name)
+		err = r.Delete(ctx, existingDeployment)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	return nil
+}
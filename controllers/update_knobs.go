@@ -0,0 +1,317 @@
+/*
+ * update_knobs.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// updateKnobs provides a reconciliation step for applying the live-updatable knobs through the configuration database.
+// Knobs that require a restart are passed as command line arguments and will be rolled out by the bounceProcesses
+// sub-reconciler. As long as the processes are not started with the configuration database all knobs require a
+// restart, so only the knobs that are still recorded as live updated in the status will be cleared.
+type updateKnobs struct{}
+
+// reconcile runs the reconciler's work.
+func (u updateKnobs) reconcile(ctx context.Context, r *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, _ *fdbv1beta2.FoundationDBStatus, logger logr.Logger) *requeue {
+	if !cluster.Status.Configured {
+		return nil
+	}
+
+	runningVersion, err := fdbv1beta2.ParseFdbVersion(cluster.GetRunningVersion())
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	// Without the configuration database all knobs are passed as command line arguments.
+	if !runningVersion.SupportsDynamicKnobs() {
+		return nil
+	}
+
+	originalKnobs := make([]fdbv1beta2.ProcessClassKnobStatus, len(cluster.Status.Knobs))
+	for idx, knobStatus := range cluster.Status.Knobs {
+		knobStatus.DeepCopyInto(&originalKnobs[idx])
+	}
+
+	adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+	if err != nil {
+		return &requeue{curError: err, delayedRequeue: true}
+	}
+	defer adminClient.Close()
+
+	for _, processClass := range getProcessClassesInUse(cluster) {
+		liveKnobs, _ := fdbv1beta2.SplitKnobsByRestart(cluster.GetProcessSettings(processClass).Knobs, runningVersion)
+		currentKnobs := getLiveKnobs(cluster.Status.Knobs, processClass)
+
+		desiredNames := make(map[string]fdbv1beta2.None, len(liveKnobs))
+		changedKnobs := make([]fdbv1beta2.FoundationDBKnob, 0, len(liveKnobs))
+		for _, knob := range liveKnobs {
+			desiredNames[knob.Name] = fdbv1beta2.None{}
+			if value, ok := currentKnobs[knob.Name]; ok && value == knob.Value {
+				continue
+			}
+
+			changedKnobs = append(changedKnobs, knob)
+		}
+
+		removedKnobs := make([]string, 0)
+		for name := range currentKnobs {
+			if _, ok := desiredNames[name]; !ok {
+				removedKnobs = append(removedKnobs, name)
+			}
+		}
+		sort.Strings(removedKnobs)
+
+		if len(changedKnobs) == 0 && len(removedKnobs) == 0 {
+			continue
+		}
+
+		configClass := string(processClass)
+		logger.Info("Updating knobs", "processClass", processClass, "changedKnobs", changedKnobs, "removedKnobs", removedKnobs)
+
+		err = adminClient.SetServerKnobs(configClass, changedKnobs)
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+
+		err = adminClient.ClearServerKnobs(configClass, removedKnobs)
+		if err != nil {
+			return &requeue{curError: err, delayedRequeue: true}
+		}
+
+		setLiveKnobs(cluster, processClass, liveKnobs)
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, "UpdatedKnobs", fmt.Sprintf("Updated %d and removed %d knobs for process class %s without a restart", len(changedKnobs), len(removedKnobs), processClass))
+	}
+
+	if equality.Semantic.DeepEqual(originalKnobs, cluster.Status.Knobs) {
+		return nil
+	}
+
+	err = r.updateOrApply(ctx, cluster)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
+
+// getProcessClassesInUse returns the sorted process classes of all process groups that are not marked for removal.
+func getProcessClassesInUse(cluster *fdbv1beta2.FoundationDBCluster) []fdbv1beta2.ProcessClass {
+	processClasses := map[fdbv1beta2.ProcessClass]fdbv1beta2.None{}
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		processClasses[processGroup.ProcessClass] = fdbv1beta2.None{}
+	}
+
+	result := make([]fdbv1beta2.ProcessClass, 0, len(processClasses))
+	for processClass := range processClasses {
+		result = append(result, processClass)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// getLiveKnobs returns the knobs that were applied through the configuration database for the provided process class.
+func getLiveKnobs(knobStatus []fdbv1beta2.ProcessClassKnobStatus, processClass fdbv1beta2.ProcessClass) map[string]string {
+	knobs := map[string]string{}
+	for _, classStatus := range knobStatus {
+		if classStatus.ProcessClass != processClass {
+			continue
+		}
+
+		for _, knob := range classStatus.Knobs {
+			if knob.LiveUpdated {
+				knobs[knob.Name] = knob.Value
+			}
+		}
+	}
+
+	return knobs
+}
+
+// setLiveKnobs replaces the knobs that were applied through the configuration database for the provided process class
+// in the status of the cluster.
+func setLiveKnobs(cluster *fdbv1beta2.FoundationDBCluster, processClass fdbv1beta2.ProcessClass, liveKnobs []fdbv1beta2.FoundationDBKnob) {
+	var classStatus *fdbv1beta2.ProcessClassKnobStatus
+	for idx := range cluster.Status.Knobs {
+		if cluster.Status.Knobs[idx].ProcessClass == processClass {
+			classStatus = &cluster.Status.Knobs[idx]
+			break
+		}
+	}
+
+	if classStatus == nil {
+		cluster.Status.Knobs = append(cluster.Status.Knobs, fdbv1beta2.ProcessClassKnobStatus{ProcessClass: processClass})
+		classStatus = &cluster.Status.Knobs[len(cluster.Status.Knobs)-1]
+	}
+
+	knobs := make([]fdbv1beta2.FoundationDBKnobStatus, 0, len(classStatus.Knobs)+len(liveKnobs))
+	for _, knob := range classStatus.Knobs {
+		if !knob.LiveUpdated {
+			knobs = append(knobs, knob)
+		}
+	}
+
+	for _, knob := range liveKnobs {
+		knobs = append(knobs, fdbv1beta2.FoundationDBKnobStatus{Name: knob.Name, Value: knob.Value, LiveUpdated: true})
+	}
+
+	classStatus.Knobs = knobs
+	normalizeKnobStatus(&cluster.Status.Knobs)
+}
+
+// normalizeKnobStatus sorts the knob status and removes the process classes without knobs to prevent a reordering from
+// issuing a new reconcile loop.
+func normalizeKnobStatus(knobStatus *[]fdbv1beta2.ProcessClassKnobStatus) {
+	result := make([]fdbv1beta2.ProcessClassKnobStatus, 0, len(*knobStatus))
+	for _, classStatus := range *knobStatus {
+		if len(classStatus.Knobs) == 0 && len(classStatus.PendingRestart) == 0 {
+			continue
+		}
+
+		sort.Slice(classStatus.Knobs, func(i, j int) bool {
+			return classStatus.Knobs[i].Name < classStatus.Knobs[j].Name
+		})
+		sort.Strings(classStatus.PendingRestart)
+		result = append(result, classStatus)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ProcessClass < result[j].ProcessClass
+	})
+
+	if len(result) == 0 {
+		result = nil
+	}
+
+	*knobStatus = result
+}
+
+// getKnobStatus returns the knobs that are in effect for each process class. Knobs passed as command line arguments are
+// only reported if all processes of the process class use the same value, the knobs applied through the configuration
+// database are taken from the provided previous status.
+func getKnobStatus(cluster *fdbv1beta2.FoundationDBCluster, processMap map[fdbv1beta2.ProcessGroupID][]fdbv1beta2.FoundationDBStatusProcessInfo, previousStatus []fdbv1beta2.ProcessClassKnobStatus) []fdbv1beta2.ProcessClassKnobStatus {
+	commandLineKnobs := map[fdbv1beta2.ProcessClass][]map[string]string{}
+	for _, processes := range processMap {
+		for _, process := range processes {
+			if process.Excluded {
+				continue
+			}
+
+			commandLineKnobs[process.ProcessClass] = append(commandLineKnobs[process.ProcessClass], parseCommandLineKnobs(process.CommandLine))
+		}
+	}
+
+	// Use the same version as the monitor conf to decide which knobs are passed as command line arguments.
+	runningVersion, err := fdbv1beta2.ParseFdbVersion(cluster.GetRunningVersion())
+	if err != nil {
+		runningVersion = fdbv1beta2.Versions.Default
+	}
+
+	knobStatus := make([]fdbv1beta2.ProcessClassKnobStatus, 0, len(commandLineKnobs))
+	for processClass, processKnobs := range commandLineKnobs {
+		classStatus := fdbv1beta2.ProcessClassKnobStatus{ProcessClass: processClass}
+
+		// Only report the knobs that are set with the same value on all processes.
+		for name, value := range processKnobs[0] {
+			effective := true
+			for _, knobs := range processKnobs[1:] {
+				if knobs[name] != value {
+					effective = false
+					break
+				}
+			}
+
+			if effective {
+				classStatus.Knobs = append(classStatus.Knobs, fdbv1beta2.FoundationDBKnobStatus{Name: name, Value: value})
+			}
+		}
+
+		_, restartKnobs := fdbv1beta2.SplitKnobsByRestart(cluster.GetProcessSettings(processClass).Knobs, runningVersion)
+		for _, knob := range restartKnobs {
+			for _, knobs := range processKnobs {
+				if value, ok := knobs[knob.Name]; !ok || value != knob.Value {
+					classStatus.PendingRestart = append(classStatus.PendingRestart, knob.Name)
+					break
+				}
+			}
+		}
+
+		for name, value := range getLiveKnobs(previousStatus, processClass) {
+			classStatus.Knobs = append(classStatus.Knobs, fdbv1beta2.FoundationDBKnobStatus{Name: name, Value: value, LiveUpdated: true})
+		}
+
+		knobStatus = append(knobStatus, classStatus)
+	}
+
+	// Keep the knobs applied through the configuration database for process classes without reporting processes.
+	for _, classStatus := range previousStatus {
+		if _, ok := commandLineKnobs[classStatus.ProcessClass]; ok {
+			continue
+		}
+
+		liveStatus := fdbv1beta2.ProcessClassKnobStatus{ProcessClass: classStatus.ProcessClass}
+		for name, value := range getLiveKnobs(previousStatus, classStatus.ProcessClass) {
+			liveStatus.Knobs = append(liveStatus.Knobs, fdbv1beta2.FoundationDBKnobStatus{Name: name, Value: value, LiveUpdated: true})
+		}
+
+		knobStatus = append(knobStatus, liveStatus)
+	}
+
+	normalizeKnobStatus(&knobStatus)
+
+	return knobStatus
+}
+
+// parseCommandLineKnobs returns the knobs that are passed as arguments in the provided command line of a process, e.g.
+// --knob_max_trace_lines=100000.
+func parseCommandLineKnobs(commandLine string) map[string]string {
+	knobs := map[string]string{}
+	for _, argument := range strings.Fields(commandLine) {
+		if !strings.HasPrefix(argument, "--knob_") {
+			continue
+		}
+
+		name, value, found := strings.Cut(strings.TrimPrefix(argument, "--knob_"), "=")
+		if !found {
+			continue
+		}
+
+		knobs[name] = value
+	}
+
+	return knobs
+}
@@ -0,0 +1,166 @@
+/*
+ * update_knobs_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("update_knobs", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var adminClient *mock.AdminClient
+
+	BeforeEach(func() {
+		cluster = internal.CreateDefaultCluster()
+		cluster.Spec.Version = fdbv1beta2.Versions.SupportsDynamicKnobs.String()
+		cluster.Spec.Processes = map[fdbv1beta2.ProcessClass]fdbv1beta2.ProcessSettings{
+			fdbv1beta2.ProcessClassGeneral: {
+				Knobs: []fdbv1beta2.FoundationDBKnob{
+					{Name: "max_trace_lines", Value: "100000"},
+				},
+			},
+			fdbv1beta2.ProcessClassStorage: {
+				Knobs: []fdbv1beta2.FoundationDBKnob{
+					{Name: "max_trace_lines", Value: "100000"},
+					{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(setupClusterForTest(cluster)).NotTo(HaveOccurred())
+
+		var err error
+		adminClient, err = mock.NewMockAdminClientUncast(cluster, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("the version supports dynamic knobs", func() {
+		It("should pass all knobs as command line arguments", func() {
+			Expect(adminClient.ServerKnobs).To(BeEmpty())
+			Expect(cluster.Status.Knobs).To(ContainElement(fdbv1beta2.ProcessClassKnobStatus{
+				ProcessClass: fdbv1beta2.ProcessClassStorage,
+				Knobs: []fdbv1beta2.FoundationDBKnobStatus{
+					{Name: "max_trace_lines", Value: "100000"},
+					{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+				},
+			}))
+			Expect(cluster.Status.Knobs).To(ContainElement(fdbv1beta2.ProcessClassKnobStatus{
+				ProcessClass: fdbv1beta2.ProcessClassLog,
+				Knobs: []fdbv1beta2.FoundationDBKnobStatus{
+					{Name: "max_trace_lines", Value: "100000"},
+				},
+			}))
+		})
+
+		When("a knob is still recorded as live updated", func() {
+			var req *requeue
+
+			JustBeforeEach(func() {
+				adminClient.ServerKnobs["storage"] = map[string]string{"spring_bytes_storage_server": "100000000"}
+				setLiveKnobs(cluster, fdbv1beta2.ProcessClassStorage, []fdbv1beta2.FoundationDBKnob{
+					{Name: "spring_bytes_storage_server", Value: "100000000"},
+				})
+
+				req = updateKnobs{}.reconcile(context.TODO(), clusterReconciler, cluster, nil, globalControllerLogger)
+			})
+
+			It("should clear the knob from the configuration database", func() {
+				Expect(req).To(BeNil())
+				Expect(adminClient.ServerKnobs).To(BeEmpty())
+				Expect(cluster.Status.Knobs).To(ContainElement(fdbv1beta2.ProcessClassKnobStatus{
+					ProcessClass: fdbv1beta2.ProcessClassStorage,
+					Knobs: []fdbv1beta2.FoundationDBKnobStatus{
+						{Name: "max_trace_lines", Value: "100000"},
+						{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+					},
+				}))
+			})
+		})
+
+		When("a knob is still recorded as live updated in dry-run mode", func() {
+			var req *requeue
+			var recorder *dryRunRecorder
+
+			JustBeforeEach(func() {
+				adminClient.ServerKnobs["storage"] = map[string]string{"spring_bytes_storage_server": "100000000"}
+				setLiveKnobs(cluster, fdbv1beta2.ProcessClassStorage, []fdbv1beta2.FoundationDBKnob{
+					{Name: "spring_bytes_storage_server", Value: "100000000"},
+				})
+
+				recorder = newDryRunRecorder(cluster)
+				recorder.setSubReconciler(updateKnobs{})
+				req = updateKnobs{}.reconcile(context.TODO(), clusterReconciler.newDryRunReconciler(recorder), cluster, nil, globalControllerLogger)
+			})
+
+			It("should not clear the knob from the configuration database", func() {
+				Expect(req).To(BeNil())
+				Expect(adminClient.ServerKnobs).To(Equal(map[string]map[string]string{
+					"storage": {"spring_bytes_storage_server": "100000000"},
+				}))
+			})
+
+			It("should record the knob changes", func() {
+				Expect(recorder.getPlan(cluster.ObjectMeta.Generation).Actions).To(ConsistOf(
+					fdbv1beta2.PlannedAction{
+						SubReconciler: "controllers.updateKnobs",
+						Type:          fdbv1beta2.PlannedActionUpdateKnobs,
+						Target:        "storage",
+						Message:       "clear spring_bytes_storage_server",
+					},
+				))
+			})
+		})
+	})
+
+	When("the version doesn't support dynamic knobs", func() {
+		BeforeEach(func() {
+			cluster.Spec.Version = fdbv1beta2.Versions.Default.String()
+		})
+
+		It("should pass all knobs as command line arguments", func() {
+			Expect(adminClient.ServerKnobs).To(BeEmpty())
+			Expect(cluster.Status.Knobs).To(ContainElement(fdbv1beta2.ProcessClassKnobStatus{
+				ProcessClass: fdbv1beta2.ProcessClassStorage,
+				Knobs: []fdbv1beta2.FoundationDBKnobStatus{
+					{Name: "max_trace_lines", Value: "100000"},
+					{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+				},
+			}))
+		})
+	})
+
+	When("parsing the knobs of a command line", func() {
+		It("should return the knobs", func() {
+			Expect(parseCommandLineKnobs("/usr/bin/fdbserver --class=storage --knob_max_trace_lines=100000 --knob_test=a=b --locality_zoneid=z")).To(Equal(map[string]string{
+				"max_trace_lines": "100000",
+				"test":            "a=b",
+			}))
+		})
+	})
+})
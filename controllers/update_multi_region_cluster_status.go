@@ -0,0 +1,111 @@
+/*
+ * update_multi_region_cluster_status.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// updateMultiRegionClusterStatus provides a reconciliation step for updating the status of a multi-region cluster.
+type updateMultiRegionClusterStatus struct{}
+
+// reconcile runs the reconciler's work.
+func (updateMultiRegionClusterStatus) reconcile(ctx context.Context, r *FoundationDBMultiRegionClusterReconciler, mrc *fdbv1beta2.FoundationDBMultiRegionCluster, logger logr.Logger) *requeue {
+	clusters, err := r.getMultiRegionClusterMembers(ctx, mrc)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	originalStatus := mrc.Status.DeepCopy()
+
+	status := fdbv1beta2.FoundationDBMultiRegionClusterStatus{}
+	status.Generations.Reconciled = mrc.Status.Generations.Reconciled
+	status.Conditions = originalStatus.Conditions
+	status.ConfiguringDataCenter = getConfiguringDataCenter(mrc)
+
+	if configuringCluster, ok := clusters[status.ConfiguringDataCenter]; ok {
+		status.ConnectionString = configuringCluster.Status.ConnectionString
+	}
+
+	mrc.Status = status
+
+	dcIDs := mrc.GetDataCenterIDs()
+	dcStatuses := make([]fdbv1beta2.MultiRegionDataCenterStatus, 0, len(dcIDs))
+	for _, dcID := range dcIDs {
+		dcStatus := fdbv1beta2.MultiRegionDataCenterStatus{
+			ID:          dcID,
+			ClusterName: mrc.GetClusterName(dcID),
+			Namespace:   mrc.GetClusterNamespace(dcID),
+		}
+
+		cluster, ok := clusters[dcID]
+		if ok {
+			desiredCluster, err := getDesiredMultiRegionClusterMember(mrc, dcID, cluster)
+			if err != nil {
+				return &requeue{curError: err}
+			}
+
+			dcStatus.Created = true
+			dcStatus.Reconciled = cluster.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey] == desiredCluster.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey] &&
+				cluster.Status.Generations.Reconciled == cluster.ObjectMeta.Generation
+		}
+
+		dcStatuses = append(dcStatuses, dcStatus)
+	}
+	mrc.Status.DataCenters = dcStatuses
+
+	_, err = mrc.CheckReconciliation()
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if equality.Semantic.DeepEqual(mrc.Status, *originalStatus) {
+		return nil
+	}
+
+	err = r.updateOrApply(ctx, mrc)
+	if err != nil {
+		logger.Error(err, "Error updating multi-region cluster status")
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
+
+// getConfiguringDataCenter returns the data center that is allowed to change the database configuration. The
+// configuring data center is only changed if it's no longer part of the regions, this makes sure that only a single
+// data center changes the database configuration at a time.
+func getConfiguringDataCenter(mrc *fdbv1beta2.FoundationDBMultiRegionCluster) string {
+	current := mrc.Status.ConfiguringDataCenter
+	if current != "" {
+		for _, dcID := range mrc.GetDataCenterIDs() {
+			if dcID == current {
+				return current
+			}
+		}
+	}
+
+	return mrc.GetPrimaryDataCenter()
+}
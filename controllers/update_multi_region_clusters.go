@@ -0,0 +1,104 @@
+/*
+ * update_multi_region_clusters.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// updateMultiRegionClusters provides a reconciliation step for creating and updating the clusters of the data centers
+// of a multi-region cluster.
+type updateMultiRegionClusters struct{}
+
+// reconcile runs the reconciler's work.
+func (updateMultiRegionClusters) reconcile(ctx context.Context, r *FoundationDBMultiRegionClusterReconciler, mrc *fdbv1beta2.FoundationDBMultiRegionCluster, logger logr.Logger) *requeue {
+	clusters, err := r.getMultiRegionClusterMembers(ctx, mrc)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	// The database is created in the configuring data center first, all other data centers will join the cluster
+	// once the database is configured.
+	configuringDataCenter := mrc.Status.ConfiguringDataCenter
+	configuringCluster := clusters[configuringDataCenter]
+	if configuringCluster == nil || !configuringCluster.Status.Configured || configuringCluster.Status.ConnectionString == "" {
+		err = r.createOrUpdateMultiRegionClusterMember(ctx, mrc, configuringDataCenter, configuringCluster, logger)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+
+		return &requeue{message: fmt.Sprintf("Waiting for the database to be configured in data center %s", configuringDataCenter), delay: multiRegionClusterRequeueDelay}
+	}
+
+	for _, dcID := range mrc.GetDataCenterIDs() {
+		err = r.createOrUpdateMultiRegionClusterMember(ctx, mrc, dcID, clusters[dcID], logger)
+		if err != nil {
+			return &requeue{curError: err}
+		}
+	}
+
+	return nil
+}
+
+// createOrUpdateMultiRegionClusterMember creates the cluster of the provided data center or updates the existing
+// cluster if the spec or the metadata is not up-to-date.
+func (r *FoundationDBMultiRegionClusterReconciler) createOrUpdateMultiRegionClusterMember(ctx context.Context, mrc *fdbv1beta2.FoundationDBMultiRegionCluster, dcID string, existing *fdbv1beta2.FoundationDBCluster, logger logr.Logger) error {
+	desiredCluster, err := getDesiredMultiRegionClusterMember(mrc, dcID, existing)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		logger.Info("Creating cluster", "dataCenter", dcID, "cluster", desiredCluster.Name, "clusterNamespace", desiredCluster.Namespace)
+		err = r.Create(ctx, desiredCluster)
+		if err != nil {
+			return err
+		}
+
+		r.Recorder.Event(mrc, corev1.EventTypeNormal, "ClusterCreated", fmt.Sprintf("Created cluster %s/%s for data center %s", desiredCluster.Namespace, desiredCluster.Name, dcID))
+		return nil
+	}
+
+	if existing.ObjectMeta.Labels == nil {
+		existing.ObjectMeta.Labels = map[string]string{}
+	}
+
+	if existing.ObjectMeta.Annotations == nil {
+		existing.ObjectMeta.Annotations = map[string]string{}
+	}
+
+	specChanged := existing.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey] != desiredCluster.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey]
+	annotationChange := mergeAnnotations(&existing.ObjectMeta, desiredCluster.ObjectMeta)
+	labelChange := mergeLabelsInMetadata(&existing.ObjectMeta, desiredCluster.ObjectMeta)
+	if !specChanged && !annotationChange && !labelChange {
+		return nil
+	}
+
+	logger.Info("Updating cluster", "dataCenter", dcID, "cluster", existing.Name, "clusterNamespace", existing.Namespace, "specChanged", specChanged)
+	existing.Spec = desiredCluster.Spec
+
+	return r.Update(ctx, existing)
+}
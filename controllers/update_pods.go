@@ -31,6 +31,7 @@ import (
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // updatePods provides a reconciliation step for recreating pods with new pod
@@ -45,11 +46,6 @@ func (updatePods) reconcile(ctx context.Context, r *FoundationDBClusterReconcile
 	}
 
 	if len(updates) > 0 {
-		if cluster.Spec.AutomationOptions.PodUpdateStrategy == fdbv1beta2.PodUpdateStrategyReplacement {
-			logger.Info("Requeuing reconciliation to replace pods")
-			return &requeue{message: "Requeueing reconciliation to replace pods"}
-		}
-
 		if r.PodLifecycleManager.GetDeletionMode(cluster) == fdbv1beta2.PodUpdateModeNone {
 			r.Recorder.Event(cluster, corev1.EventTypeNormal,
 				"NeedsPodsDeletion", "Spec require deleting some pods, but deleting pods is disabled")
@@ -151,13 +147,22 @@ func getPodsToUpdate(ctx context.Context, logger logr.Logger, reconciler *Founda
 			continue
 		}
 
+		if !cluster.IsCanaryUpdateAllowed(processGroup.ProcessGroupID) {
+			logger.V(1).Info("Skip process group for update, waiting for the canary rollout",
+				"processGroupID", processGroup.ProcessGroupID)
+			continue
+		}
+
 		if cluster.SkipProcessGroup(processGroup) {
 			logger.V(1).Info("Ignore pending Pod",
 				"processGroupID", processGroup.ProcessGroupID)
 			continue
 		}
 
-		if cluster.NeedsReplacement(processGroup) {
+		// Process groups that require a replacement for spec changes will still get a new Pod if the file system of
+		// an expanded PVC must be resized.
+		needsReplacement := cluster.NeedsReplacement(processGroup)
+		if needsReplacement && processGroup.GetConditionTime(fdbv1beta2.ResizingPVC) == nil {
 			logger.V(1).Info("Skip process group for deletion, requires a replacement",
 				"processGroupID", processGroup.ProcessGroupID)
 			continue
@@ -183,14 +188,31 @@ func getPodsToUpdate(ctx context.Context, logger logr.Logger, reconciler *Founda
 			continue
 		}
 
-		// The Pod is updated, so we can continue.
 		if pod.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey] == specHash {
-			continue
-		}
+			needsRestart, err := podNeedsRestartForPVCResize(ctx, reconciler, cluster, processGroup, pod)
+			if err != nil {
+				return nil, err
+			}
+
+			// The Pod is updated, so we can continue.
+			if !needsRestart {
+				continue
+			}
+
+			logger.Info("Update Pod",
+				"processGroupID", processGroup.ProcessGroupID,
+				"reason", "file system resize of the PVC requires a new Pod")
+		} else {
+			if needsReplacement {
+				logger.V(1).Info("Skip process group for deletion, requires a replacement",
+					"processGroupID", processGroup.ProcessGroupID)
+				continue
+			}
 
-		logger.Info("Update Pod",
-			"processGroupID", processGroup.ProcessGroupID,
-			"reason", fmt.Sprintf("specHash has changed from %s to %s", specHash, pod.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey]))
+			logger.Info("Update Pod",
+				"processGroupID", processGroup.ProcessGroupID,
+				"reason", fmt.Sprintf("specHash has changed from %s to %s", specHash, pod.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey]))
+		}
 
 		podClient, message := reconciler.getPodClient(cluster, pod)
 		if podClient == nil {
@@ -227,6 +249,27 @@ func getPodsToUpdate(ctx context.Context, logger logr.Logger, reconciler *Founda
 	return updates, nil
 }
 
+// podNeedsRestartForPVCResize returns true if the PVC of the process group was expanded and the file system can only be
+// resized by recreating the Pod.
+func podNeedsRestartForPVCResize(ctx context.Context, reconciler *FoundationDBClusterReconciler, cluster *fdbv1beta2.FoundationDBCluster, processGroup *fdbv1beta2.ProcessGroupStatus, pod *corev1.Pod) (bool, error) {
+	if processGroup.GetConditionTime(fdbv1beta2.ResizingPVC) == nil {
+		return false, nil
+	}
+
+	desiredPVC, err := internal.GetPvc(cluster, processGroup)
+	if err != nil || desiredPVC == nil {
+		return false, err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err = reconciler.Get(ctx, client.ObjectKey{Namespace: desiredPVC.Namespace, Name: desiredPVC.Name}, pvc)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return internal.PodNeedsRestartForPVCResize(pod, pvc, time.Now()), nil
+}
+
 func shouldRequeueDueToTerminatingPod(pod *corev1.Pod, cluster *fdbv1beta2.FoundationDBCluster, processGroupID fdbv1beta2.ProcessGroupID) bool {
 	return pod.DeletionTimestamp != nil &&
 		pod.DeletionTimestamp.Add(time.Duration(cluster.GetIgnoreTerminatingPodsSeconds())*time.Second).After(time.Now()) &&
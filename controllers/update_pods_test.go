@@ -337,6 +337,80 @@ var _ = Describe("update_pods", func() {
 			})
 		})
 
+		When("the PVC of a storage process group was expanded", func() {
+			var transitionTime time.Time
+			var processGroup *fdbv1beta2.ProcessGroupStatus
+
+			BeforeEach(func() {
+				transitionTime = time.Now().Add(-10 * time.Minute)
+			})
+
+			JustBeforeEach(func() {
+				for _, current := range cluster.Status.ProcessGroups {
+					if current.ProcessClass == fdbv1beta2.ProcessClassStorage {
+						processGroup = current
+						break
+					}
+				}
+
+				processGroup.UpdateCondition(fdbv1beta2.ResizingPVC, true)
+				pvc, err := internal.GetPvc(cluster, processGroup)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(pvc), pvc)).NotTo(HaveOccurred())
+				pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+					{
+						Type:               corev1.PersistentVolumeClaimFileSystemResizePending,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(transitionTime),
+					},
+				}
+				Expect(k8sClient.Status().Update(context.TODO(), pvc)).NotTo(HaveOccurred())
+
+				pod := &corev1.Pod{}
+				Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKey{Namespace: cluster.Namespace, Name: processGroup.GetPodName(cluster)}, pod)).NotTo(HaveOccurred())
+				pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-1 * time.Hour))
+				Expect(k8sClient.Update(context.TODO(), pod)).NotTo(HaveOccurred())
+
+				updates, err = getPodsToUpdate(context.Background(), globalControllerLogger, clusterReconciler, cluster)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			When("the file system resize is pending for longer than the delay", func() {
+				It("should return the Pod of the process group", func() {
+					Expect(updates).To(HaveLen(1))
+					for _, pods := range updates {
+						Expect(pods).To(HaveLen(1))
+						Expect(pods[0].Name).To(Equal(processGroup.GetPodName(cluster)))
+					}
+				})
+
+				When("the Pod update strategy is replacement", func() {
+					BeforeEach(func() {
+						cluster.Spec.AutomationOptions.PodUpdateStrategy = fdbv1beta2.PodUpdateStrategyReplacement
+					})
+
+					It("should return the Pod of the process group", func() {
+						Expect(updates).To(HaveLen(1))
+						for _, pods := range updates {
+							Expect(pods).To(HaveLen(1))
+							Expect(pods[0].Name).To(Equal(processGroup.GetPodName(cluster)))
+						}
+						Expect(processGroup.IsMarkedForRemoval()).To(BeFalse())
+					})
+				})
+			})
+
+			When("the file system resize is pending for a short time", func() {
+				BeforeEach(func() {
+					transitionTime = time.Now().Add(-1 * time.Minute)
+				})
+
+				It("should not return any Pods", func() {
+					Expect(updates).To(HaveLen(0))
+				})
+			})
+		})
+
 		When("max zones with unavailable pods is set to 3 and there are two process groups with pods in pending status", func() {
 			BeforeEach(func() {
 				expectedError = false
@@ -0,0 +1,142 @@
+/*
+ * update_restore_status.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// updateRestoreStatus provides a reconciliation step for updating the status of a running restore.
+type updateRestoreStatus struct{}
+
+// reconcile runs the reconciler's work.
+func (s updateRestoreStatus) reconcile(ctx context.Context, r *FoundationDBRestoreReconciler, restore *fdbv1beta2.FoundationDBRestore) *requeue {
+	// Only the status of a running restore is updated, as the restore status of the tag can belong to a previous or a
+	// later restore.
+	if restore.GetPhase() != fdbv1beta2.RestorePhaseRunning {
+		return nil
+	}
+
+	adminClient, err := r.adminClientForRestore(ctx, restore)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+	defer adminClient.Close()
+
+	liveStatus, err := adminClient.GetRestoreStatus()
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if liveStatus == nil {
+		return nil
+	}
+
+	if liveStatus.URL != restore.BackupURL() {
+		return &requeue{message: fmt.Sprintf("the restore status belongs to a restore from %s", liveStatus.URL), delay: time.Minute}
+	}
+
+	originalStatus := restore.Status.DeepCopy()
+	err = updateRestoreStatusFromLiveStatus(restore, liveStatus, time.Now())
+	if err != nil {
+		return &requeue{message: err.Error(), delay: time.Minute}
+	}
+
+	if equality.Semantic.DeepEqual(restore.Status, *originalStatus) {
+		return nil
+	}
+
+	if restore.Status.Phase.IsTerminal() {
+		globalControllerLogger.Info("Restore finished", "namespace", restore.Namespace, "restore", restore.Name, "phase", restore.Status.Phase, "error", restore.Status.Error)
+	}
+
+	err = r.updateOrApply(ctx, restore)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
+
+// updateRestoreStatusFromLiveStatus updates the status of the restore based on the live status reported by
+// fdbrestore. If the state of the restore is unknown, the status will not be updated.
+func updateRestoreStatusFromLiveStatus(restore *fdbv1beta2.FoundationDBRestore, liveStatus *fdbv1beta2.FoundationDBLiveRestoreStatus, now time.Time) error {
+	phase, err := getRestorePhaseForState(liveStatus.State)
+	if err != nil {
+		return err
+	}
+
+	restore.Status.RestoredBytes = liveStatus.BytesWritten
+	restore.Status.ProgressPercent = liveStatus.GetProgressPercent()
+	restore.Status.TargetVersion = liveStatus.TargetVersion
+	restore.Status.Error = liveStatus.LastError
+
+	switch phase {
+	case fdbv1beta2.RestorePhaseCompleted:
+		setRestoreTerminalPhase(restore, phase, fmt.Sprintf("The restore from %s has completed", restore.BackupURL()), now)
+	case fdbv1beta2.RestorePhaseFailed:
+		if restore.Status.Error == "" {
+			restore.Status.Error = "The restore was aborted"
+		}
+		setRestoreTerminalPhase(restore, phase, fmt.Sprintf("The restore from %s has failed: %s", restore.BackupURL(), restore.Status.Error), now)
+	default:
+		restore.Status.Phase = phase
+		restore.Status.Running = true
+	}
+
+	return nil
+}
+
+// setRestoreTerminalPhase moves the restore into the provided terminal phase.
+func setRestoreTerminalPhase(restore *fdbv1beta2.FoundationDBRestore, phase fdbv1beta2.RestorePhase, message string, now time.Time) {
+	if phase == fdbv1beta2.RestorePhaseFailed && restore.Status.Error == "" {
+		restore.Status.Error = message
+	}
+
+	restore.Status.Phase = phase
+	restore.Status.Running = false
+	if restore.Status.EndTime == nil {
+		restore.Status.EndTime = &metav1.Time{Time: now}
+	}
+
+	setStatusCondition(&restore.Status.Conditions, newStatusCondition(fdbv1beta2.RestoreConditionRunning, restore.ObjectMeta.Generation, false, fdbv1beta2.ConditionReasonRestoreRunning, fdbv1beta2.ConditionReasonRestoreNotRunning, message))
+}
+
+// getRestorePhaseForState returns the phase of a restore in the provided state. An error is returned for states that are
+// unknown to the operator.
+func getRestorePhaseForState(state fdbv1beta2.RestoreState) (fdbv1beta2.RestorePhase, error) {
+	switch state {
+	case fdbv1beta2.RestoreStateQueued, fdbv1beta2.RestoreStateStarting, fdbv1beta2.RestoreStateRunning:
+		return fdbv1beta2.RestorePhaseRunning, nil
+	case fdbv1beta2.RestoreStateCompleted:
+		return fdbv1beta2.RestorePhaseCompleted, nil
+	case fdbv1beta2.RestoreStateAborted:
+		return fdbv1beta2.RestorePhaseFailed, nil
+	default:
+		return "", fmt.Errorf("unknown restore state %s", state)
+	}
+}
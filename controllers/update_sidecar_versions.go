@@ -91,7 +91,7 @@ func (updateSidecarVersions) reconcile(ctx context.Context, r *FoundationDBClust
 	}
 
 	if upgraded > 0 {
-		r.Recorder.Event(cluster, corev1.EventTypeNormal, "SidecarUpgraded", fmt.Sprintf("New version: %s, number of sidecars upgraded: %d", cluster.Spec.Version, upgraded))
+		r.Recorder.Event(cluster, corev1.EventTypeNormal, "SidecarUpgraded", fmt.Sprintf("New version: %s, number of sidecars upgraded: %d", cluster.GetDesiredVersion(), upgraded))
 	}
 
 	return nil
@@ -40,6 +40,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 )
@@ -55,6 +56,23 @@ func (updateStatus) reconcile(ctx context.Context, r *FoundationDBClusterReconci
 	// Pass through Maintenance Mode Info as the maintenance_mode_checker reconciler takes care of updating it
 	originalStatus.MaintenanceModeInfo.DeepCopyInto(&clusterStatus.MaintenanceModeInfo)
 	clusterStatus.Generations.Reconciled = cluster.Status.Generations.Reconciled
+	// Pass through the conditions to preserve the last transition time and the Reconciled condition.
+	clusterStatus.Conditions = originalStatus.DeepCopy().Conditions
+	// Pass through the blocking sub-reconciler as this will be updated at the end of the reconciliation.
+	clusterStatus.BlockingReconciler = originalStatus.BlockingReconciler.DeepCopy()
+	// Pass through the maintenance window information as this will be updated at the end of the reconciliation.
+	clusterStatus.MaintenanceWindow = originalStatus.MaintenanceWindow.DeepCopy()
+	clusterStatus.Canary = originalStatus.Canary.DeepCopy()
+	clusterStatus.Upgrade = originalStatus.Upgrade.DeepCopy()
+	clusterStatus.UpgradeRollback = originalStatus.UpgradeRollback.DeepCopy()
+	clusterStatus.RegionFailover = originalStatus.RegionFailover.DeepCopy()
+	clusterStatus.StorageEngineMigration = originalStatus.StorageEngineMigration.DeepCopy()
+	clusterStatus.StorageAutoscaling = originalStatus.StorageAutoscaling.DeepCopy()
+	clusterStatus.StatelessAutoscaling = originalStatus.StatelessAutoscaling.DeepCopy()
+	// Pass through the storage wiggle progress in case the database is unavailable.
+	clusterStatus.StorageWiggle = originalStatus.StorageWiggle.DeepCopy()
+	// Report the sub-reconcilers that are suspended by the automation options.
+	clusterStatus.SuspendedSubReconcilers = cluster.GetSuspendedSubReconcilers()
 
 	// Initialize with the current desired storage servers per Pod
 	clusterStatus.StorageServersPerDisk = []int{cluster.GetStorageServersPerPod()}
@@ -99,10 +117,13 @@ func (updateStatus) reconcile(ctx context.Context, r *FoundationDBClusterReconci
 	// about the current database configuration, leading to a wrong signal that the database configuration must be changed as
 	// the configuration will be overwritten with the default values.
 	if databaseStatus.Client.DatabaseStatus.Available {
-		clusterStatus.DatabaseConfiguration = databaseStatus.Cluster.DatabaseConfiguration.NormalizeConfigurationWithSeparatedProxies(cluster.Spec.Version, cluster.Spec.DatabaseConfiguration.AreSeparatedProxiesConfigured())
+		clusterStatus.DatabaseConfiguration = databaseStatus.Cluster.DatabaseConfiguration.NormalizeConfigurationWithSeparatedProxies(cluster.GetDesiredVersion(), cluster.Spec.DatabaseConfiguration.AreSeparatedProxiesConfigured())
 		// Removing excluded servers as we don't want them during comparison.
 		clusterStatus.DatabaseConfiguration.ExcludedServers = nil
 		cluster.ClearMissingVersionFlags(&clusterStatus.DatabaseConfiguration)
+		cluster.ClearMissingStorageWiggleSettings(&clusterStatus.DatabaseConfiguration)
+		cluster.ClearMissingTenantMode(&clusterStatus.DatabaseConfiguration)
+		clusterStatus.StorageWiggle = getStorageWiggleStatus(databaseStatus)
 	}
 
 	// If we saw at least once that the cluster was configured, we assume that the cluster is always configured.
@@ -150,6 +171,8 @@ func (updateStatus) reconcile(ctx context.Context, r *FoundationDBClusterReconci
 	}
 
 	updateFaultDomains(logger, processMap, &clusterStatus)
+	// The knobs applied through the configuration database are managed by the updateKnobs sub-reconciler.
+	clusterStatus.Knobs = getKnobStatus(cluster, processMap, originalStatus.Knobs)
 
 	pvcs, err := refreshProcessGroupStatus(ctx, r, cluster, &clusterStatus)
 	if err != nil {
@@ -179,7 +202,7 @@ func (updateStatus) reconcile(ctx context.Context, r *FoundationDBClusterReconci
 	}
 
 	if clusterStatus.RunningVersion == "" {
-		clusterStatus.RunningVersion = cluster.Spec.Version
+		clusterStatus.RunningVersion = cluster.GetDesiredVersion()
 	}
 
 	clusterStatus.ConnectionString = cluster.Status.ConnectionString
@@ -254,6 +277,8 @@ func (updateStatus) reconcile(ctx context.Context, r *FoundationDBClusterReconci
 		return &requeue{curError: err}
 	}
 
+	updateClusterConditions(cluster)
+
 	if reconciled {
 		// Once the cluster is reconciled the operator will release any pending locks for this cluster.
 		lockErr := r.releaseLock(logger, cluster)
@@ -276,6 +301,76 @@ func (updateStatus) reconcile(ctx context.Context, r *FoundationDBClusterReconci
 	return nil
 }
 
+// updateClusterConditions updates the conditions of the cluster that are derived from the machine-readable status and
+// the process group status. The Reconciled condition is managed by the reconciler based on the result of the
+// sub-reconcilers.
+func updateClusterConditions(cluster *fdbv1beta2.FoundationDBCluster) {
+	generation := cluster.ObjectMeta.Generation
+	health := cluster.Status.Health
+
+	availableMessage := "The database is available"
+	if !health.Available {
+		availableMessage = "The database is unavailable"
+	}
+	setStatusCondition(&cluster.Status.Conditions, newStatusCondition(fdbv1beta2.ClusterConditionAvailable, generation, health.Available, fdbv1beta2.ConditionReasonDatabaseAvailable, fdbv1beta2.ConditionReasonDatabaseUnavailable, availableMessage))
+
+	healthyMessage := "The database is healthy"
+	if !health.Healthy {
+		healthyMessage = "The database is unhealthy"
+	}
+	setStatusCondition(&cluster.Status.Conditions, newStatusCondition(fdbv1beta2.ClusterConditionHealthy, generation, health.Healthy, fdbv1beta2.ConditionReasonDatabaseHealthy, fdbv1beta2.ConditionReasonDatabaseUnhealthy, healthyMessage))
+
+	replicationMessage := "All data is fully replicated"
+	if !health.FullReplication {
+		replicationMessage = fmt.Sprintf("Data is not fully replicated, highest data movement priority is %d", health.DataMovementPriority)
+	}
+	setStatusCondition(&cluster.Status.Conditions, newStatusCondition(fdbv1beta2.ClusterConditionFullReplication, generation, health.FullReplication, fdbv1beta2.ConditionReasonFullyReplicated, fdbv1beta2.ConditionReasonNotFullyReplicated, replicationMessage))
+
+	upgrading := cluster.IsBeingUpgraded()
+	upgradeMessage := fmt.Sprintf("The cluster is running the desired version %s", cluster.GetDesiredVersion())
+	if upgrading {
+		upgradeMessage = fmt.Sprintf("The cluster is changing the version from %s to %s", cluster.Status.RunningVersion, cluster.GetDesiredVersion())
+	}
+	setStatusCondition(&cluster.Status.Conditions, newStatusCondition(fdbv1beta2.ClusterConditionUpgrading, generation, upgrading, fdbv1beta2.ConditionReasonVersionChange, fdbv1beta2.ConditionReasonVersionReconciled, upgradeMessage))
+
+	maintenanceZone := cluster.Status.MaintenanceModeInfo.ZoneID
+	maintenanceMessage := "No maintenance zone is set"
+	if maintenanceZone != "" {
+		maintenanceMessage = fmt.Sprintf("The maintenance zone %s is set", maintenanceZone)
+	}
+	setStatusCondition(&cluster.Status.Conditions, newStatusCondition(fdbv1beta2.ClusterConditionMaintenanceModeActive, generation, maintenanceZone != "", fdbv1beta2.ConditionReasonMaintenanceZoneSet, fdbv1beta2.ConditionReasonNoMaintenanceZone, maintenanceMessage))
+
+	var degradedProcessGroups int
+	for _, processGroup := range cluster.Status.ProcessGroups {
+		if processGroup.IsMarkedForRemoval() {
+			continue
+		}
+
+		if len(processGroup.ProcessGroupConditions) > 0 {
+			degradedProcessGroups++
+		}
+	}
+
+	degraded := metav1.Condition{
+		Type:               fdbv1beta2.ClusterConditionDegraded,
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             fdbv1beta2.ConditionReasonNoDegradation,
+		Message:            "The database is healthy and no process group has a condition",
+	}
+
+	if !health.Healthy {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = fdbv1beta2.ConditionReasonDatabaseUnhealthy
+		degraded.Message = fmt.Sprintf("The database is unhealthy and %d process groups have conditions", degradedProcessGroups)
+	} else if degradedProcessGroups > 0 {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = fdbv1beta2.ConditionReasonProcessGroupsWithConditions
+		degraded.Message = fmt.Sprintf("%d process groups have conditions", degradedProcessGroups)
+	}
+	setStatusCondition(&cluster.Status.Conditions, degraded)
+}
+
 // containsAll determines if one map contains all the keys and matching values
 // from another map.
 func containsAll(current map[string]string, desired map[string]string) bool {
@@ -411,14 +506,14 @@ func checkAndSetProcessStatus(logger logr.Logger, r *FoundationDBClusterReconcil
 			// until the cluster is fully reconciled.
 			versionMatch := true
 			if !versionCompatibleUpgrade {
-				versionMatch = process.Version == cluster.Spec.Version || process.Version == fmt.Sprintf("%s-PRERELEASE", cluster.Spec.Version)
+				versionMatch = process.Version == cluster.GetDesiredVersion() || process.Version == fmt.Sprintf("%s-PRERELEASE", cluster.GetDesiredVersion())
 			}
 
 			// If the `EmptyMonitorConf` is set, the commandline is by definition wrong since there should be no running processes.
 			if !(commandLine == process.CommandLine && versionMatch && !cluster.Spec.Buggify.EmptyMonitorConf) {
 				logger.Info("IncorrectProcess",
 					"expected", commandLine, "got", process.CommandLine,
-					"expectedVersion", cluster.Spec.Version,
+					"expectedVersion", cluster.GetDesiredVersion(),
 					"version", process.Version,
 					"processGroupID", processGroupStatus.ProcessGroupID,
 					"emptyMonitorConf", cluster.Spec.Buggify.EmptyMonitorConf)
@@ -623,7 +718,18 @@ func validateProcessGroup(ctx context.Context, r *FoundationDBClusterReconciler,
 	}
 
 	incorrectPVC := (currentPVC != nil) != (desiredPvc != nil)
+	resizingPVC := false
 	if !incorrectPVC && desiredPvc != nil {
+		resizingPVC, err = pvcIsBeingResized(ctx, r, currentPVC, desiredPvc)
+		if err != nil {
+			return err
+		}
+
+		// The spec hash of a PVC that is expanded in place will be updated by the resizePVCs sub-reconciler.
+		if resizingPVC {
+			desiredPvc.Annotations[fdbv1beta2.LastSpecKey] = currentPVC.Annotations[fdbv1beta2.LastSpecKey]
+		}
+
 		incorrectPVC = !metadataMatches(currentPVC.ObjectMeta, desiredPvc.ObjectMeta)
 	}
 	if incorrectPVC {
@@ -631,6 +737,7 @@ func validateProcessGroup(ctx context.Context, r *FoundationDBClusterReconciler,
 	}
 
 	processGroupStatus.UpdateCondition(fdbv1beta2.MissingPVC, incorrectPVC)
+	processGroupStatus.UpdateCondition(fdbv1beta2.ResizingPVC, resizingPVC)
 
 	if pod.Status.Phase == corev1.PodPending {
 		processGroupStatus.UpdateCondition(fdbv1beta2.PodPending, true)
@@ -921,3 +1028,28 @@ func updateFaultDomains(logger logr.Logger, processes map[fdbv1beta2.ProcessGrou
 		status.ProcessGroups[idx].FaultDomain = fdbv1beta2.FaultDomain(faultDomain)
 	}
 }
+
+// getStorageWiggleStatus returns the progress of the perpetual storage wiggle in the primary region based on the
+// machine-readable status. If the perpetual storage wiggle is disabled nil will be returned.
+func getStorageWiggleStatus(databaseStatus *fdbv1beta2.FoundationDBStatus) *fdbv1beta2.StorageWiggleStatus {
+	if pointer.IntDeref(databaseStatus.Cluster.DatabaseConfiguration.PerpetualStorageWiggle, 0) == 0 {
+		return nil
+	}
+
+	wiggler := databaseStatus.Cluster.StorageWiggler
+	wiggleStatus := &fdbv1beta2.StorageWiggleStatus{
+		WigglingServerIDs:       wiggler.WiggleServerIDs,
+		WigglingServerAddresses: wiggler.WiggleServerAddresses,
+	}
+
+	if wiggler.Primary != nil {
+		wiggleStatus.FinishedRounds = wiggler.Primary.FinishedRound
+		wiggleStatus.FinishedWiggles = wiggler.Primary.FinishedWiggle
+		if wiggler.Primary.LastRoundFinishTimestamp > 0 {
+			// The timestamp in the cluster status will be truncated to seconds to prevent unnecessary status updates.
+			wiggleStatus.LastRoundFinishTime = &metav1.Time{Time: time.Unix(int64(wiggler.Primary.LastRoundFinishTimestamp), 0)}
+		}
+	}
+
+	return wiggleStatus
+}
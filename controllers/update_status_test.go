@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -731,6 +732,29 @@ var _ = Describe("update_status", func() {
 			}
 		})
 
+		It("should set the conditions based on the machine-readable status", func() {
+			Expect(meta.IsStatusConditionTrue(cluster.Status.Conditions, fdbv1beta2.ClusterConditionAvailable)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(cluster.Status.Conditions, fdbv1beta2.ClusterConditionHealthy)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(cluster.Status.Conditions, fdbv1beta2.ClusterConditionFullReplication)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(cluster.Status.Conditions, fdbv1beta2.ClusterConditionUpgrading)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(cluster.Status.Conditions, fdbv1beta2.ClusterConditionMaintenanceModeActive)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(cluster.Status.Conditions, fdbv1beta2.ClusterConditionDegraded)).To(BeTrue())
+		})
+
+		When("a process group has a condition", func() {
+			BeforeEach(func() {
+				adminClient.MockMissingProcessGroup("storage-1", true)
+			})
+
+			It("should mark the cluster as degraded", func() {
+				condition := meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionDegraded)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(fdbv1beta2.ConditionReasonProcessGroupsWithConditions))
+				Expect(condition.Message).To(Equal("1 process groups have conditions"))
+			})
+		})
+
 		When("disabling an explicit listen address", func() {
 			BeforeEach(func() {
 				result, err := reconcileCluster(cluster)
@@ -755,6 +779,13 @@ var _ = Describe("update_status", func() {
 				It("status maintenance zone should match", func() {
 					Expect(cluster.Status.MaintenanceModeInfo).To(Equal(fdbv1beta2.MaintenanceModeInfo{ZoneID: "operator-test-1-storage-4"}))
 				})
+
+				It("should set the maintenance mode condition", func() {
+					condition := meta.FindStatusCondition(cluster.Status.Conditions, fdbv1beta2.ClusterConditionMaintenanceModeActive)
+					Expect(condition).NotTo(BeNil())
+					Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+					Expect(condition.Message).To(Equal("The maintenance zone operator-test-1-storage-4 is set"))
+				})
 			})
 		})
 
@@ -964,4 +995,64 @@ var _ = Describe("update_status", func() {
 			})
 		})
 	})
+
+	When("getting the storage wiggle status", func() {
+		var databaseStatus *fdbv1beta2.FoundationDBStatus
+		var wiggleStatus *fdbv1beta2.StorageWiggleStatus
+
+		BeforeEach(func() {
+			databaseStatus = &fdbv1beta2.FoundationDBStatus{
+				Cluster: fdbv1beta2.FoundationDBStatusClusterInfo{
+					StorageWiggler: fdbv1beta2.FoundationDBStatusStorageWiggler{
+						Primary: &fdbv1beta2.FoundationDBStatusStorageWigglerStats{
+							FinishedRound:            2,
+							FinishedWiggle:           10,
+							LastRoundFinishTimestamp: 1696248000.5,
+						},
+						WiggleServerIDs:       []string{"b9f3f2e8a4c54d5e"},
+						WiggleServerAddresses: []string{"192.168.0.1:4501"},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			wiggleStatus = getStorageWiggleStatus(databaseStatus)
+		})
+
+		When("the perpetual storage wiggle is disabled", func() {
+			It("should return no storage wiggle status", func() {
+				Expect(wiggleStatus).To(BeNil())
+			})
+		})
+
+		When("the perpetual storage wiggle is enabled", func() {
+			BeforeEach(func() {
+				databaseStatus.Cluster.DatabaseConfiguration.PerpetualStorageWiggle = pointer.Int(1)
+			})
+
+			It("should return the progress of the primary region", func() {
+				Expect(wiggleStatus).To(Equal(&fdbv1beta2.StorageWiggleStatus{
+					WigglingServerIDs:       []string{"b9f3f2e8a4c54d5e"},
+					WigglingServerAddresses: []string{"192.168.0.1:4501"},
+					FinishedRounds:          2,
+					FinishedWiggles:         10,
+					LastRoundFinishTime:     &metav1.Time{Time: time.Unix(1696248000, 0)},
+				}))
+			})
+
+			When("no round has been finished", func() {
+				BeforeEach(func() {
+					databaseStatus.Cluster.StorageWiggler.Primary = nil
+				})
+
+				It("should only return the wiggling servers", func() {
+					Expect(wiggleStatus).To(Equal(&fdbv1beta2.StorageWiggleStatus{
+						WigglingServerIDs:       []string{"b9f3f2e8a4c54d5e"},
+						WigglingServerAddresses: []string{"192.168.0.1:4501"},
+					}))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,103 @@
+/*
+ * update_tenant_status.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// updateTenantStatus provides a reconciliation step for updating the status of the tenant.
+type updateTenantStatus struct{}
+
+// reconcile runs the reconciler's work.
+func (updateTenantStatus) reconcile(ctx context.Context, r *FoundationDBTenantReconciler, tenant *fdbv1beta2.FoundationDBTenant) *requeue {
+	cluster, err := r.getClusterForTenant(ctx, tenant)
+	if err != nil {
+		return &requeue{curError: err, delayedRequeue: true}
+	}
+
+	if !cluster.Status.Configured {
+		return &requeue{message: fmt.Sprintf("cluster %s is not yet configured", cluster.Name), delayedRequeue: true}
+	}
+
+	version, err := fdbv1beta2.ParseFdbVersion(cluster.GetRunningVersion())
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if !version.SupportsTenants() {
+		return &requeue{message: fmt.Sprintf("tenants are not supported on version %s", version)}
+	}
+
+	tenantMode := cluster.Status.DatabaseConfiguration.TenantMode
+	if tenantMode == nil || *tenantMode == fdbv1beta2.TenantModeDisabled {
+		return &requeue{message: fmt.Sprintf("tenants are disabled on cluster %s", cluster.Name), delayedRequeue: true}
+	}
+
+	tenantName := tenant.GetTenantName()
+	if tenant.Status.Created && tenant.Status.TenantName != tenantName {
+		return &requeue{message: fmt.Sprintf("tenant name cannot be changed from %s to %s", tenant.Status.TenantName, tenantName)}
+	}
+
+	adminClient, err := r.getDatabaseClientProvider().GetAdminClient(cluster, r)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+	defer adminClient.Close()
+
+	liveStatus, err := adminClient.GetTenant(tenantName)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	status := fdbv1beta2.FoundationDBTenantStatus{}
+	status.Generations.Reconciled = tenant.Status.Generations.Reconciled
+	status.Conditions = tenant.Status.DeepCopy().Conditions
+	status.TenantName = tenantName
+	if liveStatus != nil {
+		status.Created = true
+		status.ID = liveStatus.ID
+		status.Prefix = liveStatus.Prefix
+	}
+
+	originalStatus := tenant.Status.DeepCopy()
+
+	tenant.Status = status
+
+	_, err = tenant.CheckReconciliation()
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if !equality.Semantic.DeepEqual(tenant.Status, *originalStatus) {
+		err = r.updateOrApply(ctx, tenant)
+		if err != nil {
+			globalControllerLogger.Error(err, "Error updating tenant status", "namespace", tenant.Namespace, "tenant", tenant.Name)
+			return &requeue{curError: err}
+		}
+	}
+
+	return nil
+}
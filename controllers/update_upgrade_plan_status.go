@@ -0,0 +1,205 @@
+/*
+ * update_upgrade_plan_status.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// updateUpgradePlanStatus provides a reconciliation step for updating the upgrade state of the clusters selected by an
+// upgrade plan.
+type updateUpgradePlanStatus struct{}
+
+// reconcile runs the reconciler's work.
+func (updateUpgradePlanStatus) reconcile(ctx context.Context, r *FoundationDBUpgradePlanReconciler, plan *fdbv1beta2.FoundationDBUpgradePlan, logger logr.Logger) *requeue {
+	clusters, err := r.getClustersForUpgradePlan(ctx, plan)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	originalStatus := plan.Status.DeepCopy()
+	err = updateUpgradePlanClusterStatus(plan, clusters)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	if equality.Semantic.DeepEqual(*originalStatus, plan.Status) {
+		return nil
+	}
+
+	logger.Info("Updating upgrade plan status", "phase", plan.Status.Phase, "currentWave", plan.Status.CurrentWave)
+	err = r.updateOrApply(ctx, plan)
+	if err != nil {
+		return &requeue{curError: err}
+	}
+
+	return nil
+}
+
+// getClustersForUpgradePlan returns the clusters selected by the upgrade plan sorted by their name.
+func (r *FoundationDBUpgradePlanReconciler) getClustersForUpgradePlan(ctx context.Context, plan *fdbv1beta2.FoundationDBUpgradePlan) ([]fdbv1beta2.FoundationDBCluster, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&plan.Spec.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterList := &fdbv1beta2.FoundationDBClusterList{}
+	err = r.List(ctx, clusterList, client.InNamespace(plan.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(clusterList.Items, func(i, j int) bool {
+		return clusterList.Items[i].Name < clusterList.Items[j].Name
+	})
+
+	return clusterList.Items, nil
+}
+
+// updateUpgradePlanClusterStatus updates the upgrade state of the provided clusters and the phase of the upgrade plan.
+// Clusters that are not selected by any wave will be removed from the status.
+func updateUpgradePlanClusterStatus(plan *fdbv1beta2.FoundationDBUpgradePlan, clusters []fdbv1beta2.FoundationDBCluster) error {
+	waves := plan.GetWaves()
+	clusterStatuses := make([]fdbv1beta2.UpgradePlanClusterStatus, 0, len(clusters))
+
+	for idx := range clusters {
+		cluster := &clusters[idx]
+		wave, err := getUpgradeWaveForCluster(waves, cluster)
+		if err != nil {
+			return err
+		}
+
+		if wave == nil {
+			continue
+		}
+
+		clusterStatus := fdbv1beta2.UpgradePlanClusterStatus{
+			Name:  cluster.Name,
+			State: fdbv1beta2.UpgradePlanClusterStatePending,
+		}
+
+		current := plan.GetClusterStatus(cluster.Name)
+		if current != nil {
+			clusterStatus = *current.DeepCopy()
+		}
+
+		clusterStatus.Wave = wave.Name
+		clusterStatus.State, clusterStatus.Message = getUpgradePlanClusterState(plan.Spec.Version, cluster, clusterStatus)
+		clusterStatuses = append(clusterStatuses, clusterStatus)
+	}
+
+	plan.Status.Clusters = clusterStatuses
+	plan.Status.Phase, plan.Status.CurrentWave = getUpgradePlanPhase(plan)
+
+	return nil
+}
+
+// getUpgradeWaveForCluster returns the first wave that selects the cluster or nil if no wave selects the cluster.
+func getUpgradeWaveForCluster(waves []fdbv1beta2.UpgradeWave, cluster *fdbv1beta2.FoundationDBCluster) (*fdbv1beta2.UpgradeWave, error) {
+	for idx, wave := range waves {
+		matches, err := wave.Matches(cluster.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		if matches {
+			return &waves[idx], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getUpgradePlanClusterState returns the upgrade state and the message for the cluster based on the current cluster
+// status.
+func getUpgradePlanClusterState(version string, cluster *fdbv1beta2.FoundationDBCluster, current fdbv1beta2.UpgradePlanClusterStatus) (fdbv1beta2.UpgradePlanClusterState, string) {
+	if cluster.Spec.Version != version {
+		// Failed preflight checks will be retried, so we keep the state and the message until the checks pass.
+		if current.State == fdbv1beta2.UpgradePlanClusterStatePreflightFailed {
+			return current.State, current.Message
+		}
+
+		return fdbv1beta2.UpgradePlanClusterStatePending, ""
+	}
+
+	rollback := cluster.Status.UpgradeRollback
+	if rollback != nil && rollback.FailedVersion == version && !cluster.IsUpgradeRollbackAcknowledged() {
+		return fdbv1beta2.UpgradePlanClusterStateFailed, fmt.Sprintf("upgrade was rolled back to version %s: %s", rollback.PreviousVersion, rollback.Reason)
+	}
+
+	if cluster.Status.RunningVersion == version && cluster.Status.Generations.Reconciled == cluster.ObjectMeta.Generation {
+		return fdbv1beta2.UpgradePlanClusterStateUpgraded, ""
+	}
+
+	return fdbv1beta2.UpgradePlanClusterStateUpgrading, ""
+}
+
+// getUpgradePlanPhase returns the phase of the upgrade plan and the name of the first wave with clusters that are not
+// upgraded.
+func getUpgradePlanPhase(plan *fdbv1beta2.FoundationDBUpgradePlan) (fdbv1beta2.UpgradePlanPhase, string) {
+	if len(plan.Status.Clusters) == 0 {
+		return fdbv1beta2.UpgradePlanPhasePending, ""
+	}
+
+	var currentWave string
+	var started, failed bool
+	for _, wave := range plan.GetWaves() {
+		for _, clusterStatus := range plan.Status.Clusters {
+			if clusterStatus.Wave != wave.Name {
+				continue
+			}
+
+			switch clusterStatus.State {
+			case fdbv1beta2.UpgradePlanClusterStateUpgraded, fdbv1beta2.UpgradePlanClusterStateUpgrading:
+				started = true
+			case fdbv1beta2.UpgradePlanClusterStateFailed:
+				started = true
+				failed = true
+			}
+
+			if currentWave == "" && clusterStatus.State != fdbv1beta2.UpgradePlanClusterStateUpgraded {
+				currentWave = wave.Name
+			}
+		}
+	}
+
+	if failed {
+		return fdbv1beta2.UpgradePlanPhaseFailed, currentWave
+	}
+
+	if currentWave == "" {
+		return fdbv1beta2.UpgradePlanPhaseCompleted, ""
+	}
+
+	if !started {
+		return fdbv1beta2.UpgradePlanPhasePending, currentWave
+	}
+
+	return fdbv1beta2.UpgradePlanPhaseInProgress, currentWave
+}
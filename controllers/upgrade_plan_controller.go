@@ -0,0 +1,186 @@
+/*
+ * upgrade_plan_controller.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
+	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// upgradePlanRequeueDelay defines how long the operator waits until an unfinished upgrade plan is checked again.
+const upgradePlanRequeueDelay = 1 * time.Minute
+
+// FoundationDBUpgradePlanReconciler reconciles a FoundationDBUpgradePlan object
+type FoundationDBUpgradePlanReconciler struct {
+	client.Client
+	Recorder               record.EventRecorder
+	Log                    logr.Logger
+	DatabaseClientProvider fdbadminclient.DatabaseClientProvider
+	ServerSideApply        bool
+}
+
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbupgradeplans,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbupgradeplans/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.foundationdb.org,resources=foundationdbclusters,verbs=get;list;watch;update
+
+// Reconcile runs the reconciliation logic.
+func (r *FoundationDBUpgradePlanReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	plan := &fdbv1beta2.FoundationDBUpgradePlan{}
+	err := r.Get(ctx, request.NamespacedName, plan)
+
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically garbage collected.
+			// For additional cleanup logic use finalizers.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	planLog := globalControllerLogger.WithValues("namespace", plan.Namespace, "upgradePlan", plan.Name)
+
+	subReconcilers := []upgradePlanSubReconciler{
+		updateUpgradePlanStatus{},
+		startClusterUpgrades{},
+	}
+
+	for _, subReconciler := range subReconcilers {
+		requeue := subReconciler.reconcile(ctx, r, plan, planLog)
+		if requeue == nil {
+			continue
+		}
+
+		r.updateReconciledCondition(ctx, planLog, plan, getReconciledCondition(fdbv1beta2.UpgradePlanConditionReconciled, plan.ObjectMeta.Generation, false, requeue, subReconciler))
+		return processRequeue(requeue, subReconciler, plan, r.Recorder, planLog)
+	}
+
+	r.updateReconciledCondition(ctx, planLog, plan, getReconciledCondition(fdbv1beta2.UpgradePlanConditionReconciled, plan.ObjectMeta.Generation, true, nil, nil))
+
+	// The clusters are upgraded by the cluster reconciler, so we have to check the clusters again until all clusters
+	// are upgraded.
+	if plan.Status.Phase != fdbv1beta2.UpgradePlanPhaseCompleted {
+		planLog.Info("Waiting for cluster upgrades", "phase", plan.Status.Phase, "currentWave", plan.Status.CurrentWave)
+		return ctrl.Result{RequeueAfter: upgradePlanRequeueDelay}, nil
+	}
+
+	planLog.Info("Reconciliation complete")
+
+	return ctrl.Result{}, nil
+}
+
+// getDatabaseClientProvider gets the client provider for a reconciler.
+func (r *FoundationDBUpgradePlanReconciler) getDatabaseClientProvider() fdbadminclient.DatabaseClientProvider {
+	if r.DatabaseClientProvider != nil {
+		return r.DatabaseClientProvider
+	}
+	panic("Upgrade plan reconciler does not have a DatabaseClientProvider defined")
+}
+
+// SetupWithManager prepares a reconciler for use.
+func (r *FoundationDBUpgradePlanReconciler) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles int, selector metav1.LabelSelector) error {
+	labelSelectorPredicate, err := predicate.LabelSelectorPredicate(selector)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrentReconciles},
+		).
+		For(&fdbv1beta2.FoundationDBUpgradePlan{}).
+		// Only react on generation changes or annotation changes and only watch
+		// resources with the provided label selector.
+		WithEventFilter(
+			predicate.And(
+				labelSelectorPredicate,
+				predicate.Or(
+					predicate.GenerationChangedPredicate{},
+					predicate.AnnotationChangedPredicate{},
+				),
+			)).
+		Complete(r)
+}
+
+// upgradePlanSubReconciler describes a class that does part of the work of
+// reconciliation for an upgrade plan.
+type upgradePlanSubReconciler interface {
+	/**
+	reconcile runs the reconciler's work.
+
+	If reconciliation can continue, this should return nil.
+
+	If reconciliation encounters an error, this should return a `requeue` object
+	with an `Error` field.
+
+	If reconciliation cannot proceed, this should return a `requeue` object with
+	a `Message` field.
+	*/
+	reconcile(ctx context.Context, r *FoundationDBUpgradePlanReconciler, plan *fdbv1beta2.FoundationDBUpgradePlan, logger logr.Logger) *requeue
+}
+
+// updateReconciledCondition sets the Reconciled condition of the upgrade plan and updates the status if the condition
+// has changed. Errors during the update are only logged as the condition will be updated in the next reconciliation.
+func (r *FoundationDBUpgradePlanReconciler) updateReconciledCondition(ctx context.Context, logger logr.Logger, plan *fdbv1beta2.FoundationDBUpgradePlan, condition metav1.Condition) {
+	if !setStatusCondition(&plan.Status.Conditions, condition) {
+		return
+	}
+
+	err := r.updateOrApply(ctx, plan)
+	if err != nil {
+		logger.Error(err, "Error updating the reconciled condition", "reason", condition.Reason)
+	}
+}
+
+// updateOrApply updates the status either with server-side apply or if disabled with the normal update call.
+func (r *FoundationDBUpgradePlanReconciler) updateOrApply(ctx context.Context, plan *fdbv1beta2.FoundationDBUpgradePlan) error {
+	if r.ServerSideApply {
+		// TODO(johscheuer): We have to set the TypeMeta otherwise the Patch command will fail. This is the rudimentary
+		// support for server side apply which should be enough for the status use case. The controller runtime will
+		// add some additional support in the future: https://github.com/kubernetes-sigs/controller-runtime/issues/347.
+		patch := &fdbv1beta2.FoundationDBUpgradePlan{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       plan.Kind,
+				APIVersion: plan.APIVersion,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      plan.Name,
+				Namespace: plan.Namespace,
+			},
+			Status: plan.Status,
+		}
+
+		return r.Status().Patch(ctx, patch, client.Apply, client.FieldOwner("fdb-operator")) //, client.ForceOwnership)
+	}
+
+	return r.Status().Update(ctx, plan)
+}
@@ -0,0 +1,213 @@
+/*
+ * upgrade_plan_controller_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("upgrade_plan_controller", func() {
+	var clusters []*fdbv1beta2.FoundationDBCluster
+	var plan *fdbv1beta2.FoundationDBUpgradePlan
+
+	getClusterState := func(name string) fdbv1beta2.UpgradePlanClusterState {
+		clusterStatus := plan.GetClusterStatus(name)
+		Expect(clusterStatus).NotTo(BeNil())
+		return clusterStatus.State
+	}
+
+	BeforeEach(func() {
+		clusters = nil
+		for _, name := range []string{"cluster-a", "cluster-b", "cluster-c"} {
+			cluster := internal.CreateDefaultCluster()
+			cluster.Name = name
+			cluster.Labels = map[string]string{"team": "storage"}
+			if name == "cluster-a" {
+				cluster.Labels["canary"] = "true"
+			}
+			Expect(setupClusterForTest(cluster)).NotTo(HaveOccurred())
+			clusters = append(clusters, cluster)
+		}
+
+		plan = &fdbv1beta2.FoundationDBUpgradePlan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "upgrade",
+				Namespace: clusters[0].Namespace,
+			},
+			Spec: fdbv1beta2.FoundationDBUpgradePlanSpec{
+				Version: fdbv1beta2.Versions.NextPatchVersion.String(),
+				ClusterSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "storage"},
+				},
+				Waves: []fdbv1beta2.UpgradeWave{
+					{
+						Name: "canary",
+						ClusterSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"canary": "true"},
+						},
+					},
+					{
+						Name:           "rest",
+						MaxConcurrency: pointer.Int(2),
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(context.TODO(), plan)).NotTo(HaveOccurred())
+		_, err := reconcileUpgradePlan(plan)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(plan), plan)).NotTo(HaveOccurred())
+	})
+
+	When("the upgrade plan is created", func() {
+		It("should start the upgrade of the first wave", func() {
+			Expect(plan.Status.Phase).To(Equal(fdbv1beta2.UpgradePlanPhaseInProgress))
+			Expect(plan.Status.CurrentWave).To(Equal("canary"))
+			Expect(getClusterState("cluster-a")).To(Equal(fdbv1beta2.UpgradePlanClusterStateUpgrading))
+			Expect(getClusterState("cluster-b")).To(Equal(fdbv1beta2.UpgradePlanClusterStatePending))
+			Expect(getClusterState("cluster-c")).To(Equal(fdbv1beta2.UpgradePlanClusterStatePending))
+
+			cluster := &fdbv1beta2.FoundationDBCluster{}
+			Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(clusters[0]), cluster)).NotTo(HaveOccurred())
+			Expect(cluster.Spec.Version).To(Equal(fdbv1beta2.Versions.NextPatchVersion.String()))
+			Expect(plan.GetClusterStatus("cluster-a").PreviousVersion).To(Equal(fdbv1beta2.Versions.Default.String()))
+		})
+
+		It("should mark the upgrade plan as reconciled", func() {
+			condition := meta.FindStatusCondition(plan.Status.Conditions, fdbv1beta2.UpgradePlanConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		When("the first wave is upgraded", func() {
+			JustBeforeEach(func() {
+				_, err := reconcileCluster(clusters[0])
+				Expect(err).NotTo(HaveOccurred())
+				_, err = reconcileUpgradePlan(plan)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(plan), plan)).NotTo(HaveOccurred())
+			})
+
+			It("should start the upgrade of the next wave", func() {
+				Expect(plan.Status.Phase).To(Equal(fdbv1beta2.UpgradePlanPhaseInProgress))
+				Expect(plan.Status.CurrentWave).To(Equal("rest"))
+				Expect(getClusterState("cluster-a")).To(Equal(fdbv1beta2.UpgradePlanClusterStateUpgraded))
+				Expect(getClusterState("cluster-b")).To(Equal(fdbv1beta2.UpgradePlanClusterStateUpgrading))
+				Expect(getClusterState("cluster-c")).To(Equal(fdbv1beta2.UpgradePlanClusterStateUpgrading))
+			})
+
+			When("all waves are upgraded", func() {
+				JustBeforeEach(func() {
+					for _, cluster := range clusters[1:] {
+						_, err := reconcileCluster(cluster)
+						Expect(err).NotTo(HaveOccurred())
+					}
+					_, err := reconcileUpgradePlan(plan)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(plan), plan)).NotTo(HaveOccurred())
+				})
+
+				It("should complete the upgrade plan", func() {
+					Expect(plan.Status.Phase).To(Equal(fdbv1beta2.UpgradePlanPhaseCompleted))
+					Expect(plan.Status.CurrentWave).To(BeEmpty())
+					for _, clusterStatus := range plan.Status.Clusters {
+						Expect(clusterStatus.State).To(Equal(fdbv1beta2.UpgradePlanClusterStateUpgraded))
+					}
+				})
+			})
+		})
+
+		When("the upgrade of the first wave is rolled back", func() {
+			JustBeforeEach(func() {
+				cluster := &fdbv1beta2.FoundationDBCluster{}
+				Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(clusters[0]), cluster)).NotTo(HaveOccurred())
+				cluster.Status.UpgradeRollback = &fdbv1beta2.UpgradeRollbackStatus{
+					FailedVersion:   fdbv1beta2.Versions.NextPatchVersion.String(),
+					PreviousVersion: fdbv1beta2.Versions.Default.String(),
+					Reason:          "upgrade stalled",
+				}
+				Expect(k8sClient.Status().Update(context.TODO(), cluster)).NotTo(HaveOccurred())
+
+				_, err := reconcileUpgradePlan(plan)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(plan), plan)).NotTo(HaveOccurred())
+			})
+
+			It("should fail the upgrade plan", func() {
+				Expect(plan.Status.Phase).To(Equal(fdbv1beta2.UpgradePlanPhaseFailed))
+				Expect(plan.Status.CurrentWave).To(Equal("canary"))
+				Expect(getClusterState("cluster-a")).To(Equal(fdbv1beta2.UpgradePlanClusterStateFailed))
+				Expect(plan.GetClusterStatus("cluster-a").Message).To(Equal("upgrade was rolled back to version 6.2.21: upgrade stalled"))
+				Expect(getClusterState("cluster-b")).To(Equal(fdbv1beta2.UpgradePlanClusterStatePending))
+			})
+		})
+	})
+
+	When("a cluster is not selected by any wave", func() {
+		BeforeEach(func() {
+			plan.Spec.Waves = plan.Spec.Waves[:1]
+		})
+
+		It("should not add the cluster to the upgrade plan", func() {
+			Expect(plan.Status.Clusters).To(HaveLen(1))
+			Expect(getClusterState("cluster-a")).To(Equal(fdbv1beta2.UpgradePlanClusterStateUpgrading))
+		})
+	})
+
+	When("the preflight checks fail", func() {
+		BeforeEach(func() {
+			plan.Spec.Version = fdbv1beta2.Versions.NextMajorVersion.String()
+			adminClient, err := mock.NewMockAdminClientUncast(clusters[0], k8sClient)
+			Expect(err).NotTo(HaveOccurred())
+			adminClient.MockClientVersion(fdbv1beta2.Versions.Default.String(), []string{"127.0.0.2:3687"})
+		})
+
+		It("should not start the upgrade", func() {
+			Expect(plan.Status.Phase).To(Equal(fdbv1beta2.UpgradePlanPhasePending))
+			Expect(getClusterState("cluster-a")).To(Equal(fdbv1beta2.UpgradePlanClusterStatePreflightFailed))
+			Expect(plan.GetClusterStatus("cluster-a").Message).To(Equal("1 clients do not support version 7.0.0: 127.0.0.2:3687 (cluster-a)"))
+
+			cluster := &fdbv1beta2.FoundationDBCluster{}
+			Expect(k8sClient.Get(context.TODO(), ctrlClient.ObjectKeyFromObject(clusters[0]), cluster)).NotTo(HaveOccurred())
+			Expect(cluster.Spec.Version).To(Equal(fdbv1beta2.Versions.Default.String()))
+		})
+
+		It("should mark the upgrade plan as not reconciled", func() {
+			condition := meta.FindStatusCondition(plan.Status.Conditions, fdbv1beta2.UpgradePlanConditionReconciled)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Message).To(Equal("controllers.startClusterUpgrades: preflight checks failed for clusters: cluster-a"))
+		})
+	})
+})
@@ -226,7 +226,7 @@ spec:
           # This is a workaround for a change of the version schema that was never tested/supported
           args:
             - -c
-            - echo "{{ .FDBVersion.String }}" > /var/fdb/version && runuser -u fdb -g fdb -- /entrypoint.bash --copy-library {{ .FDBVersion.Compact }} --copy-binary fdbcli --copy-binary fdbbackup --copy-binary fdbrestore --output-dir /var/output-files/{{ .FDBVersion.String }} --init-mode
+            - echo "{{ .FDBVersion.String }}" > /var/fdb/version && runuser -u fdb -g fdb -- /entrypoint.bash --copy-library {{ .FDBVersion.Compact }} --copy-binary fdbcli --copy-binary fdbbackup --copy-binary fdbrestore --copy-binary fdbdr --output-dir /var/output-files/{{ .FDBVersion.String }} --init-mode
           volumeMounts:
             - name: fdb-binaries
               mountPath: /var/output-files
@@ -374,6 +374,8 @@ spec:
             - fdbbackup
             - --copy-binary
             - fdbrestore
+            - --copy-binary
+            - fdbdr
             - --copy-library
             - "{{ .FDBVersion.Compact }}"
 {{ if eq .FDBVersion.Compact "7.1" }}
@@ -46,8 +46,12 @@ const (
 	fdbcliStr     = "fdbcli"
 	fdbbackupStr  = "fdbbackup"
 	fdbrestoreStr = "fdbrestore"
+	fdbdrStr      = "fdbdr"
 )
 
+// restoreTimestampFormat defines the format of the timestamps that are accepted by fdbrestore.
+const restoreTimestampFormat = "2006/01/02.15:04:05-0700"
+
 var maxCommandOutput = parseMaxCommandOutput()
 
 func parseMaxCommandOutput() int {
@@ -151,6 +155,10 @@ func (command cliCommand) getClusterFileFlag() string {
 		return "--dest_cluster_file"
 	}
 
+	if command.binary == fdbdrStr {
+		return "-d"
+	}
+
 	return "-C"
 }
 
@@ -617,8 +625,74 @@ func (client *cliAdminClient) GetBackupStatus() (*fdbv1beta2.FoundationDBLiveBac
 	return status, nil
 }
 
+// ExpireBackup removes the data of the backup with the provided URL that is not needed to fulfill the retention.
+func (client *cliAdminClient) ExpireBackup(url string, retention fdbv1beta2.BackupRetention) error {
+	_, err := client.runCommand(cliCommand{
+		binary: fdbbackupStr,
+		args:   getExpireBackupArgs(url, retention),
+	})
+	return err
+}
+
+// getExpireBackupArgs returns the arguments for the fdbbackup expire command.
+func getExpireBackupArgs(url string, retention fdbv1beta2.BackupRetention) []string {
+	args := []string{
+		"expire",
+		"-d",
+		url,
+	}
+
+	if retention.DeleteBeforeDays != nil {
+		args = append(args, "--delete-before-days", strconv.Itoa(*retention.DeleteBeforeDays))
+	}
+
+	if retention.MinRestorableDays != nil {
+		args = append(args, "--min-restorable-days", strconv.Itoa(*retention.MinRestorableDays))
+	}
+
+	return args
+}
+
+// DescribeBackup gets the description of the data of the backup with the provided URL.
+func (client *cliAdminClient) DescribeBackup(url string) (*fdbv1beta2.FoundationDBBackupDescription, error) {
+	output, err := client.runCommand(cliCommand{
+		binary: fdbbackupStr,
+		args: []string{
+			"describe",
+			"-d",
+			url,
+			"--json",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	descriptionBytes, err := fdbstatus.RemoveWarningsInJSON(output)
+	if err != nil {
+		return nil, err
+	}
+
+	description := &fdbv1beta2.FoundationDBBackupDescription{}
+	err = json.Unmarshal(descriptionBytes, description)
+	if err != nil {
+		return nil, err
+	}
+
+	return description, nil
+}
+
 // StartRestore starts a new restore.
-func (client *cliAdminClient) StartRestore(url string, keyRanges []fdbv1beta2.FoundationDBKeyRange) error {
+func (client *cliAdminClient) StartRestore(url string, keyRanges []fdbv1beta2.FoundationDBKeyRange, options fdbadminclient.RestoreOptions) error {
+	_, err := client.runCommand(cliCommand{
+		binary: fdbrestoreStr,
+		args:   client.getStartRestoreArgs(url, keyRanges, options),
+	})
+	return err
+}
+
+// getStartRestoreArgs returns the arguments for the fdbrestore start command.
+func (client *cliAdminClient) getStartRestoreArgs(url string, keyRanges []fdbv1beta2.FoundationDBKeyRange, options fdbadminclient.RestoreOptions) []string {
 	args := []string{
 		"start",
 		"-r",
@@ -635,23 +709,271 @@ func (client *cliAdminClient) StartRestore(url string, keyRanges []fdbv1beta2.Fo
 		}
 		args = append(args, "-k", keyRangeString)
 	}
+
+	if options.TargetVersion != nil {
+		args = append(args, "-v", strconv.FormatInt(*options.TargetVersion, 10))
+	}
+
+	// The timestamp is resolved to a version by the timekeeper of the original cluster, so timestamps are only
+	// supported if the backup was taken from the destination cluster.
+	if options.TargetTimestamp != nil {
+		args = append(args, "--timestamp", options.TargetTimestamp.UTC().Format(restoreTimestampFormat), "--orig_cluster_file", client.clusterFilePath)
+	}
+
+	if options.AddPrefix != "" {
+		args = append(args, "--add_prefix", options.AddPrefix)
+	}
+
+	if options.RemovePrefix != "" {
+		args = append(args, "--remove_prefix", options.RemovePrefix)
+	}
+
+	return args
+}
+
+// GetRestoreStatus gets the status of the current restore. If no restore was started, nil will be returned.
+func (client *cliAdminClient) GetRestoreStatus() (*fdbv1beta2.FoundationDBLiveRestoreStatus, error) {
+	output, err := client.runCommand(cliCommand{
+		binary: fdbrestoreStr,
+		args: []string{
+			"status",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fdbstatus.ParseRestoreStatus(output)
+}
+
+// AbortRestore aborts the current restore.
+func (client *cliAdminClient) AbortRestore() error {
 	_, err := client.runCommand(cliCommand{
 		binary: fdbrestoreStr,
-		args:   args,
+		args: []string{
+			"abort",
+		},
 	})
 	return err
 }
 
-// GetRestoreStatus gets the status of the current restore.
-func (client *cliAdminClient) GetRestoreStatus() (string, error) {
+// runDisasterRecoveryCommand runs a fdbdr command for the replication from the cluster with the provided connection
+// string into this cluster.
+func (client *cliAdminClient) runDisasterRecoveryCommand(command string, sourceConnectionString string) (string, error) {
+	sourceClusterFile, err := createClusterFileForConnectionString(sourceConnectionString)
+	if err != nil {
+		return "", err
+	}
+
 	return client.runCommand(cliCommand{
-		binary: fdbrestoreStr,
+		binary: fdbdrStr,
 		args: []string{
-			"status",
+			command,
+			"-s",
+			sourceClusterFile,
 		},
 	})
 }
 
+// StartDisasterRecovery starts replicating the cluster with the provided connection string into this cluster.
+func (client *cliAdminClient) StartDisasterRecovery(sourceConnectionString string) error {
+	_, err := client.runDisasterRecoveryCommand("start", sourceConnectionString)
+	return err
+}
+
+// GetDisasterRecoveryStatus gets the status of the replication from the cluster with the provided connection string
+// into this cluster. If no replication was started, nil will be returned.
+func (client *cliAdminClient) GetDisasterRecoveryStatus(sourceConnectionString string) (*fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus, error) {
+	output, err := client.runDisasterRecoveryCommand("status", sourceConnectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	return fdbstatus.ParseDisasterRecoveryStatus(output)
+}
+
+// PauseDisasterRecovery pauses the replication from the cluster with the provided connection string.
+func (client *cliAdminClient) PauseDisasterRecovery(sourceConnectionString string) error {
+	_, err := client.runDisasterRecoveryCommand("pause", sourceConnectionString)
+	return err
+}
+
+// ResumeDisasterRecovery resumes the replication from the cluster with the provided connection string.
+func (client *cliAdminClient) ResumeDisasterRecovery(sourceConnectionString string) error {
+	_, err := client.runDisasterRecoveryCommand("resume", sourceConnectionString)
+	return err
+}
+
+// AbortDisasterRecovery aborts the replication from the cluster with the provided connection string.
+func (client *cliAdminClient) AbortDisasterRecovery(sourceConnectionString string) error {
+	_, err := client.runDisasterRecoveryCommand("abort", sourceConnectionString)
+	return err
+}
+
+// SwitchDisasterRecovery makes this cluster the active cluster and reverses the replication from the cluster with
+// the provided connection string.
+func (client *cliAdminClient) SwitchDisasterRecovery(sourceConnectionString string) error {
+	_, err := client.runDisasterRecoveryCommand("switch", sourceConnectionString)
+	return err
+}
+
+// getTenantCommand returns the fdbcli command to run the provided tenant operation. Versions before 7.2 use separate
+// commands for each operation, e.g. createtenant instead of tenant create.
+func (client *cliAdminClient) getTenantCommand(operation string) (string, error) {
+	version, err := fdbv1beta2.ParseFdbVersion(client.Cluster.GetRunningVersion())
+	if err != nil {
+		return "", err
+	}
+
+	if !version.SupportsTenants() {
+		return "", fmt.Errorf("tenants are not supported on version %s", version)
+	}
+
+	if version.HasTenantCommand() {
+		return "tenant " + operation, nil
+	}
+
+	if operation == "list" {
+		return "listtenants", nil
+	}
+
+	return operation + "tenant", nil
+}
+
+// CreateTenant creates a tenant with the provided name.
+func (client *cliAdminClient) CreateTenant(name string) error {
+	command, err := client.getTenantCommand("create")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.runCommand(cliCommand{command: fmt.Sprintf("%s %s", command, name)})
+	return err
+}
+
+// ListTenants returns the names of all tenants in the database.
+func (client *cliAdminClient) ListTenants() ([]string, error) {
+	command, err := client.getTenantCommand("list")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.runCommand(cliCommand{command: command})
+	if err != nil {
+		return nil, err
+	}
+
+	return fdbstatus.ParseTenantList(output), nil
+}
+
+// GetTenant gets the status of the tenant with the provided name. If the tenant doesn't exist, nil will be
+// returned.
+func (client *cliAdminClient) GetTenant(name string) (*fdbv1beta2.FoundationDBLiveTenantStatus, error) {
+	// fdbcli returns an error if the tenant doesn't exist, so we check the tenant list first to distinguish a missing
+	// tenant from other errors.
+	tenants, err := client.ListTenants()
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, tenant := range tenants {
+		if tenant == name {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	command, err := client.getTenantCommand("get")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.runCommand(cliCommand{command: fmt.Sprintf("%s %s JSON", command, name)})
+	if err != nil {
+		return nil, err
+	}
+
+	return fdbstatus.ParseTenantStatus(output)
+}
+
+// IsTenantEmpty returns true if the tenant with the provided name doesn't contain any keys.
+func (client *cliAdminClient) IsTenantEmpty(name string) (bool, error) {
+	output, err := client.runCommand(cliCommand{command: fmt.Sprintf("usetenant %s; getrange \"\" \\xff 1", name)})
+	if err != nil {
+		return false, err
+	}
+
+	// Each key of the range is printed as `key' is `value'.
+	return !strings.Contains(output, "' is `"), nil
+}
+
+// DeleteTenant deletes the tenant with the provided name. If force is true, all keys of the tenant will be cleared
+// before the tenant is deleted.
+func (client *cliAdminClient) DeleteTenant(name string, force bool) error {
+	command, err := client.getTenantCommand("delete")
+	if err != nil {
+		return err
+	}
+
+	command = fmt.Sprintf("%s %s", command, name)
+	if force {
+		command = fmt.Sprintf("usetenant %s; writemode on; clearrange \"\" \\xff; defaulttenant; %s", name, command)
+	}
+
+	_, err = client.runCommand(cliCommand{command: command})
+	return err
+}
+
+// SetServerKnobs sets the provided knobs for the provided config class in the configuration database. The knobs
+// will be applied to the running fdbserver processes of the config class without a restart.
+func (client *cliAdminClient) SetServerKnobs(configClass string, knobs []fdbv1beta2.FoundationDBKnob) error {
+	if len(knobs) == 0 {
+		return nil
+	}
+
+	commands := make([]string, 0, len(knobs))
+	for _, knob := range knobs {
+		commands = append(commands, fmt.Sprintf("setknob %s %s %s", knob.Name, knob.Value, configClass))
+	}
+
+	return client.runKnobTransaction(commands, fmt.Sprintf("set knobs for %s", configClass))
+}
+
+// ClearServerKnobs removes the knobs with the provided names for the provided config class from the configuration
+// database.
+func (client *cliAdminClient) ClearServerKnobs(configClass string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	commands := make([]string, 0, len(names))
+	for _, name := range names {
+		commands = append(commands, fmt.Sprintf("clearknob %s %s", name, configClass))
+	}
+
+	return client.runKnobTransaction(commands, fmt.Sprintf("clear knobs for %s", configClass))
+}
+
+// runKnobTransaction runs the provided knob commands in a single configuration database transaction.
+func (client *cliAdminClient) runKnobTransaction(commands []string, description string) error {
+	version, err := fdbv1beta2.ParseFdbVersion(client.Cluster.GetRunningVersion())
+	if err != nil {
+		return err
+	}
+
+	if !version.SupportsDynamicKnobs() {
+		return fmt.Errorf("dynamic knobs are not supported on version %s", version)
+	}
+
+	_, err = client.runCommand(cliCommand{command: fmt.Sprintf("begin; %s; commit \"%s\"", strings.Join(commands, "; "), description)})
+	return err
+}
+
 // Close cleans up any pending resources.
 func (client *cliAdminClient) Close() error {
 	// Allow to reuse the same file.
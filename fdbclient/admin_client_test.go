@@ -34,6 +34,7 @@ import (
 	"github.com/go-logr/logr"
 
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbadminclient"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -426,6 +427,30 @@ var _ = Describe("admin_client_test", func() {
 			},
 			1*time.Second,
 		),
+		// Tests for fdbdr
+		Entry("using fdbdr and trace options are disabled",
+			cliCommand{
+				binary:  fdbdrStr,
+				args:    []string{"status", "-s", "source"},
+				version: "7.1.25",
+				timeout: 1 * time.Second,
+			},
+			&cliAdminClient{
+				Cluster:         nil,
+				clusterFilePath: "test",
+				log:             logr.Discard(),
+			},
+			"",
+			"",
+			[]string{
+				"status",
+				"-s",
+				"source",
+				"-d",
+				"test",
+			},
+			1*time.Second,
+		),
 		// Tests for fdbbackup
 		Entry("using fdbbackup and trace options are disabled",
 			cliCommand{
@@ -1167,5 +1192,127 @@ protocol fdb00b071010000`,
 		})
 	})
 
+	DescribeTable("getting the args to expire a backup", func(retention fdbv1beta2.BackupRetention, expectedArgs []string) {
+		Expect(getExpireBackupArgs("blobstore://test@test-service/test-backup?bucket=fdb-backups", retention)).To(Equal(expectedArgs))
+	},
+		Entry("with min restorable days",
+			fdbv1beta2.BackupRetention{
+				MinRestorableDays: pointer.Int(7),
+			},
+			[]string{
+				"expire",
+				"-d",
+				"blobstore://test@test-service/test-backup?bucket=fdb-backups",
+				"--min-restorable-days",
+				"7",
+			}),
+		Entry("with delete before days and min restorable days",
+			fdbv1beta2.BackupRetention{
+				MinRestorableDays: pointer.Int(7),
+				DeleteBeforeDays:  pointer.Int(30),
+			},
+			[]string{
+				"expire",
+				"-d",
+				"blobstore://test@test-service/test-backup?bucket=fdb-backups",
+				"--delete-before-days",
+				"30",
+				"--min-restorable-days",
+				"7",
+			}),
+	)
+
+	restoreTimestamp := time.Date(2023, 3, 14, 13, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	DescribeTable("getting the args to start a restore", func(keyRanges []fdbv1beta2.FoundationDBKeyRange, options fdbadminclient.RestoreOptions, expectedArgs []string) {
+		client := &cliAdminClient{
+			clusterFilePath: "/tmp/test",
+		}
+
+		Expect(client.getStartRestoreArgs("blobstore://test@test-service/test-backup?bucket=fdb-backups", keyRanges, options)).To(Equal(expectedArgs))
+	},
+		Entry("without options",
+			nil,
+			fdbadminclient.RestoreOptions{},
+			[]string{
+				"start",
+				"-r",
+				"blobstore://test@test-service/test-backup?bucket=fdb-backups",
+			}),
+		Entry("with a target version and prefixes",
+			[]fdbv1beta2.FoundationDBKeyRange{
+				{
+					Start: "app/",
+					End:   "app0",
+				},
+			},
+			fdbadminclient.RestoreOptions{
+				TargetVersion: pointer.Int64(1000),
+				AddPrefix:     "restored/",
+				RemovePrefix:  "app/",
+			},
+			[]string{
+				"start",
+				"-r",
+				"blobstore://test@test-service/test-backup?bucket=fdb-backups",
+				"-k",
+				"app/ app0",
+				"-v",
+				"1000",
+				"--add_prefix",
+				"restored/",
+				"--remove_prefix",
+				"app/",
+			}),
+		Entry("with a target timestamp",
+			nil,
+			fdbadminclient.RestoreOptions{
+				TargetTimestamp: &restoreTimestamp,
+			},
+			[]string{
+				"start",
+				"-r",
+				"blobstore://test@test-service/test-backup?bucket=fdb-backups",
+				"--timestamp",
+				"2023/03/14.12:30:00+0000",
+				"--orig_cluster_file",
+				"/tmp/test",
+			}),
+	)
+
+	DescribeTable("getting the tenant command", func(version string, operation string, expected string) {
+		client := &cliAdminClient{
+			Cluster: &fdbv1beta2.FoundationDBCluster{
+				Spec: fdbv1beta2.FoundationDBClusterSpec{
+					Version: version,
+				},
+			},
+		}
+
+		command, err := client.getTenantCommand(operation)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(command).To(Equal(expected))
+	},
+		Entry("creating a tenant with 7.1", "7.1.27", "create", "createtenant"),
+		Entry("listing the tenants with 7.1", "7.1.27", "list", "listtenants"),
+		Entry("creating a tenant with 7.3", "7.3.15", "create", "tenant create"),
+		Entry("listing the tenants with 7.3", "7.3.15", "list", "tenant list"),
+	)
+
+	When("getting the tenant command for a version without tenant support", func() {
+		It("should return an error", func() {
+			client := &cliAdminClient{
+				Cluster: &fdbv1beta2.FoundationDBCluster{
+					Spec: fdbv1beta2.FoundationDBClusterSpec{
+						Version: "6.3.25",
+					},
+				},
+			}
+
+			_, err := client.getTenantCommand("create")
+			Expect(err).To(MatchError("tenants are not supported on version 6.3.25"))
+		})
+	})
+
 	// TODO(johscheuer): Add test case for timeout.
 })
@@ -21,6 +21,7 @@
 package fdbclient
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -108,6 +109,12 @@ func createClusterFile(cluster *fdbv1beta2.FoundationDBCluster) (string, error)
 	return ensureClusterFileIsPresent(os.TempDir(), string(cluster.UID), cluster.Status.ConnectionString)
 }
 
+// createClusterFileForConnectionString will create or update a cluster file for a cluster that is only known by its
+// connection string.
+func createClusterFileForConnectionString(connectionString string) (string, error) {
+	return ensureClusterFileIsPresent(os.TempDir(), fmt.Sprintf("%x", sha256.Sum256([]byte(connectionString))), connectionString)
+}
+
 // ensureClusterFileIsPresent will ensure that the cluster file with the specified connection string is present.
 func ensureClusterFileIsPresent(dir string, uid string, connectionString string) (string, error) {
 	clusterFileName := path.Join(dir, uid)
@@ -0,0 +1,105 @@
+/*
+ * cluster_defaulter.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/mutate-apps-foundationdb-org-v1beta2-foundationdbcluster,mutating=true,failurePolicy=fail,sideEffects=None,groups=apps.foundationdb.org,resources=foundationdbclusters,verbs=create;update,versions=v1beta2,name=mfoundationdbcluster.kb.io,admissionReviewVersions=v1
+
+// ClusterDefaulter materializes the defaults that the operator would compute during reconciliation into the stored
+// FoundationDBCluster spec.
+type ClusterDefaulter struct {
+	// DeprecationOptions defines the deprecation options that should be used when normalizing the cluster spec.
+	DeprecationOptions DeprecationOptions
+}
+
+var _ admission.CustomDefaulter = &ClusterDefaulter{}
+
+// Default implements the admission.CustomDefaulter interface.
+func (defaulter *ClusterDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	cluster, ok := obj.(*fdbv1beta2.FoundationDBCluster)
+	if !ok {
+		return fmt.Errorf("expected a FoundationDBCluster but got %T", obj)
+	}
+
+	return MaterializeClusterDefaults(cluster, defaulter.DeprecationOptions)
+}
+
+// MaterializeClusterDefaults writes the effective defaults of the cluster into the spec. This includes the
+// normalization of deprecated fields, the database configuration defaults, the role counts and the process counts.
+// Fields that are already set in the spec will not be changed, so a change of the defaults in a later operator
+// version will not change the stored spec of an existing cluster.
+func MaterializeClusterDefaults(cluster *fdbv1beta2.FoundationDBCluster, options DeprecationOptions) error {
+	err := NormalizeClusterSpec(cluster, options)
+	if err != nil {
+		return err
+	}
+
+	if cluster.Spec.DatabaseConfiguration.RedundancyMode == fdbv1beta2.RedundancyModeUnset {
+		cluster.Spec.DatabaseConfiguration.RedundancyMode = fdbv1beta2.RedundancyModeDouble
+	}
+
+	if cluster.Spec.DatabaseConfiguration.StorageEngine == "" {
+		cluster.Spec.DatabaseConfiguration.StorageEngine = fdbv1beta2.StorageEngineSSD2
+	}
+
+	if cluster.Spec.DatabaseConfiguration.UsableRegions < 1 {
+		cluster.Spec.DatabaseConfiguration.UsableRegions = 1
+	}
+
+	// The desired database configuration will only contain the role counts that are relevant for the running version,
+	// e.g. the proxies will be unset if separated proxies are configured.
+	configuredRoleCounts := cluster.Spec.DatabaseConfiguration.RoleCounts
+	cluster.Spec.DatabaseConfiguration.RoleCounts = cluster.DesiredDatabaseConfiguration().RoleCounts
+
+	// The role counts are used as the default minimum of the stateless autoscaling, so we keep the user provided
+	// values and let the autoscaling manage the actual counts.
+	if cluster.UseStatelessAutoscaling() {
+		cluster.Spec.DatabaseConfiguration.RoleCounts.CommitProxies = configuredRoleCounts.CommitProxies
+		cluster.Spec.DatabaseConfiguration.RoleCounts.GrvProxies = configuredRoleCounts.GrvProxies
+		cluster.Spec.DatabaseConfiguration.RoleCounts.Resolvers = configuredRoleCounts.Resolvers
+	}
+
+	processCounts, err := cluster.GetProcessCountsWithDefaults()
+	if err != nil {
+		return err
+	}
+
+	// The storage process count is used as the default minimum of the storage autoscaling, so we keep the user provided
+	// value and let the autoscaling manage the actual count.
+	if cluster.UseStorageAutoscaling() {
+		processCounts.Storage = cluster.Spec.ProcessCounts.Storage
+	}
+
+	if cluster.UseStatelessAutoscaling() {
+		processCounts.Stateless = cluster.Spec.ProcessCounts.Stateless
+	}
+	cluster.Spec.ProcessCounts = processCounts
+
+	return nil
+}
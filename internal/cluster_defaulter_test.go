@@ -0,0 +1,112 @@
+/*
+ * cluster_defaulter_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"context"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[internal] cluster defaulter", func() {
+	var cluster *fdbv1beta2.FoundationDBCluster
+
+	BeforeEach(func() {
+		cluster = &fdbv1beta2.FoundationDBCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "operator-test-1",
+			},
+			Spec: fdbv1beta2.FoundationDBClusterSpec{
+				Version: fdbv1beta2.Versions.Default.String(),
+			},
+		}
+	})
+
+	When("materializing the defaults of a cluster without any settings", func() {
+		BeforeEach(func() {
+			Expect(MaterializeClusterDefaults(cluster, DeprecationOptions{})).NotTo(HaveOccurred())
+		})
+
+		It("should set the database configuration defaults", func() {
+			Expect(cluster.Spec.DatabaseConfiguration.RedundancyMode).To(Equal(fdbv1beta2.RedundancyModeDouble))
+			Expect(cluster.Spec.DatabaseConfiguration.StorageEngine).To(Equal(fdbv1beta2.StorageEngineSSD2))
+			Expect(cluster.Spec.DatabaseConfiguration.UsableRegions).To(Equal(1))
+		})
+
+		It("should set the role counts", func() {
+			Expect(cluster.Spec.DatabaseConfiguration.RoleCounts).To(Equal(fdbv1beta2.RoleCounts{
+				Logs:       3,
+				Proxies:    3,
+				Resolvers:  1,
+				RemoteLogs: -1,
+				LogRouters: -1,
+			}))
+		})
+
+		It("should set the process counts", func() {
+			Expect(cluster.Spec.ProcessCounts).To(Equal(fdbv1beta2.ProcessCounts{
+				Storage:   3,
+				Log:       4,
+				Stateless: 9,
+			}))
+		})
+
+		It("should set the resource requirements for the main container", func() {
+			mainContainer := cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral].PodTemplate.Spec.Containers[0]
+			Expect(mainContainer.Name).To(Equal(fdbv1beta2.MainContainerName))
+			Expect(mainContainer.Resources.Requests).To(HaveKey(corev1.ResourceCPU))
+		})
+
+		It("should not change the spec when materializing the defaults again", func() {
+			materialized := cluster.DeepCopy()
+			Expect(MaterializeClusterDefaults(cluster, DeprecationOptions{})).NotTo(HaveOccurred())
+			Expect(equality.Semantic.DeepEqual(cluster.Spec, materialized.Spec)).To(BeTrue())
+		})
+	})
+
+	When("materializing the defaults of a cluster with custom counts", func() {
+		BeforeEach(func() {
+			cluster.Spec.DatabaseConfiguration.RedundancyMode = fdbv1beta2.RedundancyModeTriple
+			cluster.Spec.DatabaseConfiguration.RoleCounts.Logs = 5
+			cluster.Spec.ProcessCounts.Storage = 10
+			Expect(MaterializeClusterDefaults(cluster, DeprecationOptions{})).NotTo(HaveOccurred())
+		})
+
+		It("should keep the custom values", func() {
+			Expect(cluster.Spec.DatabaseConfiguration.RedundancyMode).To(Equal(fdbv1beta2.RedundancyModeTriple))
+			Expect(cluster.Spec.DatabaseConfiguration.RoleCounts.Logs).To(Equal(5))
+			Expect(cluster.Spec.ProcessCounts.Storage).To(Equal(10))
+			Expect(cluster.Spec.ProcessCounts.Log).To(Equal(7))
+		})
+	})
+
+	When("using the defaulter with a different object", func() {
+		It("should return an error", func() {
+			defaulter := &ClusterDefaulter{}
+			Expect(defaulter.Default(context.Background(), &fdbv1beta2.FoundationDBBackup{})).To(HaveOccurred())
+		})
+	})
+})
@@ -33,6 +33,14 @@ import (
 const (
 	// ClusterFileKey defines the key name in the ConfigMap
 	ClusterFileKey = "cluster-file"
+
+	// SourceClusterFileKey defines the key name of the cluster file of the active cluster in the ConfigMap for the
+	// DR agents.
+	SourceClusterFileKey = "source-cluster-file"
+
+	// DestinationClusterFileKey defines the key name of the cluster file of the cluster that receives the replicated
+	// data in the ConfigMap for the DR agents.
+	DestinationClusterFileKey = "destination-cluster-file"
 )
 
 // GetConfigMap builds a config map for a cluster's dynamic config
@@ -144,6 +152,41 @@ func getConfigMapMetadata(cluster *fdbv1beta2.FoundationDBCluster) metav1.Object
 	return metadata
 }
 
+// GetPlanConfigMap builds the config map that contains the plan of a cluster in dry-run mode.
+func GetPlanConfigMap(cluster *fdbv1beta2.FoundationDBCluster, plan *fdbv1beta2.ReconciliationPlan) (*corev1.ConfigMap, error) {
+	planData, err := json.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := GetObjectMetadata(cluster, nil, "", "")
+	metadata.Name = cluster.GetPlanConfigMapName()
+	metadata.OwnerReferences = BuildOwnerReference(cluster.TypeMeta, cluster.ObjectMeta)
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metadata,
+		Data: map[string]string{
+			fdbv1beta2.PlanConfigMapKey: string(planData),
+		},
+	}, nil
+}
+
+// GetDisasterRecoveryConfigMap builds the config map that contains the cluster files for the DR agents.
+func GetDisasterRecoveryConfigMap(dr *fdbv1beta2.FoundationDBDisasterRecovery, sourceConnectionString string, destinationConnectionString string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       dr.Namespace,
+			Name:            fmt.Sprintf("%s-dr-config", dr.Name),
+			Labels:          map[string]string{fdbv1beta2.DisasterRecoveryDeploymentLabel: string(dr.UID)},
+			OwnerReferences: BuildOwnerReference(dr.TypeMeta, dr.ObjectMeta),
+		},
+		Data: map[string]string{
+			SourceClusterFileKey:      sourceConnectionString,
+			DestinationClusterFileKey: destinationConnectionString,
+		},
+	}
+}
+
 func getDataForMonitorConf(cluster *fdbv1beta2.FoundationDBCluster, imageType FDBImageType, pClass fdbv1beta2.ProcessClass, serversPerPod int) (string, []byte, error) {
 	config, err := GetMonitorProcessConfiguration(cluster, pClass, serversPerPod, imageType, nil)
 	if err != nil {
@@ -165,7 +165,7 @@ func getMonitorConfStartCommandLines(cluster *fdbv1beta2.FoundationDBCluster, pr
 // GetMonitorProcessConfiguration builds the monitor conf template for the unified image.
 func GetMonitorProcessConfiguration(cluster *fdbv1beta2.FoundationDBCluster, processClass fdbv1beta2.ProcessClass, processCount int, imageType FDBImageType, customParameterSubstitutions map[string]string) (monitorapi.ProcessConfiguration, error) {
 	configuration := monitorapi.ProcessConfiguration{
-		Version: cluster.Spec.Version,
+		Version: cluster.GetDesiredVersion(),
 	}
 
 	if cluster.Status.ConnectionString == "" {
@@ -253,6 +253,24 @@ func GetMonitorProcessConfiguration(cluster *fdbv1beta2.FoundationDBCluster, pro
 		}
 	}
 
+	if len(podSettings.Knobs) > 0 {
+		// The live-updatable knobs are only applied through the configuration database once the running version
+		// supports it, so during an upgrade to a version with dynamic knobs all knobs are passed as command line
+		// arguments.
+		version, err := fdbv1beta2.ParseFdbVersion(cluster.GetRunningVersion())
+		if err != nil {
+			return configuration, err
+		}
+
+		// Live-updatable knobs are applied through the configuration database, so only the knobs that require a
+		// restart are passed as command line arguments. As long as the processes are not started with the
+		// configuration database, all knobs require a restart.
+		_, restartKnobs := fdbv1beta2.SplitKnobsByRestart(podSettings.Knobs, version)
+		for _, knob := range restartKnobs {
+			configuration.Arguments = append(configuration.Arguments, monitorapi.Argument{Value: getKnobParameterWithValue("knob_"+knob.Name, knob.Value, false)})
+		}
+	}
+
 	if cluster.Spec.DataCenter != "" {
 		configuration.Arguments = append(configuration.Arguments, monitorapi.Argument{Value: getKnobParameterWithValue(fdbv1beta2.FDBLocalityDCIDlKey, cluster.Spec.DataCenter, true)})
 	}
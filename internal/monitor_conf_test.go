@@ -1117,6 +1117,60 @@ var _ = Describe("monitor_conf", func() {
 			})
 		})
 
+		Context("with knobs", func() {
+			BeforeEach(func() {
+				cluster.Spec.Processes = map[fdbv1beta2.ProcessClass]fdbv1beta2.ProcessSettings{
+					fdbv1beta2.ProcessClassGeneral: {Knobs: []fdbv1beta2.FoundationDBKnob{
+						{Name: "target_bytes_per_storage_server", Value: "1000000000"},
+						{Name: "max_trace_lines", Value: "100000"},
+					}},
+				}
+			})
+
+			JustBeforeEach(func() {
+				conf, err = GetMonitorConf(cluster, fdbv1beta2.ProcessClassStorage, nil, cluster.GetStorageServersPerPod())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should include all knobs", func() {
+				Expect(conf).To(HaveSuffix(strings.Join([]string{
+					"locality_zoneid = $FDB_ZONE_ID",
+					"knob_max_trace_lines = 100000",
+					"knob_target_bytes_per_storage_server = 1000000000",
+				}, "\n")))
+			})
+
+			When("the version supports dynamic knobs", func() {
+				BeforeEach(func() {
+					cluster.Spec.Version = fdbv1beta2.Versions.SupportsDynamicKnobs.String()
+					cluster.Status.RunningVersion = fdbv1beta2.Versions.SupportsDynamicKnobs.String()
+				})
+
+				It("should include all knobs as long as the configuration database is not enabled", func() {
+					Expect(conf).To(HaveSuffix(strings.Join([]string{
+						"locality_zoneid = $FDB_ZONE_ID",
+						"knob_max_trace_lines = 100000",
+						"knob_target_bytes_per_storage_server = 1000000000",
+					}, "\n")))
+				})
+			})
+
+			When("the cluster is upgraded to a version that supports dynamic knobs", func() {
+				BeforeEach(func() {
+					cluster.Spec.Version = fdbv1beta2.Versions.SupportsDynamicKnobs.String()
+					cluster.Status.RunningVersion = fdbv1beta2.Versions.Default.String()
+				})
+
+				It("should include all knobs until the upgrade is done", func() {
+					Expect(conf).To(HaveSuffix(strings.Join([]string{
+						"locality_zoneid = $FDB_ZONE_ID",
+						"knob_max_trace_lines = 100000",
+						"knob_target_bytes_per_storage_server = 1000000000",
+					}, "\n")))
+				})
+			})
+		})
+
 		Context("with an alternative fault domain variable", func() {
 			BeforeEach(func() {
 				cluster.Spec.FaultDomain = fdbv1beta2.FoundationDBClusterFaultDomain{
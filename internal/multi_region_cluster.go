@@ -0,0 +1,97 @@
+/*
+ * multi_region_cluster.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"fmt"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// GetMultiRegionClusterMember returns the desired FoundationDBCluster for the provided data center of the multi-region
+// cluster. Only the cluster of the configuring data center is allowed to change the database configuration. If
+// bootstrap is true, the database configuration will only contain the provided data center, which allows to create the
+// database before the other data centers join the cluster.
+func GetMultiRegionClusterMember(mrc *fdbv1beta2.FoundationDBMultiRegionCluster, dcID string, seedConnectionString string, bootstrap bool) (*fdbv1beta2.FoundationDBCluster, error) {
+	dc := mrc.GetDataCenter(dcID)
+	spec := mrc.Spec.ClusterSpec.DeepCopy()
+
+	spec.DataCenter = dcID
+	spec.SeedConnectionString = seedConnectionString
+	if spec.ProcessGroupIDPrefix == "" {
+		spec.ProcessGroupIDPrefix = dcID
+	} else {
+		spec.ProcessGroupIDPrefix = fmt.Sprintf("%s-%s", spec.ProcessGroupIDPrefix, dcID)
+	}
+
+	if dc.ProcessCounts != nil {
+		spec.ProcessCounts = *dc.ProcessCounts
+	}
+
+	if bootstrap {
+		spec.DatabaseConfiguration.UsableRegions = 1
+		spec.DatabaseConfiguration.Regions = []fdbv1beta2.Region{
+			{
+				DataCenters: []fdbv1beta2.DataCenter{
+					{
+						ID: dcID,
+					},
+				},
+			},
+		}
+	}
+
+	if dcID != mrc.Status.ConfiguringDataCenter {
+		spec.AutomationOptions.ConfigureDatabase = pointer.Bool(false)
+	}
+
+	labels := make(map[string]string, len(dc.Labels)+1)
+	for key, value := range dc.Labels {
+		labels[key] = value
+	}
+	labels[fdbv1beta2.MultiRegionClusterLabel] = mrc.Name
+
+	cluster := &fdbv1beta2.FoundationDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        mrc.GetClusterName(dcID),
+			Namespace:   mrc.GetClusterNamespace(dcID),
+			Labels:      labels,
+			Annotations: map[string]string{},
+		},
+		Spec: *spec,
+	}
+
+	// Owner references are only allowed in the same namespace.
+	if cluster.Namespace == mrc.Namespace {
+		cluster.ObjectMeta.OwnerReferences = BuildOwnerReference(mrc.TypeMeta, mrc.ObjectMeta)
+	}
+
+	specHash, err := GetJSONHash(cluster.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey] = specHash
+
+	return cluster, nil
+}
@@ -0,0 +1,137 @@
+/*
+ * multi_region_cluster_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("multi_region_cluster", func() {
+	var mrc *fdbv1beta2.FoundationDBMultiRegionCluster
+	var cluster *fdbv1beta2.FoundationDBCluster
+	var dcID string
+	var bootstrap bool
+	var err error
+
+	BeforeEach(func() {
+		mrc = CreateDefaultMultiRegionCluster()
+		mrc.UID = "mrc-uid"
+		mrc.Status.ConfiguringDataCenter = "primary"
+		dcID = "primary"
+		bootstrap = false
+	})
+
+	JustBeforeEach(func() {
+		cluster, err = GetMultiRegionClusterMember(mrc, dcID, "test:test@127.0.0.1:4501", bootstrap)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("generating the cluster of the configuring data center", func() {
+		It("should set the metadata", func() {
+			Expect(cluster.Name).To(Equal("operator-test-mrc-primary"))
+			Expect(cluster.Namespace).To(Equal(mrc.Namespace))
+			Expect(cluster.Labels).To(Equal(map[string]string{
+				fdbv1beta2.MultiRegionClusterLabel: mrc.Name,
+			}))
+			Expect(cluster.OwnerReferences).To(HaveLen(1))
+			Expect(cluster.OwnerReferences[0].UID).To(Equal(mrc.UID))
+			Expect(cluster.Annotations).To(HaveKey(fdbv1beta2.LastSpecKey))
+		})
+
+		It("should set the data center specific fields", func() {
+			Expect(cluster.Spec.DataCenter).To(Equal("primary"))
+			Expect(cluster.Spec.ProcessGroupIDPrefix).To(Equal("primary"))
+			Expect(cluster.Spec.SeedConnectionString).To(Equal("test:test@127.0.0.1:4501"))
+			Expect(cluster.Spec.AutomationOptions.ConfigureDatabase).To(BeNil())
+			Expect(cluster.Spec.DatabaseConfiguration).To(Equal(mrc.Spec.ClusterSpec.DatabaseConfiguration))
+			Expect(cluster.Spec.ProcessCounts).To(Equal(mrc.Spec.ClusterSpec.ProcessCounts))
+		})
+
+		When("the cluster is bootstrapped", func() {
+			BeforeEach(func() {
+				bootstrap = true
+			})
+
+			It("should only configure a single region", func() {
+				Expect(cluster.Spec.DatabaseConfiguration.UsableRegions).To(Equal(1))
+				Expect(cluster.Spec.DatabaseConfiguration.Regions).To(Equal([]fdbv1beta2.Region{
+					{
+						DataCenters: []fdbv1beta2.DataCenter{
+							{
+								ID: "primary",
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		When("a process group ID prefix is defined", func() {
+			BeforeEach(func() {
+				mrc.Spec.ClusterSpec.ProcessGroupIDPrefix = "dev"
+			})
+
+			It("should append the data center", func() {
+				Expect(cluster.Spec.ProcessGroupIDPrefix).To(Equal("dev-primary"))
+			})
+		})
+	})
+
+	When("generating the cluster of another data center", func() {
+		BeforeEach(func() {
+			dcID = "remote-satellite"
+			mrc.Spec.DataCenters = []fdbv1beta2.MultiRegionDataCenter{
+				{
+					ID:        "remote-satellite",
+					Namespace: "satellite-ns",
+					Labels:    map[string]string{"dc": "remote-satellite"},
+					ProcessCounts: &fdbv1beta2.ProcessCounts{
+						Log: 4,
+					},
+				},
+			}
+		})
+
+		It("should not allow to change the database configuration", func() {
+			Expect(cluster.Spec.AutomationOptions.ConfigureDatabase).To(Equal(pointer.Bool(false)))
+		})
+
+		It("should apply the data center settings", func() {
+			Expect(cluster.Name).To(Equal("operator-test-mrc-remote-satellite"))
+			Expect(cluster.Namespace).To(Equal("satellite-ns"))
+			Expect(cluster.Labels).To(Equal(map[string]string{
+				fdbv1beta2.MultiRegionClusterLabel: mrc.Name,
+				"dc":                               "remote-satellite",
+			}))
+			Expect(cluster.OwnerReferences).To(BeEmpty())
+			Expect(cluster.Spec.ProcessCounts).To(Equal(fdbv1beta2.ProcessCounts{Log: 4}))
+		})
+
+		It("should generate a different spec hash", func() {
+			primary, err := GetMultiRegionClusterMember(mrc, "primary", "test:test@127.0.0.1:4501", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cluster.Annotations[fdbv1beta2.LastSpecKey]).NotTo(Equal(primary.Annotations[fdbv1beta2.LastSpecKey]))
+		})
+	})
+})
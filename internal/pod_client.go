@@ -238,7 +238,7 @@ func (client *realFdbPodSidecarClient) makeRequest(method, path string) (string,
 
 // IsPresent checks whether a file in the sidecar is present.
 func (client *realFdbPodSidecarClient) IsPresent(filename string) (bool, error) {
-	version, err := fdbv1beta2.ParseFdbVersion(client.Cluster.Spec.Version)
+	version, err := fdbv1beta2.ParseFdbVersion(client.Cluster.GetDesiredVersion())
 	if err != nil {
 		return false, err
 	}
@@ -486,7 +486,7 @@ func GetSubstitutionsFromClusterAndPod(logger logr.Logger, cluster *fdbv1beta2.F
 	substitutions["FDB_INSTANCE_ID"] = string(GetProcessGroupIDFromMeta(cluster, pod.ObjectMeta))
 
 	if cluster.IsBeingUpgradedWithVersionIncompatibleVersion() {
-		substitutions["BINARY_DIR"] = fmt.Sprintf("/var/dynamic-conf/bin/%s", cluster.Spec.Version)
+		substitutions["BINARY_DIR"] = fmt.Sprintf("/var/dynamic-conf/bin/%s", cluster.GetDesiredVersion())
 	} else {
 		substitutions["BINARY_DIR"] = "/usr/bin"
 	}
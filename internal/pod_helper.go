@@ -221,7 +221,7 @@ func GetSidecarImage(cluster *fdbv1beta2.FoundationDBCluster, pClass fdbv1beta2.
 		imageConfigs = cluster.Spec.SidecarContainer.ImageConfigs
 	}
 
-	return GetImage(image, imageConfigs, cluster.Spec.Version, false)
+	return GetImage(image, imageConfigs, cluster.GetDesiredVersion(), false)
 }
 
 // GetPublicIPSource determines how a Pod has gotten its public IP.
@@ -22,6 +22,7 @@ package internal
 
 import (
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 
@@ -34,6 +35,16 @@ import (
 	"k8s.io/utils/pointer"
 )
 
+const (
+	// disasterRecoverySourceClusterFile defines the file name of the cluster file of the active cluster for the DR
+	// agents.
+	disasterRecoverySourceClusterFile = "source.cluster"
+
+	// disasterRecoveryDestinationClusterFile defines the file name of the cluster file of the cluster that receives
+	// the replicated data for the DR agents.
+	disasterRecoveryDestinationClusterFile = "destination.cluster"
+)
+
 // GetProcessGroupIDFromPodName returns the process group ID for a given Pod name.
 func GetProcessGroupIDFromPodName(cluster *fdbv1beta2.FoundationDBCluster, podName string) fdbv1beta2.ProcessGroupID {
 	tmpName := strings.ReplaceAll(podName, cluster.Name, "")[1:]
@@ -402,7 +413,7 @@ func GetPodSpec(cluster *fdbv1beta2.FoundationDBCluster, processGroup *fdbv1beta
 
 	desiredVersion := cluster.GetRunningVersion()
 	if cluster.VersionCompatibleUpgradeInProgress() {
-		desiredVersion = cluster.Spec.Version
+		desiredVersion = cluster.GetDesiredVersion()
 	}
 
 	image, err := GetImage(mainContainer.Image, cluster.Spec.MainContainer.ImageConfigs, desiredVersion, false)
@@ -492,25 +503,31 @@ func GetPodSpec(cluster *fdbv1beta2.FoundationDBCluster, processGroup *fdbv1beta
 // configureSidecarContainerForCluster sets up a sidecar container for a sidecar
 // in the FDB cluster.
 func configureSidecarContainerForCluster(cluster *fdbv1beta2.FoundationDBCluster, podName string, container *corev1.Container, initMode bool, processGroupID fdbv1beta2.ProcessGroupID, fdbVersion string) error {
-	return configureSidecarContainer(container, initMode, processGroupID, podName, fdbVersion, cluster, cluster.Spec.SidecarContainer.ImageConfigs, false)
+	return configureSidecarContainer(container, initMode, processGroupID, podName, fdbVersion, cluster, cluster.Spec.SidecarContainer.ImageConfigs, false, []string{"fdb.cluster"})
 }
 
 // configureSidecarContainerForBackup sets up a sidecar container for the init
 // container for a backup process.
 func configureSidecarContainerForBackup(backup *fdbv1beta2.FoundationDBBackup, container *corev1.Container) error {
-	return configureSidecarContainer(container, true, "", "", backup.Spec.Version, nil, backup.Spec.SidecarContainer.ImageConfigs, pointer.BoolDeref(backup.Spec.AllowTagOverride, false))
+	return configureSidecarContainer(container, true, "", "", backup.Spec.Version, nil, backup.Spec.SidecarContainer.ImageConfigs, pointer.BoolDeref(backup.Spec.AllowTagOverride, false), []string{"fdb.cluster"})
+}
+
+// configureSidecarContainerForDisasterRecovery sets up a sidecar container for
+// the init container for a DR agent process.
+func configureSidecarContainerForDisasterRecovery(dr *fdbv1beta2.FoundationDBDisasterRecovery, container *corev1.Container) error {
+	return configureSidecarContainer(container, true, "", "", dr.Spec.Version, nil, dr.Spec.SidecarContainer.ImageConfigs, dr.Spec.GetAllowTagOverride(), []string{disasterRecoverySourceClusterFile, disasterRecoveryDestinationClusterFile})
 }
 
 // configureSidecarContainer sets up a foundationdb-kubernetes-sidecar container.
-func configureSidecarContainer(container *corev1.Container, initMode bool, processGroupID fdbv1beta2.ProcessGroupID, podName string, versionString string, optionalCluster *fdbv1beta2.FoundationDBCluster, imageConfigs []fdbv1beta2.ImageConfig, allowTagOverride bool) error {
+// The provided cluster files will be copied from the input files.
+func configureSidecarContainer(container *corev1.Container, initMode bool, processGroupID fdbv1beta2.ProcessGroupID, podName string, versionString string, optionalCluster *fdbv1beta2.FoundationDBCluster, imageConfigs []fdbv1beta2.ImageConfig, allowTagOverride bool, clusterFiles []string) error {
 	sidecarEnv := make([]corev1.EnvVar, 0, 4)
 
 	hasTrustedCAs := optionalCluster != nil && len(optionalCluster.Spec.TrustedCAs) > 0
 
-	var sidecarArgs []string
-
-	sidecarArgs = []string{
-		"--copy-file", "fdb.cluster",
+	sidecarArgs := make([]string, 0, 2*len(clusterFiles))
+	for _, clusterFile := range clusterFiles {
+		sidecarArgs = append(sidecarArgs, "--copy-file", clusterFile)
 	}
 	if hasTrustedCAs {
 		sidecarArgs = append(sidecarArgs, "--copy-file", "ca.pem")
@@ -525,8 +542,10 @@ func configureSidecarContainer(container *corev1.Container, initMode bool, proce
 	}
 
 	if optionalCluster == nil {
-		sidecarArgs = append(sidecarArgs, "--require-not-empty")
-		sidecarArgs = append(sidecarArgs, "fdb.cluster")
+		for _, clusterFile := range clusterFiles {
+			sidecarArgs = append(sidecarArgs, "--require-not-empty")
+			sidecarArgs = append(sidecarArgs, clusterFile)
+		}
 	}
 
 	if optionalCluster != nil {
@@ -965,6 +984,172 @@ func GetBackupDeployment(backup *fdbv1beta2.FoundationDBBackup) (*appsv1.Deploym
 	return deployment, nil
 }
 
+// GetDisasterRecoveryDeployment returns the deployment for the DR agents. The agents read the cluster files from the
+// provided config map and will be restarted when the content of the config map changes.
+func GetDisasterRecoveryDeployment(dr *fdbv1beta2.FoundationDBDisasterRecovery, configMap *corev1.ConfigMap) (*appsv1.Deployment, error) {
+	agentCount := int32(dr.GetDesiredAgentCount())
+	if agentCount == 0 {
+		return nil, nil
+	}
+	deploymentName := fmt.Sprintf("%s-dr-agents", dr.ObjectMeta.Name)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   dr.ObjectMeta.Namespace,
+			Name:        deploymentName,
+			Annotations: map[string]string{},
+			Labels:      map[string]string{},
+		},
+	}
+	deployment.Spec.Replicas = &agentCount
+	deployment.ObjectMeta.OwnerReferences = BuildOwnerReference(dr.TypeMeta, dr.ObjectMeta)
+
+	if dr.Spec.AgentDeploymentMetadata != nil {
+		for key, value := range dr.Spec.AgentDeploymentMetadata.Labels {
+			deployment.ObjectMeta.Labels[key] = value
+		}
+		for key, value := range dr.Spec.AgentDeploymentMetadata.Annotations {
+			deployment.ObjectMeta.Annotations[key] = value
+		}
+	}
+	deployment.ObjectMeta.Labels[fdbv1beta2.DisasterRecoveryDeploymentLabel] = string(dr.ObjectMeta.UID)
+
+	var podTemplate *corev1.PodTemplateSpec
+	if dr.Spec.PodTemplateSpec != nil {
+		podTemplate = dr.Spec.PodTemplateSpec.DeepCopy()
+	} else {
+		podTemplate = &corev1.PodTemplateSpec{}
+	}
+
+	var mainContainer *corev1.Container
+	var initContainer *corev1.Container
+
+	for index, container := range podTemplate.Spec.Containers {
+		if container.Name == fdbv1beta2.MainContainerName {
+			mainContainer = &podTemplate.Spec.Containers[index]
+		}
+	}
+
+	for index, container := range podTemplate.Spec.InitContainers {
+		if container.Name == fdbv1beta2.InitContainerName {
+			initContainer = &podTemplate.Spec.InitContainers[index]
+		}
+	}
+
+	if mainContainer == nil {
+		containers := []corev1.Container{
+			{
+				Name: fdbv1beta2.MainContainerName,
+			},
+		}
+		containers = append(containers, podTemplate.Spec.Containers...)
+		mainContainer = &containers[0]
+		podTemplate.Spec.Containers = containers
+	}
+
+	imageConfigs := dr.Spec.MainContainer.ImageConfigs
+	if len(imageConfigs) == 0 {
+		imageConfigs = []fdbv1beta2.ImageConfig{
+			{BaseImage: "foundationdb/foundationdb"},
+		}
+	}
+
+	image, err := GetImage(mainContainer.Image, imageConfigs, dr.Spec.Version, dr.Spec.GetAllowTagOverride())
+	if err != nil {
+		return nil, err
+	}
+	mainContainer.Image = image
+	mainContainer.Command = []string{"dr_agent"}
+	args := []string{
+		"--log", "--logdir", "/var/log/fdb-trace-logs",
+		"-s", path.Join("/var/dynamic-conf", disasterRecoverySourceClusterFile),
+		"-d", path.Join("/var/dynamic-conf", disasterRecoveryDestinationClusterFile),
+	}
+
+	if len(dr.Spec.CustomParameters) > 0 {
+		err := dr.Spec.CustomParameters.ValidateCustomParameters()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, customParameter := range dr.Spec.CustomParameters {
+			args = append(args, fmt.Sprintf("--%s", customParameter))
+		}
+	}
+
+	mainContainer.Args = args
+	mainContainer.VolumeMounts = append(mainContainer.VolumeMounts,
+		corev1.VolumeMount{Name: "logs", MountPath: "/var/log/fdb-trace-logs"},
+		corev1.VolumeMount{Name: "dynamic-conf", MountPath: "/var/dynamic-conf"},
+	)
+
+	if mainContainer.Resources.Requests == nil {
+		mainContainer.Resources.Requests = corev1.ResourceList{
+			"cpu":    resource.MustParse("1"),
+			"memory": resource.MustParse("1Gi"),
+		}
+	}
+
+	if mainContainer.Resources.Limits == nil {
+		mainContainer.Resources.Limits = mainContainer.Resources.Requests
+	}
+
+	if initContainer == nil {
+		podTemplate.Spec.InitContainers = append(podTemplate.Spec.InitContainers, corev1.Container{Name: fdbv1beta2.InitContainerName})
+		initContainer = &podTemplate.Spec.InitContainers[0]
+	}
+
+	err = configureSidecarContainerForDisasterRecovery(dr, initContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	if podTemplate.ObjectMeta.Labels == nil {
+		podTemplate.ObjectMeta.Labels = make(map[string]string, 1)
+	}
+	podTemplate.ObjectMeta.Labels[fdbv1beta2.BackupDeploymentPodLabel] = deployment.ObjectMeta.Name
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{
+		fdbv1beta2.BackupDeploymentPodLabel: deployment.ObjectMeta.Name,
+	}}
+
+	// The cluster files are only copied when the Pods are started, so the Pods have to be recreated when the cluster
+	// files change, e.g. after a switchover.
+	configMapHash, err := GetJSONHash(configMap.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if podTemplate.ObjectMeta.Annotations == nil {
+		podTemplate.ObjectMeta.Annotations = make(map[string]string, 1)
+	}
+	podTemplate.ObjectMeta.Annotations[fdbv1beta2.LastConfigMapKey] = configMapHash
+
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes,
+		corev1.Volume{Name: "logs", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		corev1.Volume{Name: "dynamic-conf", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		corev1.Volume{
+			Name: "config-map",
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+				Items: []corev1.KeyToPath{
+					{Key: SourceClusterFileKey, Path: disasterRecoverySourceClusterFile},
+					{Key: DestinationClusterFileKey, Path: disasterRecoveryDestinationClusterFile},
+				},
+			}},
+		},
+	)
+
+	deployment.Spec.Template = *podTemplate
+
+	specHash, err := GetJSONHash(deployment.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment.ObjectMeta.Annotations[fdbv1beta2.LastSpecKey] = specHash
+
+	return deployment, nil
+}
+
 // GetServersPerPodForPod returns the count of servers per Pod based on the processClass from the sidecar or 1
 func GetServersPerPodForPod(pod *corev1.Pod, pClass fdbv1beta2.ProcessClass) (int, error) {
 	// If not specified we will default to 1
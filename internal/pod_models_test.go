@@ -3340,6 +3340,110 @@ var _ = Describe("pod_models", func() {
 		})
 	})
 
+	Describe("GetDisasterRecoveryDeployment", func() {
+		var dr *fdbv1beta2.FoundationDBDisasterRecovery
+		var configMap *corev1.ConfigMap
+		var deployment *appsv1.Deployment
+
+		BeforeEach(func() {
+			destination := CreateDefaultCluster()
+			destination.Name = "operator-test-2"
+			dr = CreateDefaultDisasterRecovery(cluster, destination)
+			configMap = GetDisasterRecoveryConfigMap(dr, "source:source@127.0.0.1:4501", "destination:destination@127.0.0.2:4501")
+		})
+
+		Context("with a basic deployment", func() {
+			BeforeEach(func() {
+				deployment, err = GetDisasterRecoveryDeployment(dr, configMap)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deployment).NotTo(BeNil())
+			})
+
+			It("should set the metadata for the deployment", func() {
+				Expect(deployment.ObjectMeta.Name).To(Equal("operator-test-1-dr-agents"))
+				Expect(deployment.ObjectMeta.OwnerReferences).To(HaveLen(1))
+				Expect(deployment.ObjectMeta.Labels).To(Equal(map[string]string{
+					fdbv1beta2.DisasterRecoveryDeploymentLabel: string(dr.ObjectMeta.UID),
+				}))
+				Expect(deployment.ObjectMeta.Annotations).To(HaveKey(fdbv1beta2.LastSpecKey))
+			})
+
+			It("should set the replication factor to the specified agent count", func() {
+				Expect(deployment.Spec.Replicas).NotTo(BeNil())
+				Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+			})
+
+			It("should add the hash of the config map to the pod template", func() {
+				Expect(deployment.Spec.Template.ObjectMeta.Annotations).To(HaveKey(fdbv1beta2.LastConfigMapKey))
+			})
+
+			It("should mount the cluster files from the config map", func() {
+				Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+					Name: "config-map",
+					VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "operator-test-1-dr-config"},
+						Items: []corev1.KeyToPath{
+							{Key: SourceClusterFileKey, Path: "source.cluster"},
+							{Key: DestinationClusterFileKey, Path: "destination.cluster"},
+						},
+					}},
+				}))
+			})
+
+			It("should set the image and command for the DR agent", func() {
+				container := deployment.Spec.Template.Spec.Containers[0]
+				Expect(container.Name).To(Equal(fdbv1beta2.MainContainerName))
+				Expect(container.Image).To(Equal(fmt.Sprintf("foundationdb/foundationdb:%s", cluster.Spec.Version)))
+				Expect(container.Command).To(Equal([]string{"dr_agent"}))
+				Expect(container.Args).To(Equal([]string{
+					"--log",
+					"--logdir",
+					"/var/log/fdb-trace-logs",
+					"-s",
+					"/var/dynamic-conf/source.cluster",
+					"-d",
+					"/var/dynamic-conf/destination.cluster",
+				}))
+			})
+
+			It("should copy both cluster files in the init container", func() {
+				container := deployment.Spec.Template.Spec.InitContainers[0]
+				Expect(container.Name).To(Equal(fdbv1beta2.InitContainerName))
+				Expect(container.Image).To(Equal(fmt.Sprintf("foundationdb/foundationdb-kubernetes-sidecar:%s-1", cluster.Spec.Version)))
+				Expect(container.Args).To(Equal([]string{
+					"--copy-file",
+					"source.cluster",
+					"--copy-file",
+					"destination.cluster",
+					"--require-not-empty",
+					"source.cluster",
+					"--require-not-empty",
+					"destination.cluster",
+					"--init-mode",
+				}))
+			})
+		})
+
+		Context("with the cluster files changed", func() {
+			It("should change the hash of the pod template", func() {
+				deployment, err = GetDisasterRecoveryDeployment(dr, configMap)
+				Expect(err).NotTo(HaveOccurred())
+				switchedDeployment, err := GetDisasterRecoveryDeployment(dr, GetDisasterRecoveryConfigMap(dr, "destination:destination@127.0.0.2:4501", "source:source@127.0.0.1:4501"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(switchedDeployment.Spec.Template.ObjectMeta.Annotations[fdbv1beta2.LastConfigMapKey]).NotTo(Equal(deployment.Spec.Template.ObjectMeta.Annotations[fdbv1beta2.LastConfigMapKey]))
+			})
+		})
+
+		Context("with no agents", func() {
+			It("should not create a deployment", func() {
+				dr.Spec.AgentCount = pointer.Int(0)
+				deployment, err = GetDisasterRecoveryDeployment(dr, configMap)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deployment).To(BeNil())
+			})
+		})
+	})
+
 	Context("Get image for container", func() {
 		type testCase struct {
 			imageName     string
@@ -21,10 +21,21 @@
 package internal
 
 import (
+	"context"
+	"time"
+
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// fileSystemResizeRestartDelay defines how long the operator waits for the file system of an expanded PVC to be
+// resized while the Pod is running, before the Pod will be recreated to resize the file system.
+const fileSystemResizeRestartDelay = 5 * time.Minute
+
 // CreatePVCMap creates a map with the process group ID as a key and the according PVC as a value
 func CreatePVCMap(cluster *fdbv1beta2.FoundationDBCluster, pvcs *corev1.PersistentVolumeClaimList) map[fdbv1beta2.ProcessGroupID]corev1.PersistentVolumeClaim {
 	pvcMap := make(map[fdbv1beta2.ProcessGroupID]corev1.PersistentVolumeClaim, len(pvcs.Items))
@@ -39,3 +50,103 @@ func CreatePVCMap(cluster *fdbv1beta2.FoundationDBCluster, pvcs *corev1.Persiste
 
 	return pvcMap
 }
+
+// PVCCanBeExpanded returns true if the desired PVC only differs from the current PVC by a larger storage request and the
+// StorageClass of the current PVC allows volume expansion. In this case the PVC can be expanded in place and the process
+// group doesn't have to be replaced.
+func PVCCanBeExpanded(ctx context.Context, reader client.Reader, pvc *corev1.PersistentVolumeClaim, desiredPVC *corev1.PersistentVolumeClaim) (bool, error) {
+	needsExpansion, err := pvcNeedsExpansion(pvc, desiredPVC)
+	if err != nil || !needsExpansion {
+		return false, err
+	}
+
+	return storageClassAllowsExpansion(ctx, reader, pvc)
+}
+
+// pvcNeedsExpansion returns true if the desired PVC only differs from the current PVC by a larger storage request. The
+// spec of the current PVC is tracked by the hash in the fdbv1beta2.LastSpecKey annotation, so the desired spec with the
+// storage request of the current PVC must result in the same hash.
+func pvcNeedsExpansion(pvc *corev1.PersistentVolumeClaim, desiredPVC *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Annotations[fdbv1beta2.LastSpecKey] == desiredPVC.Annotations[fdbv1beta2.LastSpecKey] {
+		return false, nil
+	}
+
+	currentSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	desiredSize := desiredPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+	if desiredSize.Cmp(currentSize) <= 0 {
+		return false, nil
+	}
+
+	spec := desiredPVC.Spec.DeepCopy()
+	spec.Resources.Requests[corev1.ResourceStorage] = currentSize
+	specHash, err := GetJSONHash(spec)
+	if err != nil {
+		return false, err
+	}
+
+	return pvc.Annotations[fdbv1beta2.LastSpecKey] == specHash, nil
+}
+
+// storageClassAllowsExpansion returns true if the StorageClass of the provided PVC allows volume expansion. If the
+// operator is not allowed to read the StorageClass, e.g. because it only has a namespaced Role, the PVC will be treated
+// as not expandable and the process group will be replaced instead.
+func storageClassAllowsExpansion(ctx context.Context, reader client.Reader, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	storageClassName := pointer.StringDeref(pvc.Spec.StorageClassName, "")
+	if storageClassName == "" {
+		return false, nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	err := reader.Get(ctx, client.ObjectKey{Name: storageClassName}, storageClass)
+	if err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return pointer.BoolDeref(storageClass.AllowVolumeExpansion, false), nil
+}
+
+// PVCIsResizing returns true if the volume or the file system of the provided PVC is still being resized to the
+// requested storage size.
+func PVCIsResizing(pvc *corev1.PersistentVolumeClaim) bool {
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if condition.Type == corev1.PersistentVolumeClaimResizing || condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending {
+			return true
+		}
+	}
+
+	capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+	if !ok {
+		return false
+	}
+
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	return capacity.Cmp(requested) < 0
+}
+
+// PodNeedsRestartForPVCResize returns true if the Pod must be recreated to resize the file system of the provided PVC.
+// Volume plugins that support online expansion resize the file system while the Pod is running, so the Pod will only be
+// recreated if the file system resize is pending for longer than fileSystemResizeRestartDelay and the Pod was created
+// before the file system resize was pending.
+func PodNeedsRestartForPVCResize(pod *corev1.Pod, pvc *corev1.PersistentVolumeClaim, now time.Time) bool {
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Type != corev1.PersistentVolumeClaimFileSystemResizePending || condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if now.Before(condition.LastTransitionTime.Add(fileSystemResizeRestartDelay)) {
+			return false
+		}
+
+		return pod.CreationTimestamp.Before(&condition.LastTransitionTime)
+	}
+
+	return false
+}
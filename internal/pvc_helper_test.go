@@ -0,0 +1,230 @@
+/*
+ * pvc_helper_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("pvc_helper", func() {
+	var pvc *corev1.PersistentVolumeClaim
+
+	BeforeEach(func() {
+		pvc = &corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("32Gi"),
+					},
+				},
+			},
+		}
+	})
+
+	DescribeTable("checking if the PVC is resizing", func(capacity string, conditionType corev1.PersistentVolumeClaimConditionType, expected bool) {
+		if capacity != "" {
+			pvc.Status.Capacity = corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(capacity),
+			}
+		}
+
+		if conditionType != "" {
+			pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+				{
+					Type:   conditionType,
+					Status: corev1.ConditionTrue,
+				},
+			}
+		}
+
+		Expect(PVCIsResizing(pvc)).To(Equal(expected))
+	},
+		Entry("the PVC is not bound", "", corev1.PersistentVolumeClaimConditionType(""), false),
+		Entry("the capacity matches the request", "32Gi", corev1.PersistentVolumeClaimConditionType(""), false),
+		Entry("the capacity is smaller than the request", "16Gi", corev1.PersistentVolumeClaimConditionType(""), true),
+		Entry("the volume is resizing", "32Gi", corev1.PersistentVolumeClaimResizing, true),
+		Entry("the file system resize is pending", "32Gi", corev1.PersistentVolumeClaimFileSystemResizePending, true),
+	)
+
+	When("checking if the Pod must be restarted for the file system resize", func() {
+		var pod *corev1.Pod
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+				},
+			}
+		})
+
+		When("no file system resize is pending", func() {
+			It("should not restart the Pod", func() {
+				Expect(PodNeedsRestartForPVCResize(pod, pvc, now)).To(BeFalse())
+			})
+		})
+
+		When("the file system resize is pending", func() {
+			var transitionTime time.Time
+
+			JustBeforeEach(func() {
+				pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+					{
+						Type:               corev1.PersistentVolumeClaimFileSystemResizePending,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(transitionTime),
+					},
+				}
+			})
+
+			When("the resize is pending for less than the delay", func() {
+				BeforeEach(func() {
+					transitionTime = now.Add(-1 * time.Minute)
+				})
+
+				It("should not restart the Pod", func() {
+					Expect(PodNeedsRestartForPVCResize(pod, pvc, now)).To(BeFalse())
+				})
+			})
+
+			When("the resize is pending for longer than the delay", func() {
+				BeforeEach(func() {
+					transitionTime = now.Add(-10 * time.Minute)
+				})
+
+				It("should restart the Pod", func() {
+					Expect(PodNeedsRestartForPVCResize(pod, pvc, now)).To(BeTrue())
+				})
+
+				When("the Pod was created after the resize was pending", func() {
+					BeforeEach(func() {
+						pod.CreationTimestamp = metav1.NewTime(now.Add(-5 * time.Minute))
+					})
+
+					It("should not restart the Pod", func() {
+						Expect(PodNeedsRestartForPVCResize(pod, pvc, now)).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("checking if the PVC can be expanded", func() {
+		var desiredPVC *corev1.PersistentVolumeClaim
+		var reader *storageClassReader
+
+		BeforeEach(func() {
+			pvc.Spec.StorageClassName = pointer.String("expandable")
+			desiredPVC = pvc.DeepCopy()
+			desiredPVC.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("64Gi")
+
+			currentHash, err := GetJSONHash(pvc.Spec)
+			Expect(err).NotTo(HaveOccurred())
+			pvc.Annotations = map[string]string{fdbv1beta2.LastSpecKey: currentHash}
+
+			desiredHash, err := GetJSONHash(desiredPVC.Spec)
+			Expect(err).NotTo(HaveOccurred())
+			desiredPVC.Annotations = map[string]string{fdbv1beta2.LastSpecKey: desiredHash}
+
+			reader = &storageClassReader{
+				storageClass: &storagev1.StorageClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+					AllowVolumeExpansion: pointer.Bool(true),
+				},
+			}
+		})
+
+		It("should expand the PVC", func() {
+			Expect(PVCCanBeExpanded(context.Background(), reader, pvc, desiredPVC)).To(BeTrue())
+		})
+
+		When("the StorageClass doesn't allow volume expansion", func() {
+			BeforeEach(func() {
+				reader.storageClass.AllowVolumeExpansion = pointer.Bool(false)
+			})
+
+			It("should not expand the PVC", func() {
+				Expect(PVCCanBeExpanded(context.Background(), reader, pvc, desiredPVC)).To(BeFalse())
+			})
+		})
+
+		When("the operator is not allowed to read the StorageClass", func() {
+			BeforeEach(func() {
+				reader.err = k8serrors.NewForbidden(storagev1.Resource("storageclasses"), "expandable", fmt.Errorf("storageclasses is forbidden"))
+			})
+
+			It("should not expand the PVC", func() {
+				Expect(PVCCanBeExpanded(context.Background(), reader, pvc, desiredPVC)).To(BeFalse())
+			})
+		})
+
+		When("the StorageClass can't be read", func() {
+			BeforeEach(func() {
+				reader.err = k8serrors.NewServiceUnavailable("unavailable")
+			})
+
+			It("should return the error", func() {
+				_, err := PVCCanBeExpanded(context.Background(), reader, pvc, desiredPVC)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+// storageClassReader is a client.Reader that returns the provided StorageClass or error.
+type storageClassReader struct {
+	storageClass *storagev1.StorageClass
+	err          error
+}
+
+// Get returns the StorageClass of the reader or the error of the reader.
+func (reader *storageClassReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if reader.err != nil {
+		return reader.err
+	}
+
+	storageClass, ok := obj.(*storagev1.StorageClass)
+	if !ok || key.Name != reader.storageClass.Name {
+		return k8serrors.NewNotFound(storagev1.Resource("storageclasses"), key.Name)
+	}
+
+	reader.storageClass.DeepCopyInto(storageClass)
+	return nil
+}
+
+// List is not supported by the reader.
+func (reader *storageClassReader) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return fmt.Errorf("not supported")
+}
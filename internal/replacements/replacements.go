@@ -53,11 +53,16 @@ func ReplaceMisconfiguredProcessGroups(ctx context.Context, podManager podmanage
 			continue
 		}
 
+		// Process groups that are not part of the canary rollout will be replaced once the canary is promoted.
+		if !cluster.IsCanaryUpdateAllowed(processGroup.ProcessGroupID) {
+			continue
+		}
+
 		// TODO(johscheuer): Fix how we fetch the pvc to make better use of the controller runtime cache.
 		pvc, hasPVC := pvcMap[processGroup.ProcessGroupID]
 		pod, podErr := podManager.GetPod(ctx, client, cluster, processGroup.GetPodName(cluster))
 		if hasPVC {
-			needsPVCRemoval, err := processGroupNeedsRemovalForPVC(cluster, pvc, log, processGroup)
+			needsPVCRemoval, err := processGroupNeedsRemovalForPVC(ctx, client, cluster, pvc, log, processGroup)
 			if err != nil {
 				return hasReplacements, err
 			}
@@ -94,7 +99,7 @@ func ReplaceMisconfiguredProcessGroups(ctx context.Context, podManager podmanage
 	return hasReplacements, nil
 }
 
-func processGroupNeedsRemovalForPVC(cluster *fdbv1beta2.FoundationDBCluster, pvc corev1.PersistentVolumeClaim, log logr.Logger, processGroup *fdbv1beta2.ProcessGroupStatus) (bool, error) {
+func processGroupNeedsRemovalForPVC(ctx context.Context, reader client.Reader, cluster *fdbv1beta2.FoundationDBCluster, pvc corev1.PersistentVolumeClaim, log logr.Logger, processGroup *fdbv1beta2.ProcessGroupStatus) (bool, error) {
 	processGroupID := internal.GetProcessGroupIDFromMeta(cluster, pvc.ObjectMeta)
 	logger := log.WithValues("namespace", cluster.Namespace, "cluster", cluster.Name, "pvc", pvc.Name, "processGroupID", processGroupID, "reconciler", "replaceMisconfiguredProcessGroups")
 
@@ -122,9 +127,17 @@ func processGroupNeedsRemovalForPVC(cluster *fdbv1beta2.FoundationDBCluster, pvc
 	}
 
 	if pvc.Annotations[fdbv1beta2.LastSpecKey] != pvcHash {
-		logger.Info("Replace process group",
-			"reason", fmt.Sprintf("PVC spec has changed from %s to %s", pvcHash, pvc.Annotations[fdbv1beta2.LastSpecKey]))
-		return true, nil
+		// An increased storage request will be applied by expanding the PVC in place, if the StorageClass allows it.
+		canBeExpanded, err := internal.PVCCanBeExpanded(ctx, reader, &pvc, desiredPVC)
+		if err != nil {
+			return false, err
+		}
+
+		if !canBeExpanded {
+			logger.Info("Replace process group",
+				"reason", fmt.Sprintf("PVC spec has changed from %s to %s", pvcHash, pvc.Annotations[fdbv1beta2.LastSpecKey]))
+			return true, nil
+		}
 	}
 	if pvc.Name != desiredPVC.Name {
 		logger.Info("Replace process group",
@@ -23,6 +23,7 @@ package replacements
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/podmanager"
 	ctrlClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +40,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -362,7 +364,7 @@ var _ = Describe("replace_misconfigured_pods", func() {
 				pvc, err := internal.GetPvc(cluster, processGroup)
 				Expect(err).NotTo(HaveOccurred())
 				pvc.Name = "Test-storage"
-				needsRemoval, err := processGroupNeedsRemovalForPVC(cluster, *pvc, log, processGroup)
+				needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(needsRemoval).To(BeTrue())
 			})
@@ -372,7 +374,7 @@ var _ = Describe("replace_misconfigured_pods", func() {
 			It("should not need a removal", func() {
 				pvc, err := internal.GetPvc(cluster, processGroup)
 				Expect(err).NotTo(HaveOccurred())
-				needsRemoval, err := processGroupNeedsRemovalForPVC(cluster, *pvc, log, processGroup)
+				needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(needsRemoval).To(BeFalse())
 			})
@@ -383,12 +385,97 @@ var _ = Describe("replace_misconfigured_pods", func() {
 				pvc, err := internal.GetPvc(cluster, processGroup)
 				Expect(err).NotTo(HaveOccurred())
 				pvc.Annotations[fdbv1beta2.LastSpecKey] = "1"
-				needsRemoval, err := processGroupNeedsRemovalForPVC(cluster, *pvc, log, processGroup)
+				needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(needsRemoval).To(BeTrue())
 			})
 		})
 
+		When("the storage request of the PVC was increased", func() {
+			var pvc *corev1.PersistentVolumeClaim
+			var allowVolumeExpansion *bool
+
+			BeforeEach(func() {
+				pClass = fdbv1beta2.ProcessClassStorage
+				remove = false
+				allowVolumeExpansion = pointer.Bool(true)
+			})
+
+			JustBeforeEach(func() {
+				storageClass := &storagev1.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "expandable",
+					},
+					Provisioner:          "test",
+					AllowVolumeExpansion: allowVolumeExpansion,
+				}
+				Expect(k8sClient.Create(context.Background(), storageClass)).NotTo(HaveOccurred())
+
+				cluster.Spec.Processes = map[fdbv1beta2.ProcessClass]fdbv1beta2.ProcessSettings{
+					fdbv1beta2.ProcessClassGeneral: {
+						VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+							Spec: corev1.PersistentVolumeClaimSpec{
+								StorageClassName: pointer.String(storageClass.Name),
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: resource.MustParse("16Gi"),
+									},
+								},
+							},
+						},
+					},
+				}
+
+				var err error
+				pvc, err = internal.GetPvc(cluster, processGroup)
+				Expect(err).NotTo(HaveOccurred())
+
+				cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral].VolumeClaimTemplate.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("32Gi")
+			})
+
+			AfterEach(func() {
+				k8sClient.Clear()
+			})
+
+			When("the StorageClass allows volume expansion", func() {
+				It("should not need a removal", func() {
+					needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(needsRemoval).To(BeFalse())
+				})
+
+				When("the storage class of the PVC was changed too", func() {
+					It("should need a removal", func() {
+						cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral].VolumeClaimTemplate.Spec.StorageClassName = pointer.String("other")
+						needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(needsRemoval).To(BeTrue())
+					})
+				})
+
+				When("the storage request was decreased", func() {
+					It("should need a removal", func() {
+						cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral].VolumeClaimTemplate.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("8Gi")
+						needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(needsRemoval).To(BeTrue())
+					})
+				})
+			})
+
+			When("the StorageClass doesn't allow volume expansion", func() {
+				BeforeEach(func() {
+					allowVolumeExpansion = nil
+				})
+
+				It("should need a removal", func() {
+					needsRemoval, err := processGroupNeedsRemovalForPVC(context.Background(), k8sClient, cluster, *pvc, log, processGroup)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(needsRemoval).To(BeTrue())
+				})
+			})
+		})
+
 		Context("when the memory resources are changed", func() {
 			var status *fdbv1beta2.ProcessGroupStatus
 			var pod *corev1.Pod
@@ -742,5 +829,39 @@ var _ = Describe("replace_misconfigured_pods", func() {
 				})
 			})
 		})
+
+		When("the file system of an expanded PVC must be resized by recreating the Pod", func() {
+			BeforeEach(func() {
+				cluster.Spec.Processes[fdbv1beta2.ProcessClassGeneral].PodTemplate.Spec.NodeSelector = map[string]string{}
+				cluster.Spec.AutomationOptions.PodUpdateStrategy = fdbv1beta2.PodUpdateStrategyReplacement
+
+				for _, processGroup := range cluster.Status.ProcessGroups {
+					pvc := pvcMap[processGroup.ProcessGroupID]
+					pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+						{
+							Type:               corev1.PersistentVolumeClaimFileSystemResizePending,
+							Status:             corev1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Now().Add(-1 * time.Hour)),
+						},
+					}
+					pvcMap[processGroup.ProcessGroupID] = pvc
+
+					pod := &corev1.Pod{}
+					Expect(k8sClient.Get(context.Background(), ctrlClient.ObjectKey{Name: processGroup.GetPodName(cluster), Namespace: cluster.Namespace}, pod)).NotTo(HaveOccurred())
+					pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+					Expect(k8sClient.Update(context.Background(), pod)).NotTo(HaveOccurred())
+				}
+			})
+
+			It("should not replace the process groups", func() {
+				hasReplacement, err := ReplaceMisconfiguredProcessGroups(context.Background(), podmanager.StandardPodLifecycleManager{}, k8sClient, log, cluster, pvcMap)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hasReplacement).To(BeFalse())
+
+				for _, pGroup := range cluster.Status.ProcessGroups {
+					Expect(pGroup.IsMarkedForRemoval()).To(BeFalse())
+				}
+			})
+		})
 	})
 })
@@ -95,6 +95,81 @@ func CreateDefaultBackup(cluster *fdbv1beta2.FoundationDBCluster) *fdbv1beta2.Fo
 	}
 }
 
+// CreateDefaultDisasterRecovery creates a default FoundationDBDisasterRecovery for testing that replicates the source
+// cluster into the destination cluster.
+func CreateDefaultDisasterRecovery(source *fdbv1beta2.FoundationDBCluster, destination *fdbv1beta2.FoundationDBCluster) *fdbv1beta2.FoundationDBDisasterRecovery {
+	return &fdbv1beta2.FoundationDBDisasterRecovery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      source.Name,
+			Namespace: source.Namespace,
+		},
+		Spec: fdbv1beta2.FoundationDBDisasterRecoverySpec{
+			DRState: fdbv1beta2.DisasterRecoveryStateRunning,
+			Version: source.Spec.Version,
+			SourceCluster: fdbv1beta2.DisasterRecoveryClusterReference{
+				Name: source.Name,
+			},
+			DestinationCluster: fdbv1beta2.DisasterRecoveryClusterReference{
+				Name:      destination.Name,
+				Namespace: destination.Namespace,
+			},
+			AgentCount: pointer.Int(3),
+		},
+	}
+}
+
+// CreateDefaultMultiRegionCluster creates a default FoundationDBMultiRegionCluster for testing with a primary and a
+// remote data center, each with a satellite.
+func CreateDefaultMultiRegionCluster() *fdbv1beta2.FoundationDBMultiRegionCluster {
+	clusterSpec := CreateDefaultCluster().Spec
+	clusterSpec.DatabaseConfiguration = fdbv1beta2.DatabaseConfiguration{
+		RedundancyMode: fdbv1beta2.RedundancyModeDouble,
+		UsableRegions:  2,
+		Regions: []fdbv1beta2.Region{
+			{
+				DataCenters: []fdbv1beta2.DataCenter{
+					{
+						ID:       "primary",
+						Priority: 1,
+					},
+					{
+						ID:        "primary-satellite",
+						Satellite: 1,
+						Priority:  1,
+					},
+				},
+				SatelliteLogs:           3,
+				SatelliteRedundancyMode: "one_satellite_double",
+			},
+			{
+				DataCenters: []fdbv1beta2.DataCenter{
+					{
+						ID:       "remote",
+						Priority: 0,
+					},
+					{
+						ID:        "remote-satellite",
+						Satellite: 1,
+						Priority:  1,
+					},
+				},
+				SatelliteLogs:           3,
+				SatelliteRedundancyMode: "one_satellite_double",
+			},
+		},
+	}
+
+	return &fdbv1beta2.FoundationDBMultiRegionCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "operator-test-mrc",
+			Namespace: "my-ns",
+		},
+		Spec: fdbv1beta2.FoundationDBMultiRegionClusterSpec{
+			ClusterSpec: clusterSpec,
+		},
+	}
+}
+
 // GetProcessGroup is a helper method that creates a ProcessGroup based on the provided process class and id number.
 func GetProcessGroup(cluster *fdbv1beta2.FoundationDBCluster, processClass fdbv1beta2.ProcessClass, idNum int) *fdbv1beta2.ProcessGroupStatus {
 	_, processGroupID := cluster.GetProcessGroupID(processClass, idNum)
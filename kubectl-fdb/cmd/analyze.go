@@ -257,6 +257,11 @@ func analyzeCluster(cmd *cobra.Command, kubeClient client.Client, cluster *fdbv1
 		printStatement(cmd, "Cluster is not reconciled", errorMessage)
 	}
 
+	if cluster.Status.BlockingReconciler != nil {
+		foundIssues = true
+		printStatement(cmd, getBlockingReconcilerStatement(cluster.Status.BlockingReconciler), errorMessage)
+	}
+
 	// We could add here more fields from cluster.Status.Generations and check if they are present.
 	var failedProcessGroups []string
 	processGroupMap := map[fdbv1beta2.ProcessGroupID]fdbv1beta2.None{}
@@ -450,6 +455,16 @@ func analyzeCluster(cmd *cobra.Command, kubeClient client.Client, cluster *fdbv1
 	return nil
 }
 
+// getBlockingReconcilerStatement returns the statement that describes the sub-reconciler that blocked the reconciliation.
+func getBlockingReconcilerStatement(blockingReconciler *fdbv1beta2.BlockingReconcilerInfo) string {
+	firstSeen := "unknown"
+	if blockingReconciler.FirstSeen != nil {
+		firstSeen = blockingReconciler.FirstSeen.UTC().Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("Reconciliation is blocked by %s since %s for %d consecutive reconciliations: %s", blockingReconciler.SubReconciler, firstSeen, blockingReconciler.Count, blockingReconciler.Message)
+}
+
 func filterDeletePods(replacements []string, killPods []corev1.Pod) []corev1.Pod {
 	res := make([]corev1.Pod, 0, len(killPods))
 
@@ -203,6 +203,35 @@ var _ = Describe("[plugin] analyze cluster", func() {
 ✔ Cluster is available
 ✔ Cluster is fully replicated
 ✔ ProcessGroups are all in ready condition
+✔ Pods are all running and available`,
+					AutoFix:        false,
+					HasErrors:      true,
+					IgnoreRemovals: true,
+				}),
+			Entry("Cluster is blocked by a sub-reconciler",
+				testCase{
+					cluster: func() *fdbv1beta2.FoundationDBCluster {
+						cluster := getCluster(clusterName, namespace, true, true, true, 0, []*fdbv1beta2.ProcessGroupStatus{
+							{ProcessGroupID: "storage-1"},
+						})
+						cluster.Status.BlockingReconciler = &fdbv1beta2.BlockingReconcilerInfo{
+							SubReconciler: "ExcludeProcesses",
+							Message:       "waiting for missing processes",
+							FirstSeen:     &metav1.Time{Time: time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)},
+							Count:         5,
+						}
+
+						return cluster
+					}(),
+					podList: getPodList(clusterName, namespace, corev1.PodStatus{
+						Phase: corev1.PodRunning,
+					}, nil),
+					ExpectedErrMsg: `✖ Cluster is not reconciled
+✖ Reconciliation is blocked by ExcludeProcesses since 2023-05-01T12:00:00Z for 5 consecutive reconciliations: waiting for missing processes`,
+					ExpectedStdoutMsg: `Checking cluster: test/test
+✔ Cluster is available
+✔ Cluster is fully replicated
+✔ ProcessGroups are all in ready condition
 ✔ Pods are all running and available`,
 					AutoFix:        false,
 					HasErrors:      true,
@@ -31,6 +31,7 @@ import (
 
 	fdbv1beta1 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta1"
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	fdbv1beta3 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta3"
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
 
 	"github.com/go-logr/logr"
@@ -62,6 +63,7 @@ func getKubeClient(ctx context.Context, o *fdbBOptions) (client.Client, error) {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(fdbv1beta1.AddToScheme(scheme))
 	utilruntime.Must(fdbv1beta2.AddToScheme(scheme))
+	utilruntime.Must(fdbv1beta3.AddToScheme(scheme))
 
 	// Don't printout any log messages from client-go.
 	klog.SetLogger(logr.Discard())
@@ -0,0 +1,213 @@
+/*
+ * plan.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	ctx "context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newPlanCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newFDBOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Shows the actions the operator would perform for a cluster in dry-run mode",
+		Long:  "Shows the actions the operator would perform for a cluster in dry-run mode",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wait, err := cmd.Root().Flags().GetBool("wait")
+			if err != nil {
+				return err
+			}
+			clusterName, err := cmd.Flags().GetString("fdb-cluster")
+			if err != nil {
+				return err
+			}
+			enable, err := cmd.Flags().GetBool("enable")
+			if err != nil {
+				return err
+			}
+			disable, err := cmd.Flags().GetBool("disable")
+			if err != nil {
+				return err
+			}
+
+			if enable && disable {
+				return fmt.Errorf("the flags --enable and --disable cannot be used together")
+			}
+
+			kubeClient, err := getKubeClient(cmd.Context(), o)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := getNamespace(*o.configFlags.Namespace)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := loadCluster(kubeClient, namespace, clusterName)
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return fmt.Errorf("could not get cluster: %s/%s", namespace, clusterName)
+				}
+				return err
+			}
+
+			if enable {
+				return setDryRun(cmd, kubeClient, cluster, true, false)
+			}
+
+			if disable {
+				return setDryRun(cmd, kubeClient, cluster, false, wait)
+			}
+
+			return printPlan(cmd, kubeClient, cluster)
+		},
+		Example: `
+# Enable the dry-run mode for cluster c1, the operator will not perform any changes for this cluster
+kubectl fdb plan -c c1 --enable
+
+# Show the actions the operator would perform for cluster c1
+kubectl fdb plan -c c1
+
+# Disable the dry-run mode for cluster c1, the operator will perform the planned actions
+kubectl fdb plan -c c1 --disable
+`,
+	}
+
+	cmd.Flags().StringP("fdb-cluster", "c", "", "show the plan of the provided cluster.")
+	cmd.Flags().Bool("enable", false, "enables the dry-run mode for the cluster.")
+	cmd.Flags().Bool("disable", false, "disables the dry-run mode for the cluster, the operator will perform all pending actions.")
+	err := cmd.MarkFlagRequired("fdb-cluster")
+	if err != nil {
+		log.Fatal(err)
+	}
+	cmd.SetOut(o.Out)
+	cmd.SetErr(o.ErrOut)
+	cmd.SetIn(o.In)
+
+	o.configFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// setDryRun enables or disables the dry-run mode of the cluster by setting the dry-run annotation.
+func setDryRun(cmd *cobra.Command, kubeClient client.Client, cluster *fdbv1beta2.FoundationDBCluster, enable bool, wait bool) error {
+	if cluster.IsDryRun() == enable {
+		cmd.Printf("Dry-run mode is already %s for cluster %s/%s\n", getDryRunState(enable), cluster.Namespace, cluster.Name)
+		return nil
+	}
+
+	if wait && !confirmAction(fmt.Sprintf("Disabling the dry-run mode for cluster %s/%s, the operator will perform all pending actions", cluster.Namespace, cluster.Name)) {
+		return fmt.Errorf("user aborted the action")
+	}
+
+	patch := client.MergeFrom(cluster.DeepCopy())
+	annotations := cluster.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if enable {
+		annotations[fdbv1beta2.DryRunAnnotation] = "true"
+	} else {
+		delete(annotations, fdbv1beta2.DryRunAnnotation)
+	}
+	cluster.SetAnnotations(annotations)
+
+	err := kubeClient.Patch(ctx.TODO(), cluster, patch)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Dry-run mode is %s for cluster %s/%s\n", getDryRunState(enable), cluster.Namespace, cluster.Name)
+	return nil
+}
+
+func getDryRunState(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+
+	return "disabled"
+}
+
+// printPlan prints the actions from the plan ConfigMap of the cluster.
+func printPlan(cmd *cobra.Command, kubeClient client.Client, cluster *fdbv1beta2.FoundationDBCluster) error {
+	if !cluster.IsDryRun() {
+		return fmt.Errorf("cluster %s/%s is not in dry-run mode, enable it with --enable", cluster.Namespace, cluster.Name)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := kubeClient.Get(ctx.TODO(), client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.GetPlanConfigMapName()}, configMap)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("no plan found for cluster %s/%s, the operator has not yet reconciled the cluster in dry-run mode", cluster.Namespace, cluster.Name)
+		}
+		return err
+	}
+
+	plan := &fdbv1beta2.ReconciliationPlan{}
+	err = json.Unmarshal([]byte(configMap.Data[fdbv1beta2.PlanConfigMapKey]), plan)
+	if err != nil {
+		return fmt.Errorf("could not parse plan of cluster %s/%s: %w", cluster.Namespace, cluster.Name, err)
+	}
+
+	if plan.Generation != cluster.ObjectMeta.Generation {
+		printStatement(cmd, fmt.Sprintf("Plan was created for generation %d but the cluster is at generation %d, the operator has not yet reconciled the latest generation", plan.Generation, cluster.ObjectMeta.Generation), warnMessage)
+	}
+
+	var timestamp string
+	if plan.Timestamp != nil {
+		timestamp = plan.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	cmd.Printf("Plan for cluster %s/%s generation %d created at %s\n", cluster.Namespace, cluster.Name, plan.Generation, timestamp)
+	if len(plan.Actions) == 0 {
+		cmd.Println("No actions planned")
+		return nil
+	}
+
+	for idx, action := range plan.Actions {
+		line := fmt.Sprintf("%d. %s %s", idx+1, action.SubReconciler, action.Type)
+		if action.Target != "" {
+			line += " " + action.Target
+		}
+		if action.Message != "" {
+			line += ": " + action.Message
+		}
+
+		cmd.Println(line)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+ * plan_test.go
+ *
+ * This source file is part of the FoundationDB open source project
+ *
+ * Copyright 2023 Apple Inc. and the FoundationDB project authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("[plugin] plan command", func() {
+	var outBuffer, errBuffer bytes.Buffer
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		outBuffer = bytes.Buffer{}
+		errBuffer = bytes.Buffer{}
+		cmd = newPlanCmd(genericclioptions.IOStreams{Out: &outBuffer, ErrOut: &errBuffer})
+	})
+
+	When("enabling the dry-run mode", func() {
+		It("should set the dry-run annotation", func() {
+			Expect(setDryRun(cmd, k8sClient, cluster, true, false)).NotTo(HaveOccurred())
+
+			fetchedCluster := &fdbv1beta2.FoundationDBCluster{}
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(cluster), fetchedCluster)).NotTo(HaveOccurred())
+			Expect(fetchedCluster.IsDryRun()).To(BeTrue())
+			Expect(outBuffer.String()).To(Equal("Dry-run mode is enabled for cluster test/test\n"))
+		})
+	})
+
+	When("disabling the dry-run mode", func() {
+		BeforeEach(func() {
+			cluster.Annotations = map[string]string{
+				fdbv1beta2.DryRunAnnotation: "true",
+			}
+		})
+
+		It("should remove the dry-run annotation", func() {
+			Expect(setDryRun(cmd, k8sClient, cluster, false, false)).NotTo(HaveOccurred())
+
+			fetchedCluster := &fdbv1beta2.FoundationDBCluster{}
+			Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(cluster), fetchedCluster)).NotTo(HaveOccurred())
+			Expect(fetchedCluster.IsDryRun()).To(BeFalse())
+			Expect(fetchedCluster.Annotations).NotTo(HaveKey(fdbv1beta2.DryRunAnnotation))
+		})
+	})
+
+	When("printing the plan", func() {
+		When("the cluster is not in dry-run mode", func() {
+			It("should return an error", func() {
+				err := printPlan(cmd, k8sClient, cluster)
+				Expect(err).To(MatchError("cluster test/test is not in dry-run mode, enable it with --enable"))
+			})
+		})
+
+		When("the cluster is in dry-run mode", func() {
+			BeforeEach(func() {
+				cluster.Annotations = map[string]string{
+					fdbv1beta2.DryRunAnnotation: "true",
+				}
+			})
+
+			When("no plan exists", func() {
+				It("should return an error", func() {
+					err := printPlan(cmd, k8sClient, cluster)
+					Expect(err).To(MatchError("no plan found for cluster test/test, the operator has not yet reconciled the cluster in dry-run mode"))
+				})
+			})
+
+			When("a plan exists", func() {
+				JustBeforeEach(func() {
+					plan := fdbv1beta2.ReconciliationPlan{
+						Generation: cluster.ObjectMeta.Generation,
+						Timestamp:  &metav1.Time{Time: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)},
+						Actions: []fdbv1beta2.PlannedAction{
+							{
+								SubReconciler: "controllers.chooseRemovals",
+								Type:          fdbv1beta2.PlannedActionRemoveProcessGroup,
+								Target:        "storage-4",
+								Message:       "process class storage",
+							},
+							{
+								SubReconciler: "controllers.excludeProcesses",
+								Type:          fdbv1beta2.PlannedActionExclude,
+								Target:        "192.168.0.4",
+							},
+						},
+					}
+					planData, err := json.Marshal(plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(k8sClient.Create(context.TODO(), &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      cluster.GetPlanConfigMapName(),
+							Namespace: namespace,
+						},
+						Data: map[string]string{
+							fdbv1beta2.PlanConfigMapKey: string(planData),
+						},
+					})).NotTo(HaveOccurred())
+				})
+
+				It("should print the planned actions", func() {
+					Expect(printPlan(cmd, k8sClient, cluster)).NotTo(HaveOccurred())
+					Expect(outBuffer.String()).To(Equal(`Plan for cluster test/test generation 1 created at 2023-10-01T12:00:00Z
+1. controllers.chooseRemovals RemoveProcessGroup storage-4: process class storage
+2. controllers.excludeProcesses Exclude 192.168.0.4
+`))
+					Expect(errBuffer.String()).To(BeEmpty())
+				})
+			})
+		})
+	})
+})
@@ -18,6 +18,8 @@ package main
 import (
 	"flag"
 	"os"
+	// Embed the time zone database to evaluate the maintenance windows independent of the base image.
+	_ "time/tzdata"
 
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
@@ -25,13 +27,10 @@ import (
 
 	"github.com/apple/foundationdb/bindings/go/src/fdb"
 	"k8s.io/apimachinery/pkg/runtime"
-	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
-	fdbv1beta1 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta1"
-	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
 	"github.com/FoundationDB/fdb-kubernetes-operator/controllers"
 	"github.com/FoundationDB/fdb-kubernetes-operator/setup"
 	// +kubebuilder:scaffold:imports
@@ -42,9 +41,7 @@ var (
 )
 
 func init() {
-	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
-	utilruntime.Must(fdbv1beta1.AddToScheme(scheme))
-	utilruntime.Must(fdbv1beta2.AddToScheme(scheme))
+	utilruntime.Must(setup.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -66,6 +63,10 @@ func main() {
 		),
 		&controllers.FoundationDBBackupReconciler{},
 		&controllers.FoundationDBRestoreReconciler{},
+		&controllers.FoundationDBUpgradePlanReconciler{},
+		&controllers.FoundationDBDisasterRecoveryReconciler{},
+		&controllers.FoundationDBMultiRegionClusterReconciler{},
+		&controllers.FoundationDBTenantReconciler{},
 		ctrl.Log)
 
 	if file != nil {
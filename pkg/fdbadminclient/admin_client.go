@@ -88,11 +88,65 @@ type AdminClient interface {
 	// GetBackupStatus gets the status of the current backup.
 	GetBackupStatus() (*fdbv1beta2.FoundationDBLiveBackupStatus, error)
 
+	// ExpireBackup removes the data of the backup with the provided URL that is not needed to fulfill the retention.
+	ExpireBackup(url string, retention fdbv1beta2.BackupRetention) error
+
+	// DescribeBackup gets the description of the data of the backup with the provided URL.
+	DescribeBackup(url string) (*fdbv1beta2.FoundationDBBackupDescription, error)
+
 	// StartRestore starts a new restore.
-	StartRestore(url string, keyRanges []fdbv1beta2.FoundationDBKeyRange) error
+	StartRestore(url string, keyRanges []fdbv1beta2.FoundationDBKeyRange, options RestoreOptions) error
+
+	// GetRestoreStatus gets the status of the current restore. If no restore was started, nil will be returned.
+	GetRestoreStatus() (*fdbv1beta2.FoundationDBLiveRestoreStatus, error)
+
+	// AbortRestore aborts the current restore.
+	AbortRestore() error
+
+	// StartDisasterRecovery starts replicating the cluster with the provided connection string into this cluster.
+	StartDisasterRecovery(sourceConnectionString string) error
+
+	// GetDisasterRecoveryStatus gets the status of the replication from the cluster with the provided connection string
+	// into this cluster. If no replication was started, nil will be returned.
+	GetDisasterRecoveryStatus(sourceConnectionString string) (*fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus, error)
+
+	// PauseDisasterRecovery pauses the replication from the cluster with the provided connection string.
+	PauseDisasterRecovery(sourceConnectionString string) error
+
+	// ResumeDisasterRecovery resumes the replication from the cluster with the provided connection string.
+	ResumeDisasterRecovery(sourceConnectionString string) error
+
+	// AbortDisasterRecovery aborts the replication from the cluster with the provided connection string.
+	AbortDisasterRecovery(sourceConnectionString string) error
+
+	// SwitchDisasterRecovery makes this cluster the active cluster and reverses the replication from the cluster with
+	// the provided connection string.
+	SwitchDisasterRecovery(sourceConnectionString string) error
+
+	// CreateTenant creates a tenant with the provided name.
+	CreateTenant(name string) error
 
-	// GetRestoreStatus gets the status of the current restore.
-	GetRestoreStatus() (string, error)
+	// ListTenants returns the names of all tenants in the database.
+	ListTenants() ([]string, error)
+
+	// GetTenant gets the status of the tenant with the provided name. If the tenant doesn't exist, nil will be
+	// returned.
+	GetTenant(name string) (*fdbv1beta2.FoundationDBLiveTenantStatus, error)
+
+	// IsTenantEmpty returns true if the tenant with the provided name doesn't contain any keys.
+	IsTenantEmpty(name string) (bool, error)
+
+	// DeleteTenant deletes the tenant with the provided name. If force is true, all keys of the tenant will be cleared
+	// before the tenant is deleted.
+	DeleteTenant(name string, force bool) error
+
+	// SetServerKnobs sets the provided knobs for the provided config class in the configuration database. The knobs
+	// will be applied to the running fdbserver processes of the config class without a restart.
+	SetServerKnobs(configClass string, knobs []fdbv1beta2.FoundationDBKnob) error
+
+	// ClearServerKnobs removes the knobs with the provided names for the provided config class from the configuration
+	// database.
+	ClearServerKnobs(configClass string, names []string) error
 
 	// Close shuts down any resources for the client once it is no longer
 	// needed.
@@ -120,3 +174,19 @@ type AdminClient interface {
 	// SetTimeout will overwrite the default timeout for interacting the FDB cluster.
 	SetTimeout(timeout time.Duration)
 }
+
+// RestoreOptions defines the point in time a backup will be restored to and how the restored keys will be modified.
+type RestoreOptions struct {
+	// TargetVersion defines the database version to restore to.
+	TargetVersion *int64
+
+	// TargetTimestamp defines the point in time to restore to. The timestamp will be resolved to a version by the
+	// destination cluster.
+	TargetTimestamp *time.Time
+
+	// AddPrefix defines a prefix that will be added to all restored keys.
+	AddPrefix string
+
+	// RemovePrefix defines a prefix that will be removed from all restored keys.
+	RemovePrefix string
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"github.com/FoundationDB/fdb-kubernetes-operator/pkg/fdbstatus"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -53,6 +54,9 @@ type AdminClient struct {
 	incorrectCommandLines                    map[fdbv1beta2.ProcessGroupID]fdbv1beta2.None
 	FrozenStatus                             *fdbv1beta2.FoundationDBStatus
 	Backups                                  map[string]fdbv1beta2.FoundationDBBackupStatusBackupDetails
+	ExpiredBackups                           map[string]fdbv1beta2.BackupRetention
+	BackupLagSeconds                         float64
+	BackupBytesWritten                       int64
 	clientVersions                           map[string][]string
 	currentCommandLines                      map[string]string
 	VersionProcessGroups                     map[fdbv1beta2.ProcessGroupID]string
@@ -62,7 +66,13 @@ type AdminClient struct {
 	MaxZoneFailuresWithoutLosingData         *int
 	MaxZoneFailuresWithoutLosingAvailability *int
 	MaintenanceZone                          fdbv1beta2.FaultDomain
-	restoreURL                               string
+	restoreStatus                            *fdbv1beta2.FoundationDBLiveRestoreStatus
+	RestoreOptions                           fdbadminclient.RestoreOptions
+	DisasterRecoveries                       map[string]fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus
+	Tenants                                  map[string]fdbv1beta2.FoundationDBLiveTenantStatus
+	tenantsWithData                          map[string]fdbv1beta2.None
+	lastTenantID                             int64
+	ServerKnobs                              map[string]map[string]string
 	maintenanceZoneStartTimestamp            time.Time
 	uptimeSecondsForMaintenanceZone          float64
 	TeamTracker                              []fdbv1beta2.FoundationDBStatusTeamTracker
@@ -107,6 +117,10 @@ func NewMockAdminClientUncast(cluster *fdbv1beta2.FoundationDBCluster, kubeClien
 		}
 		adminClientCache[cluster.Name] = cachedClient
 		cachedClient.Backups = make(map[string]fdbv1beta2.FoundationDBBackupStatusBackupDetails)
+		cachedClient.DisasterRecoveries = make(map[string]fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus)
+		cachedClient.Tenants = make(map[string]fdbv1beta2.FoundationDBLiveTenantStatus)
+		cachedClient.tenantsWithData = make(map[string]fdbv1beta2.None)
+		cachedClient.ServerKnobs = make(map[string]map[string]string)
 	} else {
 		cachedClient.Cluster = cluster.DeepCopy()
 	}
@@ -638,10 +652,10 @@ func (client *AdminClient) KillProcesses(addresses []fdbv1beta2.ProcessAddress)
 	}
 	adminClientMutex.Unlock()
 
-	if client.Cluster.Status.RunningVersion != client.Cluster.Spec.Version {
+	if client.Cluster.Status.RunningVersion != client.Cluster.GetDesiredVersion() {
 		// We have to do this in the mock client, in the real world the tryConnectionOptions in update_status,
 		// will update the version.
-		client.Cluster.Status.RunningVersion = client.Cluster.Spec.Version
+		client.Cluster.Status.RunningVersion = client.Cluster.GetDesiredVersion()
 		err := client.KubeClient.Status().Update(context.TODO(), client.Cluster)
 		if err != nil {
 			return err
@@ -816,13 +830,68 @@ func (client *AdminClient) GetBackupStatus() (*fdbv1beta2.FoundationDBLiveBackup
 		status.Status.Running = backup.Running
 		status.BackupAgentsPaused = backup.Paused
 		status.SnapshotIntervalSeconds = backup.SnapshotPeriodSeconds
+		if backup.Running {
+			status.LatestRestorablePoint = &fdbv1beta2.FoundationDBLiveBackupRestorablePoint{
+				LagSeconds: client.BackupLagSeconds,
+			}
+			status.LogBytes.Written = client.BackupBytesWritten
+		}
 	}
 
 	return status, nil
 }
 
+// ExpireBackup removes the data of the backup with the provided URL that is not needed to fulfill the retention.
+func (client *AdminClient) ExpireBackup(url string, retention fdbv1beta2.BackupRetention) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	if client.ExpiredBackups == nil {
+		client.ExpiredBackups = map[string]fdbv1beta2.BackupRetention{}
+	}
+
+	client.ExpiredBackups[url] = retention
+
+	return nil
+}
+
+// DescribeBackup gets the description of the data of the backup with the provided URL.
+func (client *AdminClient) DescribeBackup(url string) (*fdbv1beta2.FoundationDBBackupDescription, error) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return nil, client.mockError
+	}
+
+	// The mocked backups are restorable for the last day, unless the backup data was expired. The versions are
+	// derived from the timestamps, as the database version advances by roughly one million versions per second.
+	now := time.Now()
+	minRestorableTime := now.Add(-24 * time.Hour)
+	retention, expired := client.ExpiredBackups[url]
+	if expired && retention.MinRestorableDays != nil {
+		minRestorableTime = now.AddDate(0, 0, -*retention.MinRestorableDays)
+	}
+
+	return &fdbv1beta2.FoundationDBBackupDescription{
+		Restorable: true,
+		MinRestorablePoint: &fdbv1beta2.FoundationDBBackupRestorablePoint{
+			Version: minRestorableTime.Unix() * 1000000,
+			Epochs:  minRestorableTime.Unix(),
+		},
+		MaxRestorablePoint: &fdbv1beta2.FoundationDBBackupRestorablePoint{
+			Version: now.Unix() * 1000000,
+			Epochs:  now.Unix(),
+		},
+	}, nil
+}
+
 // StartRestore starts a new restore.
-func (client *AdminClient) StartRestore(url string, _ []fdbv1beta2.FoundationDBKeyRange) error {
+func (client *AdminClient) StartRestore(url string, _ []fdbv1beta2.FoundationDBKeyRange, options fdbadminclient.RestoreOptions) error {
 	adminClientMutex.Lock()
 	defer adminClientMutex.Unlock()
 
@@ -830,20 +899,338 @@ func (client *AdminClient) StartRestore(url string, _ []fdbv1beta2.FoundationDBK
 		return client.mockError
 	}
 
-	client.restoreURL = url
+	targetVersion := time.Now().Unix() * 1000000
+	if options.TargetVersion != nil {
+		targetVersion = *options.TargetVersion
+	}
+
+	client.restoreStatus = &fdbv1beta2.FoundationDBLiveRestoreStatus{
+		Tag:           "default",
+		State:         fdbv1beta2.RestoreStateRunning,
+		BlocksTotal:   100,
+		URL:           url,
+		TargetVersion: targetVersion,
+	}
+	client.RestoreOptions = options
 	return nil
 }
 
-// GetRestoreStatus gets the status of the current restore.
-func (client *AdminClient) GetRestoreStatus() (string, error) {
+// GetRestoreStatus gets the status of the current restore. If no restore was started, nil will be returned.
+func (client *AdminClient) GetRestoreStatus() (*fdbv1beta2.FoundationDBLiveRestoreStatus, error) {
 	adminClientMutex.Lock()
 	defer adminClientMutex.Unlock()
 
 	if client.mockError != nil {
-		return "", client.mockError
+		return nil, client.mockError
+	}
+
+	return client.restoreStatus.DeepCopy(), nil
+}
+
+// AbortRestore aborts the current restore.
+func (client *AdminClient) AbortRestore() error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	if client.restoreStatus == nil {
+		return fmt.Errorf("no restore is running")
+	}
+
+	client.restoreStatus.State = fdbv1beta2.RestoreStateAborted
+	return nil
+}
+
+// MockRestoreStatus mocks the status of the current restore.
+func (client *AdminClient) MockRestoreStatus(status *fdbv1beta2.FoundationDBLiveRestoreStatus) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	client.restoreStatus = status.DeepCopy()
+}
+
+// StartDisasterRecovery starts replicating the cluster with the provided connection string into this cluster.
+func (client *AdminClient) StartDisasterRecovery(sourceConnectionString string) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	if client.DisasterRecoveries[sourceConnectionString].State.IsRunning() {
+		return fmt.Errorf("a DR from %s is already running", sourceConnectionString)
+	}
+
+	client.DisasterRecoveries[sourceConnectionString] = fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+		State: fdbv1beta2.DisasterRecoveryReplicationStateReplicating,
+	}
+	return nil
+}
+
+// GetDisasterRecoveryStatus gets the status of the replication from the cluster with the provided connection string
+// into this cluster. If no replication was started, nil will be returned.
+func (client *AdminClient) GetDisasterRecoveryStatus(sourceConnectionString string) (*fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus, error) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return nil, client.mockError
+	}
+
+	status, ok := client.DisasterRecoveries[sourceConnectionString]
+	if !ok {
+		return nil, nil
+	}
+
+	return &status, nil
+}
+
+// PauseDisasterRecovery pauses the replication from the cluster with the provided connection string.
+func (client *AdminClient) PauseDisasterRecovery(sourceConnectionString string) error {
+	return client.setDisasterRecoveryPaused(sourceConnectionString, true)
+}
+
+// ResumeDisasterRecovery resumes the replication from the cluster with the provided connection string.
+func (client *AdminClient) ResumeDisasterRecovery(sourceConnectionString string) error {
+	return client.setDisasterRecoveryPaused(sourceConnectionString, false)
+}
+
+// setDisasterRecoveryPaused updates the paused state of a running replication.
+func (client *AdminClient) setDisasterRecoveryPaused(sourceConnectionString string, paused bool) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	status, ok := client.DisasterRecoveries[sourceConnectionString]
+	if !ok || !status.State.IsRunning() {
+		return fmt.Errorf("no DR from %s is running", sourceConnectionString)
+	}
+
+	status.Paused = paused
+	client.DisasterRecoveries[sourceConnectionString] = status
+	return nil
+}
+
+// AbortDisasterRecovery aborts the replication from the cluster with the provided connection string.
+func (client *AdminClient) AbortDisasterRecovery(sourceConnectionString string) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	status, ok := client.DisasterRecoveries[sourceConnectionString]
+	if !ok || !status.State.IsRunning() {
+		return fmt.Errorf("no DR from %s is running", sourceConnectionString)
+	}
+
+	client.DisasterRecoveries[sourceConnectionString] = fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+		State: fdbv1beta2.DisasterRecoveryReplicationStateAborted,
+	}
+	return nil
+}
+
+// SwitchDisasterRecovery makes this cluster the active cluster and reverses the replication from the cluster with
+// the provided connection string. The reversed replication will be recorded in the mock admin client of the source
+// cluster.
+func (client *AdminClient) SwitchDisasterRecovery(sourceConnectionString string) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
 	}
 
-	return fmt.Sprintf("%s\n", client.restoreURL), nil
+	status, ok := client.DisasterRecoveries[sourceConnectionString]
+	if !ok || status.State != fdbv1beta2.DisasterRecoveryReplicationStateReplicating {
+		return fmt.Errorf("the DR from %s is not a complete copy", sourceConnectionString)
+	}
+
+	var sourceClient *AdminClient
+	for _, cachedClient := range adminClientCache {
+		if cachedClient.Cluster.Status.ConnectionString == sourceConnectionString {
+			sourceClient = cachedClient
+			break
+		}
+	}
+
+	if sourceClient == nil {
+		return fmt.Errorf("could not find cluster with connection string %s", sourceConnectionString)
+	}
+
+	delete(client.DisasterRecoveries, sourceConnectionString)
+	sourceClient.DisasterRecoveries[client.Cluster.Status.ConnectionString] = fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+		State: fdbv1beta2.DisasterRecoveryReplicationStateReplicating,
+	}
+	return nil
+}
+
+// MockDisasterRecoveryStatus mocks the status of the replication from the cluster with the provided connection
+// string.
+func (client *AdminClient) MockDisasterRecoveryStatus(sourceConnectionString string, status fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	client.DisasterRecoveries[sourceConnectionString] = status
+}
+
+// CreateTenant creates a tenant with the provided name.
+func (client *AdminClient) CreateTenant(name string) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	if _, ok := client.Tenants[name]; ok {
+		return fmt.Errorf("tenant %s already exists", name)
+	}
+
+	client.lastTenantID++
+	client.Tenants[name] = fdbv1beta2.FoundationDBLiveTenantStatus{
+		ID:     client.lastTenantID,
+		Prefix: fmt.Sprintf("\\x00\\x00\\x00\\x00\\x00\\x00\\x00\\x%02x", client.lastTenantID),
+	}
+	return nil
+}
+
+// ListTenants returns the names of all tenants in the database.
+func (client *AdminClient) ListTenants() ([]string, error) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return nil, client.mockError
+	}
+
+	tenants := make([]string, 0, len(client.Tenants))
+	for name := range client.Tenants {
+		tenants = append(tenants, name)
+	}
+	sort.Strings(tenants)
+
+	return tenants, nil
+}
+
+// GetTenant gets the status of the tenant with the provided name. If the tenant doesn't exist, nil will be
+// returned.
+func (client *AdminClient) GetTenant(name string) (*fdbv1beta2.FoundationDBLiveTenantStatus, error) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return nil, client.mockError
+	}
+
+	status, ok := client.Tenants[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return &status, nil
+}
+
+// IsTenantEmpty returns true if the tenant with the provided name doesn't contain any keys.
+func (client *AdminClient) IsTenantEmpty(name string) (bool, error) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return false, client.mockError
+	}
+
+	if _, ok := client.Tenants[name]; !ok {
+		return false, fmt.Errorf("tenant %s does not exist", name)
+	}
+
+	_, hasData := client.tenantsWithData[name]
+	return !hasData, nil
+}
+
+// DeleteTenant deletes the tenant with the provided name. If force is true, all keys of the tenant will be cleared
+// before the tenant is deleted.
+func (client *AdminClient) DeleteTenant(name string, force bool) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	if _, ok := client.Tenants[name]; !ok {
+		return fmt.Errorf("tenant %s does not exist", name)
+	}
+
+	if _, hasData := client.tenantsWithData[name]; hasData && !force {
+		return fmt.Errorf("tenant %s is not empty", name)
+	}
+
+	delete(client.Tenants, name)
+	delete(client.tenantsWithData, name)
+	return nil
+}
+
+// MockTenantHasData mocks whether the tenant with the provided name contains any keys.
+func (client *AdminClient) MockTenantHasData(name string, hasData bool) {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if hasData {
+		client.tenantsWithData[name] = fdbv1beta2.None{}
+		return
+	}
+
+	delete(client.tenantsWithData, name)
+}
+
+// SetServerKnobs sets the provided knobs for the provided config class in the configuration database.
+func (client *AdminClient) SetServerKnobs(configClass string, knobs []fdbv1beta2.FoundationDBKnob) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	if _, ok := client.ServerKnobs[configClass]; !ok {
+		client.ServerKnobs[configClass] = make(map[string]string, len(knobs))
+	}
+
+	for _, knob := range knobs {
+		client.ServerKnobs[configClass][knob.Name] = knob.Value
+	}
+
+	return nil
+}
+
+// ClearServerKnobs removes the knobs with the provided names for the provided config class from the configuration
+// database.
+func (client *AdminClient) ClearServerKnobs(configClass string, names []string) error {
+	adminClientMutex.Lock()
+	defer adminClientMutex.Unlock()
+
+	if client.mockError != nil {
+		return client.mockError
+	}
+
+	for _, name := range names {
+		delete(client.ServerKnobs[configClass], name)
+	}
+
+	if len(client.ServerKnobs[configClass]) == 0 {
+		delete(client.ServerKnobs, configClass)
+	}
+
+	return nil
 }
 
 // MockClientVersion returns a mocked client version
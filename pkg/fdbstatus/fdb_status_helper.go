@@ -21,8 +21,26 @@
 package fdbstatus
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+)
+
+var (
+	restoreTagRegex          = regexp.MustCompile(`Tag: (\S+)`)
+	restoreStateRegex        = regexp.MustCompile(`State: (\S+)`)
+	restoreBlocksRegex       = regexp.MustCompile(`Blocks: (\d+)/(\d+)`)
+	restoreBytesWrittenRegex = regexp.MustCompile(`BytesWritten: (\d+)`)
+	restoreLastErrorRegex    = regexp.MustCompile(`LastError: '(.*)' -?\d+s ago`)
+	restoreURLRegex          = regexp.MustCompile(`URL: (\S+)`)
+	restoreVersionRegex      = regexp.MustCompile(`(?:^|\s)Version: (-?\d+)`)
+	drLagRegex               = regexp.MustCompile(`([0-9.]+) seconds behind`)
+	drPausedRegex            = regexp.MustCompile(`(?i)DR agents (?:are|have been) paused`)
+	tenantListRegex          = regexp.MustCompile(`^\s*\d+\. (\S+)$`)
 )
 
 // RemoveWarningsInJSON removes any warning messages that might appear in the status output from the fdbcli and returns
@@ -35,3 +53,169 @@ func RemoveWarningsInJSON(jsonString string) ([]byte, error) {
 
 	return []byte(strings.TrimSpace(jsonString[idx:])), nil
 }
+
+// ParseRestoreStatus parses the output of the fdbrestore status command for a single restore tag. If the output
+// doesn't contain a restore, nil will be returned.
+func ParseRestoreStatus(output string) (*fdbv1beta2.FoundationDBLiveRestoreStatus, error) {
+	stateMatch := restoreStateRegex.FindStringSubmatch(output)
+	if stateMatch == nil {
+		return nil, nil
+	}
+
+	status := &fdbv1beta2.FoundationDBLiveRestoreStatus{
+		State: fdbv1beta2.RestoreState(stateMatch[1]),
+	}
+
+	tagMatch := restoreTagRegex.FindStringSubmatch(output)
+	if tagMatch != nil {
+		status.Tag = tagMatch[1]
+	}
+
+	urlMatch := restoreURLRegex.FindStringSubmatch(output)
+	if urlMatch != nil {
+		status.URL = urlMatch[1]
+	}
+
+	lastErrorMatch := restoreLastErrorRegex.FindStringSubmatch(output)
+	if lastErrorMatch != nil {
+		status.LastError = lastErrorMatch[1]
+	}
+
+	var err error
+	blocksMatch := restoreBlocksRegex.FindStringSubmatch(output)
+	if blocksMatch != nil {
+		status.BlocksCompleted, err = strconv.ParseInt(blocksMatch[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		status.BlocksTotal, err = strconv.ParseInt(blocksMatch[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bytesWrittenMatch := restoreBytesWrittenRegex.FindStringSubmatch(output)
+	if bytesWrittenMatch != nil {
+		status.BytesWritten, err = strconv.ParseInt(bytesWrittenMatch[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	versionMatch := restoreVersionRegex.FindStringSubmatch(output)
+	if versionMatch != nil {
+		status.TargetVersion, err = strconv.ParseInt(versionMatch[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+// ParseDisasterRecoveryStatus parses the output of the fdbdr status command. If the output doesn't contain a
+// replication, nil will be returned.
+func ParseDisasterRecoveryStatus(output string) (*fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus, error) {
+	var state fdbv1beta2.DisasterRecoveryReplicationState
+	switch {
+	case strings.Contains(output, "(just started)"):
+		state = fdbv1beta2.DisasterRecoveryReplicationStateStarting
+	case strings.Contains(output, "is NOT a complete copy"):
+		state = fdbv1beta2.DisasterRecoveryReplicationStateCopying
+	case strings.Contains(output, "is a complete copy"):
+		state = fdbv1beta2.DisasterRecoveryReplicationStateReplicating
+	case strings.Contains(output, "completed at version"):
+		state = fdbv1beta2.DisasterRecoveryReplicationStateCompleted
+	case strings.Contains(output, "aborted"):
+		state = fdbv1beta2.DisasterRecoveryReplicationStateAborted
+	default:
+		return nil, nil
+	}
+
+	status := &fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+		State:  state,
+		Paused: drPausedRegex.MatchString(output),
+	}
+
+	lagMatch := drLagRegex.FindStringSubmatch(output)
+	if lagMatch != nil {
+		lagSeconds, err := strconv.ParseFloat(lagMatch[1], 64)
+		if err != nil {
+			return nil, err
+		}
+
+		status.LagMilliseconds = int64(lagSeconds * 1000)
+	}
+
+	return status, nil
+}
+
+// ParseTenantList parses the output of the fdbcli command to list the tenants and returns the names of the tenants.
+func ParseTenantList(output string) []string {
+	var tenants []string
+	for _, line := range strings.Split(output, "\n") {
+		match := tenantListRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+
+		tenants = append(tenants, match[1])
+	}
+
+	return tenants
+}
+
+// tenantStatusOutput describes the JSON output of the fdbcli command to get a tenant.
+type tenantStatusOutput struct {
+	Type   string `json:"type"`
+	Error  string `json:"error,omitempty"`
+	Tenant struct {
+		ID     int64           `json:"id"`
+		Prefix json.RawMessage `json:"prefix"`
+	} `json:"tenant"`
+}
+
+// ParseTenantStatus parses the JSON output of the fdbcli command to get a tenant. FDB 7.1 reports the prefix as a
+// printable string, newer versions report an object that contains the printable prefix.
+func ParseTenantStatus(output string) (*fdbv1beta2.FoundationDBLiveTenantStatus, error) {
+	contents, err := RemoveWarningsInJSON(output)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := &tenantStatusOutput{}
+	err = json.Unmarshal(contents, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Type != "success" {
+		return nil, fmt.Errorf("could not get tenant: %s", parsed.Error)
+	}
+
+	status := &fdbv1beta2.FoundationDBLiveTenantStatus{
+		ID: parsed.Tenant.ID,
+	}
+
+	if len(parsed.Tenant.Prefix) == 0 {
+		return status, nil
+	}
+
+	err = json.Unmarshal(parsed.Tenant.Prefix, &status.Prefix)
+	if err == nil {
+		return status, nil
+	}
+
+	prefix := struct {
+		Printable string `json:"printable"`
+	}{}
+	err = json.Unmarshal(parsed.Tenant.Prefix, &prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	status.Prefix = prefix.Printable
+
+	return status, nil
+}
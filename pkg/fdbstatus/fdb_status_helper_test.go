@@ -23,6 +23,8 @@ package fdbstatus
 import (
 	"fmt"
 
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -76,4 +78,137 @@ var _ = Describe("fdb_status_helper_test", func() {
 			),
 		)
 	})
+
+	When("parsing the restore status", func() {
+		DescribeTable("it should return the parsed restore status",
+			func(output string, expected *fdbv1beta2.FoundationDBLiveRestoreStatus) {
+				status, err := ParseRestoreStatus(output)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(expected))
+			},
+			Entry("no restore",
+				"\n",
+				nil,
+			),
+			Entry("running restore",
+				"Tag: default  UID: 4a7d1b2c3e4f5a6b7c8d9e0f1a2b3c4d  State: running  Blocks: 25/100  BlocksInProgress: 10  Files: 12  BytesWritten: 1048576  CurrentVersion: 1000 FirstConsistentVersion: 900  ApplyVersionLag: 0  LastError: None  URL: blobstore://test@test-service/test-backup?bucket=fdb-backups  Begin: ''  End: '\\xff'  AddPrefix: ''  RemovePrefix: ''  Version: 123456789\n\n",
+				&fdbv1beta2.FoundationDBLiveRestoreStatus{
+					Tag:             "default",
+					State:           fdbv1beta2.RestoreStateRunning,
+					BlocksCompleted: 25,
+					BlocksTotal:     100,
+					BytesWritten:    1048576,
+					URL:             "blobstore://test@test-service/test-backup?bucket=fdb-backups",
+					TargetVersion:   123456789,
+				},
+			),
+			Entry("aborted restore with an error",
+				"Tag: default  UID: 4a7d1b2c3e4f5a6b7c8d9e0f1a2b3c4d  State: aborted  Blocks: 25/100  BlocksInProgress: 0  Files: 12  BytesWritten: 1048576  CurrentVersion: 1000 FirstConsistentVersion: 900  ApplyVersionLag: 0  LastError: 'restore_destination_not_empty' 12s ago.\n  URL: blobstore://test@test-service/test-backup?bucket=fdb-backups  Begin: ''  End: '\\xff'  AddPrefix: ''  RemovePrefix: ''  Version: 123456789\n\n",
+				&fdbv1beta2.FoundationDBLiveRestoreStatus{
+					Tag:             "default",
+					State:           fdbv1beta2.RestoreStateAborted,
+					BlocksCompleted: 25,
+					BlocksTotal:     100,
+					BytesWritten:    1048576,
+					LastError:       "restore_destination_not_empty",
+					URL:             "blobstore://test@test-service/test-backup?bucket=fdb-backups",
+					TargetVersion:   123456789,
+				},
+			),
+		)
+	})
+
+	When("parsing the DR status", func() {
+		DescribeTable("it should return the parsed DR status",
+			func(output string, expected *fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus) {
+				status, err := ParseDisasterRecoveryStatus(output)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(expected))
+			},
+			Entry("no replication",
+				"No previous DR on tag `default'.\n",
+				nil,
+			),
+			Entry("started replication",
+				"The DR on tag `default' is NOT a complete copy of the primary database (just started).\n",
+				&fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+					State: fdbv1beta2.DisasterRecoveryReplicationStateStarting,
+				},
+			),
+			Entry("replication with the initial copy in progress",
+				"The DR on tag `default' is NOT a complete copy of the primary database.\n\nThe DR is 12.500000 seconds behind.\n",
+				&fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+					State:           fdbv1beta2.DisasterRecoveryReplicationStateCopying,
+					LagMilliseconds: 12500,
+				},
+			),
+			Entry("paused replication of a complete copy",
+				"The DR on tag `default' is a complete copy of the primary database.\n\nThe DR is 0.123000 seconds behind.\n\nAll DR agents have been paused.\n",
+				&fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+					State:           fdbv1beta2.DisasterRecoveryReplicationStateReplicating,
+					Paused:          true,
+					LagMilliseconds: 123,
+				},
+			),
+			Entry("completed replication",
+				"The previous DR on tag `default' completed at version 123456789.\n",
+				&fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+					State: fdbv1beta2.DisasterRecoveryReplicationStateCompleted,
+				},
+			),
+			Entry("aborted replication",
+				"The previous DR on tag `default' has been aborted.\n",
+				&fdbv1beta2.FoundationDBLiveDisasterRecoveryStatus{
+					State: fdbv1beta2.DisasterRecoveryReplicationStateAborted,
+				},
+			),
+		)
+	})
+
+	When("parsing the tenant list", func() {
+		DescribeTable("it should return the tenant names",
+			func(output string, expected []string) {
+				Expect(ParseTenantList(output)).To(Equal(expected))
+			},
+			Entry("no tenants",
+				"The cluster has no tenants\n",
+				nil,
+			),
+			Entry("multiple tenants",
+				"  1. app\n  2. app.test\n",
+				[]string{"app", "app.test"},
+			),
+		)
+	})
+
+	When("parsing the tenant status", func() {
+		DescribeTable("it should return the parsed tenant status",
+			func(output string, expected *fdbv1beta2.FoundationDBLiveTenantStatus) {
+				status, err := ParseTenantStatus(output)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(expected))
+			},
+			Entry("a tenant from FDB 7.1",
+				`{"tenant":{"id":1,"prefix":"\\x00\\x00\\x00\\x00\\x00\\x00\\x00\\x01"},"type":"success"}`,
+				&fdbv1beta2.FoundationDBLiveTenantStatus{
+					ID:     1,
+					Prefix: `\x00\x00\x00\x00\x00\x00\x00\x01`,
+				},
+			),
+			Entry("a tenant from FDB 7.3",
+				`{"tenant":{"id":2,"prefix":{"base64":"AAAAAAAAAAI=","printable":"\\x00\\x00\\x00\\x00\\x00\\x00\\x00\\x02"},"tenant_state":"ready"},"type":"success"}`,
+				&fdbv1beta2.FoundationDBLiveTenantStatus{
+					ID:     2,
+					Prefix: `\x00\x00\x00\x00\x00\x00\x00\x02`,
+				},
+			),
+		)
+
+		When("the output contains an error", func() {
+			It("should return an error", func() {
+				_, err := ParseTenantStatus(`{"error":"Tenant does not exist","type":"error"}`)
+				Expect(err).To(MatchError("could not get tenant: Tenant does not exist"))
+			})
+		})
+	})
 })
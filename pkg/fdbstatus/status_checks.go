@@ -273,6 +273,23 @@ func GetCoordinatorsFromStatus(status *fdbv1beta2.FoundationDBStatus) map[string
 	return coordinators
 }
 
+// GetReportingProcessCountForDataCenter returns the number of processes in the provided data center that are
+// reporting to the cluster and are not excluded. If no process of a data center is reporting, the data center is
+// most likely unavailable.
+func GetReportingProcessCountForDataCenter(status *fdbv1beta2.FoundationDBStatus, dataCenterID string) int {
+	var count int
+
+	for _, process := range status.Cluster.Processes {
+		if process.Excluded || process.Locality[fdbv1beta2.FDBLocalityDCIDKey] != dataCenterID {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
 // GetMinimumUptimeAndAddressMap returns address map of the processes included the the foundationdb status. The minimum
 // uptime will be either secondsSinceLastRecovered if the recovery state is supported and enabled otherwise we will
 // take the minimum uptime of all processes.
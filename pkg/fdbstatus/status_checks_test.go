@@ -2141,4 +2141,37 @@ var _ = Describe("status_checks", func() {
 			fmt.Errorf("data lag is to high to issue configuration change, current data lag in seconds: 61.00"),
 		),
 	)
+
+	DescribeTable("when counting the reporting processes of a data center", func(dataCenterID string, expected int) {
+		status := &fdbv1beta2.FoundationDBStatus{
+			Cluster: fdbv1beta2.FoundationDBStatusClusterInfo{
+				Processes: map[fdbv1beta2.ProcessGroupID]fdbv1beta2.FoundationDBStatusProcessInfo{
+					"primary-storage-1": {
+						Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "primary"},
+					},
+					"primary-storage-2": {
+						Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "primary"},
+						Excluded: true,
+					},
+					"remote-storage-1": {
+						Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "remote"},
+						Excluded: true,
+					},
+					"satellite-log-1": {
+						Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "satellite"},
+					},
+					"satellite-log-2": {
+						Locality: map[string]string{fdbv1beta2.FDBLocalityDCIDKey: "satellite"},
+					},
+				},
+			},
+		}
+
+		Expect(GetReportingProcessCountForDataCenter(status, dataCenterID)).To(Equal(expected))
+	},
+		Entry("data center with an excluded process", "primary", 1),
+		Entry("data center with only excluded processes", "remote", 0),
+		Entry("data center with multiple processes", "satellite", 2),
+		Entry("unknown data center", "unknown", 0),
+	)
 })
@@ -27,6 +27,7 @@ import (
 	"io/fs"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"log"
 	"os"
@@ -38,13 +39,16 @@ import (
 
 	"github.com/go-logr/logr"
 
+	fdbv1beta1 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta1"
 	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
+	fdbv1beta3 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta3"
 	"github.com/FoundationDB/fdb-kubernetes-operator/controllers"
 	"github.com/FoundationDB/fdb-kubernetes-operator/fdbclient"
 	"github.com/FoundationDB/fdb-kubernetes-operator/internal"
 	"gopkg.in/natefinch/lumberjack.v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -65,6 +69,8 @@ type Options struct {
 	EnableRecoveryState                bool
 	CacheDatabaseStatus                bool
 	EnableNodeIndex                    bool
+	EnableWebhooks                     bool
+	EnableDefaultingWebhook            bool
 	MetricsAddr                        string
 	LeaderElectionID                   string
 	LogFile                            string
@@ -129,6 +135,8 @@ func (o *Options) BindFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&o.ServerSideApply, "server-side-apply", false, "This flag enables server side apply.")
 	fs.BoolVar(&o.EnableRecoveryState, "enable-recovery-state", true, "This flag enables the use of the recovery state for the minimum uptime between bounced if the FDB version supports it.")
 	fs.BoolVar(&o.CacheDatabaseStatus, "cache-database-status", true, "Defines the default value for caching the database status.")
+	fs.BoolVar(&o.EnableWebhooks, "enable-webhooks", false, "Defines if the operator should serve the validating admission webhooks for the FoundationDBCluster, FoundationDBBackup and FoundationDBRestore resources and the conversion webhook for the FoundationDBCluster resource. This requires a valid certificate for the webhook server.")
+	fs.BoolVar(&o.EnableDefaultingWebhook, "enable-defaulting-webhook", false, "Defines if the operator should serve the defaulting webhook for the FoundationDBCluster resource. The defaulting webhook writes the effective defaults into the stored cluster spec. This requires \"--enable-webhooks\" to be set.")
 	fs.BoolVar(&o.EnableNodeIndex, "enable-node-index", false, "Defines if the operator should add an index for accessing node objects. This requires a ClusterRoleBinding with node access. If the taint feature should be used, this setting should be set to true.")
 }
 
@@ -142,6 +150,10 @@ func StartManager(
 	clusterReconciler *controllers.FoundationDBClusterReconciler,
 	backupReconciler *controllers.FoundationDBBackupReconciler,
 	restoreReconciler *controllers.FoundationDBRestoreReconciler,
+	upgradePlanReconciler *controllers.FoundationDBUpgradePlanReconciler,
+	disasterRecoveryReconciler *controllers.FoundationDBDisasterRecoveryReconciler,
+	multiRegionClusterReconciler *controllers.FoundationDBMultiRegionClusterReconciler,
+	tenantReconciler *controllers.FoundationDBTenantReconciler,
 	logr logr.Logger,
 	watchedObjects ...client.Object) (manager.Manager, *os.File) {
 	if operatorOpts.PrintVersion {
@@ -208,6 +220,10 @@ func StartManager(
 		RetryPeriod:        &operatorOpts.RetryPeriod,
 		Port:               9443,
 		NewCache:           cache.BuilderWithOptions(cacheOptions),
+		// StorageClasses are cluster-scoped and the operator might only have a namespaced Role, in this case an
+		// informer for StorageClasses could never be synced. StorageClasses are only read if a PVC should be expanded,
+		// so they are read directly from the API server.
+		ClientDisableCacheFor: []client.Object{&storagev1.StorageClass{}},
 	}
 
 	if operatorOpts.WatchNamespace != "" {
@@ -285,6 +301,64 @@ func StartManager(
 		}
 	}
 
+	if upgradePlanReconciler != nil {
+		upgradePlanReconciler.Client = mgr.GetClient()
+		upgradePlanReconciler.Recorder = mgr.GetEventRecorderFor("foundationdbupgradeplan-controller")
+		upgradePlanReconciler.DatabaseClientProvider = fdbclient.NewDatabaseClientProvider(logger)
+		upgradePlanReconciler.Log = logr.WithName("controllers").WithName("FoundationDBUpgradePlan")
+		upgradePlanReconciler.ServerSideApply = operatorOpts.ServerSideApply
+
+		if err := upgradePlanReconciler.SetupWithManager(mgr, operatorOpts.MaxConcurrentReconciles, *labelSelector); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FoundationDBUpgradePlan")
+			os.Exit(1)
+		}
+	}
+
+	if disasterRecoveryReconciler != nil {
+		disasterRecoveryReconciler.Client = mgr.GetClient()
+		disasterRecoveryReconciler.Recorder = mgr.GetEventRecorderFor("foundationdbdisasterrecovery-controller")
+		disasterRecoveryReconciler.DatabaseClientProvider = fdbclient.NewDatabaseClientProvider(logger)
+		disasterRecoveryReconciler.Log = logr.WithName("controllers").WithName("FoundationDBDisasterRecovery")
+		disasterRecoveryReconciler.ServerSideApply = operatorOpts.ServerSideApply
+
+		if err := disasterRecoveryReconciler.SetupWithManager(mgr, operatorOpts.MaxConcurrentReconciles, *labelSelector); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FoundationDBDisasterRecovery")
+			os.Exit(1)
+		}
+	}
+
+	if multiRegionClusterReconciler != nil {
+		multiRegionClusterReconciler.Client = mgr.GetClient()
+		multiRegionClusterReconciler.Recorder = mgr.GetEventRecorderFor("foundationdbmultiregioncluster-controller")
+		multiRegionClusterReconciler.Log = logr.WithName("controllers").WithName("FoundationDBMultiRegionCluster")
+		multiRegionClusterReconciler.ServerSideApply = operatorOpts.ServerSideApply
+
+		if err := multiRegionClusterReconciler.SetupWithManager(mgr, operatorOpts.MaxConcurrentReconciles, *labelSelector); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FoundationDBMultiRegionCluster")
+			os.Exit(1)
+		}
+	}
+
+	if tenantReconciler != nil {
+		tenantReconciler.Client = mgr.GetClient()
+		tenantReconciler.Recorder = mgr.GetEventRecorderFor("foundationdbtenant-controller")
+		tenantReconciler.DatabaseClientProvider = fdbclient.NewDatabaseClientProvider(logger)
+		tenantReconciler.Log = logr.WithName("controllers").WithName("FoundationDBTenant")
+		tenantReconciler.ServerSideApply = operatorOpts.ServerSideApply
+
+		if err := tenantReconciler.SetupWithManager(mgr, operatorOpts.MaxConcurrentReconciles, *labelSelector); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FoundationDBTenant")
+			os.Exit(1)
+		}
+	}
+
+	if operatorOpts.EnableWebhooks {
+		if err := setupWebhooks(mgr, operatorOpts, clusterReconciler != nil, backupReconciler != nil, restoreReconciler != nil); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
+
 	if operatorOpts.CleanUpOldLogFile {
 		setupLog.V(1).Info("setup log file cleaner", "LogFileMinAge", operatorOpts.LogFileMinAge.String())
 		cleaner := internal.NewCliLogFileCleaner(logger, operatorOpts.LogFileMinAge)
@@ -302,6 +376,57 @@ func StartManager(
 	return mgr, nil
 }
 
+// AddToScheme adds all the types that are used by the operator to the provided scheme.
+func AddToScheme(scheme *runtime.Scheme) error {
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		fdbv1beta1.AddToScheme,
+		fdbv1beta2.AddToScheme,
+		fdbv1beta3.AddToScheme,
+	} {
+		err := addToScheme(scheme)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setupWebhooks registers the validating webhooks for all the resources that are managed by this operator instance. If
+// enabled the defaulting webhook for the FoundationDBCluster resource will be registered too.
+func setupWebhooks(mgr manager.Manager, operatorOpts Options, enableCluster bool, enableBackup bool, enableRestore bool) error {
+	if enableCluster {
+		if err := (&fdbv1beta2.FoundationDBCluster{}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create webhook for FoundationDBCluster: %w", err)
+		}
+
+		if operatorOpts.EnableDefaultingWebhook {
+			err := ctrl.NewWebhookManagedBy(mgr).
+				For(&fdbv1beta2.FoundationDBCluster{}).
+				WithDefaulter(&internal.ClusterDefaulter{DeprecationOptions: operatorOpts.DeprecationOptions}).
+				Complete()
+			if err != nil {
+				return fmt.Errorf("unable to create defaulting webhook for FoundationDBCluster: %w", err)
+			}
+		}
+	}
+
+	if enableBackup {
+		if err := (&fdbv1beta2.FoundationDBBackup{}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create webhook for FoundationDBBackup: %w", err)
+		}
+	}
+
+	if enableRestore {
+		if err := (&fdbv1beta2.FoundationDBRestore{}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create webhook for FoundationDBRestore: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // MoveFDBBinaries moves FDB binaries that are pulled from setup containers into
 // the correct locations.
 func moveFDBBinaries(log logr.Logger) error {
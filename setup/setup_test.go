@@ -25,13 +25,46 @@ import (
 	"os"
 	"path"
 
+	fdbv1beta2 "github.com/FoundationDB/fdb-kubernetes-operator/api/v1beta2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 )
 
 var _ = Describe("setup", func() {
 	var options Options
 
+	When("the webhooks are registered", func() {
+		var scheme *runtime.Scheme
+
+		BeforeEach(func() {
+			scheme = runtime.NewScheme()
+			Expect(AddToScheme(scheme)).NotTo(HaveOccurred())
+		})
+
+		It("should be able to convert the FoundationDBCluster", func() {
+			Expect(conversion.IsConvertible(scheme, &fdbv1beta2.FoundationDBCluster{})).To(BeTrue())
+		})
+
+		It("should register all webhooks", func() {
+			mgr, err := ctrl.NewManager(&rest.Config{Host: "https://localhost:6443"}, ctrl.Options{
+				Scheme:             scheme,
+				MetricsBindAddress: "0",
+				MapperProvider: func(_ *rest.Config) (meta.RESTMapper, error) {
+					return meta.NewDefaultRESTMapper(nil), nil
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			options.EnableDefaultingWebhook = true
+			Expect(setupWebhooks(mgr, options, true, true, true)).NotTo(HaveOccurred())
+		})
+	})
+
 	When("no log output file is defined", func() {
 		It("should return stdout as writer", func() {
 			writer, err := setupLogger(options)